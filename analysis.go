@@ -2,6 +2,7 @@ package dtrack
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -22,6 +23,24 @@ const (
 	AnalysisJustificationRequiresEnvironment          AnalysisJustification = "REQUIRES_ENVIRONMENT"
 )
 
+// IsValid determines whether j is a known analysis justification.
+func (j AnalysisJustification) IsValid() bool {
+	switch j {
+	case AnalysisJustificationCodeNotPresent,
+		AnalysisJustificationCodeNotReachable,
+		AnalysisJustificationNotSet,
+		AnalysisJustificationProtectedAtPerimeter,
+		AnalysisJustificationProtectedAtRuntime,
+		AnalysisJustificationProtectedByCompiler,
+		AnalysisJustificationProtectedByMitigatingControl,
+		AnalysisJustificationRequiresConfiguration,
+		AnalysisJustificationRequiresDependency,
+		AnalysisJustificationRequiresEnvironment:
+		return true
+	}
+	return false
+}
+
 type AnalysisResponse string
 
 const (
@@ -33,6 +52,20 @@ const (
 	AnalysisResponseWorkaroundAvailable AnalysisResponse = "WORKAROUND_AVAILABLE"
 )
 
+// IsValid determines whether r is a known analysis response.
+func (r AnalysisResponse) IsValid() bool {
+	switch r {
+	case AnalysisResponseCanNotFix,
+		AnalysisResponseNotSet,
+		AnalysisResponseRollback,
+		AnalysisResponseUpdate,
+		AnalysisResponseWillNotFix,
+		AnalysisResponseWorkaroundAvailable:
+		return true
+	}
+	return false
+}
+
 type AnalysisState string
 
 const (
@@ -44,6 +77,20 @@ const (
 	AnalysisStateResolved      AnalysisState = "RESOLVED"
 )
 
+// IsValid determines whether s is a known analysis state.
+func (s AnalysisState) IsValid() bool {
+	switch s {
+	case AnalysisStateExploitable,
+		AnalysisStateFalsePositive,
+		AnalysisStateInTriage,
+		AnalysisStateNotAffected,
+		AnalysisStateNotSet,
+		AnalysisStateResolved:
+		return true
+	}
+	return false
+}
+
 type Analysis struct {
 	Comments      []AnalysisComment     `json:"analysisComments"`
 	State         AnalysisState         `json:"analysisState"`
@@ -53,6 +100,8 @@ type Analysis struct {
 	Suppressed    bool                  `json:"isSuppressed"`
 }
 
+// AnalysisComment is an entry of the analysis trail. Besides comments provided by users,
+// the server records a comment for every change of state, justification, response or suppression.
 type AnalysisComment struct {
 	Comment   string `json:"comment"`
 	Commenter string `json:"commenter"`
@@ -71,10 +120,25 @@ type AnalysisRequest struct {
 	Suppressed    *bool                 `json:"isSuppressed,omitempty"`
 }
 
+// validate ensures that all values of the request that are set are valid.
+func (ar AnalysisRequest) validate() error {
+	if ar.State != "" && !ar.State.IsValid() {
+		return fmt.Errorf("invalid analysis state: %s", ar.State)
+	}
+	if ar.Justification != "" && !ar.Justification.IsValid() {
+		return fmt.Errorf("invalid analysis justification: %s", ar.Justification)
+	}
+	if ar.Response != "" && !ar.Response.IsValid() {
+		return fmt.Errorf("invalid analysis response: %s", ar.Response)
+	}
+	return nil
+}
+
 type AnalysisService struct {
 	client *Client
 }
 
+// Get fetches the analysis of a vulnerability affecting a component in a given project.
 func (as AnalysisService) Get(ctx context.Context, component, project, vulnerability uuid.UUID) (a Analysis, err error) {
 	params := map[string]string{
 		"component":     component.String(),
@@ -91,7 +155,15 @@ func (as AnalysisService) Get(ctx context.Context, component, project, vulnerabi
 	return
 }
 
+// Create records an analysis decision. If an analysis already exists for the given
+// component, project and vulnerability, it is updated instead.
+// Invalid states, justifications and responses are rejected without contacting the server.
 func (as AnalysisService) Create(ctx context.Context, analysisReq AnalysisRequest) (a Analysis, err error) {
+	err = analysisReq.validate()
+	if err != nil {
+		return
+	}
+
 	req, err := as.client.newRequest(ctx, http.MethodPut, "api/v1/analysis", withBody(analysisReq))
 	if err != nil {
 		return
@@ -100,3 +172,14 @@ func (as AnalysisService) Create(ctx context.Context, analysisReq AnalysisReques
 	_, err = as.client.doRequest(req, &a)
 	return
 }
+
+// AddComment appends a comment to the analysis trail of a vulnerability affecting a component
+// in a given project, without modifying the analysis decision itself.
+func (as AnalysisService) AddComment(ctx context.Context, component, project, vulnerability uuid.UUID, comment string) (a Analysis, err error) {
+	return as.Create(ctx, AnalysisRequest{
+		Component:     component,
+		Project:       project,
+		Vulnerability: vulnerability,
+		Comment:       comment,
+	})
+}
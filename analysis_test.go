@@ -0,0 +1,58 @@
+package dtrack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalysisState_IsValid(t *testing.T) {
+	require.True(t, AnalysisStateNotAffected.IsValid())
+	require.False(t, AnalysisState("FOO").IsValid())
+}
+
+func TestAnalysisJustification_IsValid(t *testing.T) {
+	require.True(t, AnalysisJustificationCodeNotReachable.IsValid())
+	require.False(t, AnalysisJustification("FOO").IsValid())
+}
+
+func TestAnalysisResponse_IsValid(t *testing.T) {
+	require.True(t, AnalysisResponseWillNotFix.IsValid())
+	require.False(t, AnalysisResponse("FOO").IsValid())
+}
+
+func TestAnalysisService_Create_InvalidRequest(t *testing.T) {
+	client := setUpTestServer(t, "4.11.0", nil)
+
+	_, err := client.Analysis.Create(context.Background(), AnalysisRequest{
+		State: "FOO",
+	})
+	require.EqualError(t, err, "invalid analysis state: FOO")
+}
+
+func TestAnalysisService_AddComment(t *testing.T) {
+	component, project, vulnerability := uuid.New(), uuid.New(), uuid.New()
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/api/v1/analysis", r.URL.Path)
+
+		// The analysis decision itself must not be part of the request.
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, fmt.Sprintf(`{"component":%q,"project":%q,"vulnerability":%q,"comment":"Reviewed"}`, component, project, vulnerability), string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"analysisState":"NOT_AFFECTED","analysisComments":[{"comment":"Reviewed","commenter":"admin"}]}`))
+	}))
+
+	analysis, err := client.Analysis.AddComment(context.Background(), component, project, vulnerability, "Reviewed")
+	require.NoError(t, err)
+	require.Equal(t, AnalysisStateNotAffected, analysis.State)
+	require.Equal(t, []AnalysisComment{{Comment: "Reviewed", Commenter: "admin"}}, analysis.Comments)
+}
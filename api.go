@@ -0,0 +1,365 @@
+package dtrack
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.7
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The interfaces below are implemented by the services of Client.
+// Code that depends on a subset of the API can accept them instead of a *Client,
+// and use the mocks in the dtrackmock package for testing.
+//
+// Methods added to services must be added to their interface as well,
+// followed by running "go generate" to update the mocks.
+
+// ACLAPI is the interface implemented by ACLService.
+type ACLAPI interface {
+	AddProjectMapping(ctx context.Context, mapping ACLMappingRequest) error
+	GetAllProjects(ctx context.Context, team uuid.UUID, po PageOptions) (Page[Project], error)
+	RemoveProjectMapping(ctx context.Context, team, project uuid.UUID) error
+}
+
+// AboutAPI is the interface implemented by AboutService.
+type AboutAPI interface {
+	Get(ctx context.Context) (About, error)
+}
+
+// AnalysisAPI is the interface implemented by AnalysisService.
+type AnalysisAPI interface {
+	AddComment(ctx context.Context, component, project, vulnerability uuid.UUID, comment string) (Analysis, error)
+	Create(ctx context.Context, analysisReq AnalysisRequest) (Analysis, error)
+	Get(ctx context.Context, component, project, vulnerability uuid.UUID) (Analysis, error)
+}
+
+// BOMAPI is the interface implemented by BOMService.
+type BOMAPI interface {
+	ExportComponent(ctx context.Context, componentUUID uuid.UUID, format BOMFormat) (string, error)
+	ExportProject(ctx context.Context, projectUUID uuid.UUID, format BOMFormat, variant BOMVariant) (string, error)
+	ExportProjectJSON(ctx context.Context, projectUUID uuid.UUID, variant BOMVariant, v interface{}) error
+	IsBeingProcessed(ctx context.Context, token BOMUploadToken) (bool, error)
+	PostBom(ctx context.Context, uploadReq BOMUploadRequest) (BOMUploadToken, error)
+	PostBomStream(ctx context.Context, uploadReq BOMUploadRequest, bom io.Reader) (BOMUploadToken, error)
+	Upload(ctx context.Context, uploadReq BOMUploadRequest) (BOMUploadToken, error)
+	UploadDir(ctx context.Context, dir string, opts BulkUploadOptions) ([]BOMUploadResult, error)
+	UploadJSON(ctx context.Context, uploadReq BOMUploadRequest, bom interface{}) (BOMUploadToken, error)
+	WaitForProcessing(ctx context.Context, token BOMUploadToken, opts PollingOptions) error
+}
+
+// BadgeAPI is the interface implemented by BadgeService.
+type BadgeAPI interface {
+	GetProjectPolicyViolationsBadge(ctx context.Context, projectUUID uuid.UUID) ([]byte, error)
+	GetProjectPolicyViolationsBadgeByNameVersion(ctx context.Context, name, version string) ([]byte, error)
+	GetProjectVulnerabilitiesBadge(ctx context.Context, projectUUID uuid.UUID) ([]byte, error)
+	GetProjectVulnerabilitiesBadgeByNameVersion(ctx context.Context, name, version string) ([]byte, error)
+}
+
+// ComponentAPI is the interface implemented by ComponentService.
+type ComponentAPI interface {
+	Create(ctx context.Context, projectUUID uuid.UUID, component Component) (Component, error)
+	CreateProperty(ctx context.Context, componentUUID uuid.UUID, property ComponentProperty) (ComponentProperty, error)
+	Delete(ctx context.Context, componentUUID uuid.UUID) error
+	DeleteProperty(ctx context.Context, componentUUID, propertyUUID uuid.UUID) error
+	Get(ctx context.Context, componentUUID uuid.UUID) (Component, error)
+	GetAll(ctx context.Context, projectUUID uuid.UUID, po PageOptions, filterOptions ComponentFilterOptions) (Page[Component], error)
+	GetByHash(ctx context.Context, hash string, po PageOptions, so SortOptions) (Page[Component], error)
+	GetByIdentity(ctx context.Context, po PageOptions, so SortOptions, io ComponentIdentityQueryOptions) (Page[Component], error)
+	GetInternalIdentification(ctx context.Context) (InternalComponentIdentification, error)
+	GetOccurrences(ctx context.Context, componentUUID uuid.UUID, po PageOptions) (Page[ComponentOccurrence], error)
+	GetProperties(ctx context.Context, componentUUID uuid.UUID) ([]ComponentProperty, error)
+	GetRepositoryMeta(ctx context.Context, componentUUID uuid.UUID) (RepositoryMetaComponent, error)
+	IdentifyInternal(ctx context.Context) error
+	Update(ctx context.Context, component Component) (Component, error)
+	UpdateInternalIdentification(ctx context.Context, ici InternalComponentIdentification) error
+}
+
+// ConfigAPI is the interface implemented by ConfigService.
+type ConfigAPI interface {
+	Get(ctx context.Context, groupName, propertyName string) (ConfigProperty, error)
+	GetAll(ctx context.Context) ([]ConfigProperty, error)
+	Update(ctx context.Context, config ConfigProperty) (ConfigProperty, error)
+	UpdateAll(ctx context.Context, configs []ConfigProperty) ([]ConfigProperty, error)
+}
+
+// EventAPI is the interface implemented by EventService.
+type EventAPI interface {
+	IsBeingProcessed(ctx context.Context, token EventToken) (bool, error)
+	WaitForProcessing(ctx context.Context, token EventToken, opts PollingOptions) error
+}
+
+// FindingAPI is the interface implemented by FindingService.
+type FindingAPI interface {
+	AnalyzeProject(ctx context.Context, projectUUID uuid.UUID) (BOMUploadToken, error)
+	ExportFPF(ctx context.Context, projectUUID uuid.UUID) ([]byte, error)
+	GetAll(ctx context.Context, projectUUID uuid.UUID, suppressed bool, po PageOptions) (Page[Finding], error)
+	GetAllBySource(ctx context.Context, projectUUID uuid.UUID, suppressed bool, source string, po PageOptions) (Page[Finding], error)
+	GetAllFiltered(ctx context.Context, projectUUID uuid.UUID, suppressed bool, filter FindingFilter) ([]Finding, error)
+	GetAllForPortfolio(ctx context.Context, filterOptions PortfolioFindingFilterOptions, po PageOptions) (Page[Finding], error)
+	GetAllGrouped(ctx context.Context, filterOptions PortfolioFindingFilterOptions, po PageOptions) (Page[GroupedFinding], error)
+}
+
+// HealthAPI is the interface implemented by HealthService.
+type HealthAPI interface {
+	Get(ctx context.Context) (Health, error)
+}
+
+// LDAPAPI is the interface implemented by LDAPService.
+type LDAPAPI interface {
+	AddMapping(ctx context.Context, mapping MappedLdapGroupRequest) (MappedLdapGroup, error)
+	CreateUser(ctx context.Context, user LdapUser) (LdapUser, error)
+	DeleteUser(ctx context.Context, user LdapUser) error
+	GetAllAccessibleGroups(ctx context.Context, po PageOptions) (Page[string], error)
+	GetTeamMappings(ctx context.Context, teamUUID uuid.UUID) ([]MappedLdapGroup, error)
+	GetUsers(ctx context.Context, po PageOptions) (Page[LdapUser], error)
+	RemoveMapping(ctx context.Context, mappingId uuid.UUID) error
+}
+
+// LicenseGroupAPI is the interface implemented by LicenseGroupService.
+type LicenseGroupAPI interface {
+	AddLicense(ctx context.Context, licenseGroupUUID, licenseUUID uuid.UUID) (LicenseGroup, error)
+	Create(ctx context.Context, licenseGroup LicenseGroup) (LicenseGroup, error)
+	Delete(ctx context.Context, licenseGroupUUID uuid.UUID) error
+	Get(ctx context.Context, licenseGroupUUID uuid.UUID) (LicenseGroup, error)
+	GetAll(ctx context.Context, po PageOptions) (Page[LicenseGroup], error)
+	RemoveLicense(ctx context.Context, licenseGroupUUID, licenseUUID uuid.UUID) (LicenseGroup, error)
+	Update(ctx context.Context, licenseGroup LicenseGroup) (LicenseGroup, error)
+}
+
+// LicenseAPI is the interface implemented by LicenseService.
+type LicenseAPI interface {
+	ComplianceReport(ctx context.Context, projectUUID uuid.UUID, opts LicenseComplianceOptions) (LicenseComplianceReport, error)
+	Create(ctx context.Context, license License) (License, error)
+	Delete(ctx context.Context, licenseID string) error
+	Get(ctx context.Context, licenseID string) (License, error)
+	GetAll(ctx context.Context, po PageOptions) (Page[License], error)
+	GetAllConcise(ctx context.Context) ([]License, error)
+	PortfolioComplianceReport(ctx context.Context, opts LicenseComplianceOptions) (LicenseComplianceReport, error)
+}
+
+// MetricsAPI is the interface implemented by MetricsService.
+type MetricsAPI interface {
+	ComponentMetricsSince(ctx context.Context, componentUUID uuid.UUID, date time.Time) ([]ComponentMetrics, error)
+	ComponentMetricsSinceDays(ctx context.Context, componentUUID uuid.UUID, days uint) ([]ComponentMetrics, error)
+	ExportPortfolioReport(ctx context.Context, w io.Writer, opts MetricsReportOptions) error
+	LatestComponentMetrics(ctx context.Context, componentUUID uuid.UUID) (ComponentMetrics, error)
+	LatestPortfolioMetrics(ctx context.Context) (PortfolioMetrics, error)
+	LatestProjectMetrics(ctx context.Context, projectUUID uuid.UUID) (ProjectMetrics, error)
+	PortfolioMetricsSince(ctx context.Context, date time.Time) ([]PortfolioMetrics, error)
+	PortfolioMetricsSinceDays(ctx context.Context, days uint) ([]PortfolioMetrics, error)
+	ProjectMetricsDelta(ctx context.Context, projectUUID uuid.UUID, from, to time.Time) (ProjectMetricsDelta, error)
+	ProjectMetricsSince(ctx context.Context, projectUUID uuid.UUID, date time.Time) ([]ProjectMetrics, error)
+	ProjectMetricsSinceDays(ctx context.Context, projectUUID uuid.UUID, days uint) ([]ProjectMetrics, error)
+	RefreshComponentMetrics(ctx context.Context, componentUUID uuid.UUID) error
+	RefreshPortfolioMetrics(ctx context.Context) error
+	RefreshProjectMetrics(ctx context.Context, projectUUID uuid.UUID) error
+	VulnerabilityMetrics(ctx context.Context) ([]VulnerabilityMetrics, error)
+	WaitForComponentMetrics(ctx context.Context, componentUUID uuid.UUID, since time.Time, opts PollingOptions) error
+	WaitForPortfolioMetrics(ctx context.Context, since time.Time, opts PollingOptions) error
+	WaitForProjectMetrics(ctx context.Context, projectUUID uuid.UUID, since time.Time, opts PollingOptions) error
+}
+
+// OIDCAPI is the interface implemented by OIDCService.
+type OIDCAPI interface {
+	AddTeamMapping(ctx context.Context, mapping OIDCMappingRequest) (OIDCMapping, error)
+	Available(ctx context.Context) (bool, error)
+	CreateGroup(ctx context.Context, name string) (OIDCGroup, error)
+	CreateUser(ctx context.Context, userReq OIDCUser) (OIDCUser, error)
+	DeleteGroup(ctx context.Context, groupUUID uuid.UUID) error
+	DeleteUser(ctx context.Context, user OIDCUser) error
+	GetAllGroups(ctx context.Context) ([]OIDCGroup, error)
+	GetAllTeamsOf(ctx context.Context, group OIDCGroup) ([]Team, error)
+	GetAllUsers(ctx context.Context) (Page[OIDCUser], error)
+	Login(ctx context.Context, tokens OIDCTokens) (string, error)
+	RemoveTeamMapping(ctx context.Context, mappingID uuid.UUID) error
+	RemoveTeamMapping2(ctx context.Context, groupID, teamID uuid.UUID) error
+	UpdateGroup(ctx context.Context, group OIDCGroup) (OIDCGroup, error)
+}
+
+// PermissionAPI is the interface implemented by PermissionService.
+type PermissionAPI interface {
+	AddPermissionToTeam(ctx context.Context, permission Permission, team uuid.UUID) (Team, error)
+	AddPermissionToUser(ctx context.Context, permission Permission, username string) (UserPrincipal, error)
+	GetAll(ctx context.Context, po PageOptions) (Page[Permission], error)
+	RemovePermissionFromTeam(ctx context.Context, permission Permission, team uuid.UUID) (Team, error)
+	RemovePermissionFromUser(ctx context.Context, permission Permission, username string) (UserPrincipal, error)
+}
+
+// PolicyConditionAPI is the interface implemented by PolicyConditionService.
+type PolicyConditionAPI interface {
+	Create(ctx context.Context, policyUUID uuid.UUID, policyCondition PolicyCondition) (PolicyCondition, error)
+	Delete(ctx context.Context, policyConditionUUID uuid.UUID) error
+	Update(ctx context.Context, policyCondition PolicyCondition) (PolicyCondition, error)
+}
+
+// PolicyAPI is the interface implemented by PolicyService.
+type PolicyAPI interface {
+	AddProject(ctx context.Context, policyUUID, projectUUID uuid.UUID) (Policy, error)
+	AddTag(ctx context.Context, policyUUID uuid.UUID, tagName string) (Policy, error)
+	Create(ctx context.Context, policy Policy) (Policy, error)
+	Delete(ctx context.Context, policyUUID uuid.UUID) error
+	DeleteProject(ctx context.Context, policyUUID, projectUUID uuid.UUID) (Policy, error)
+	DeleteTag(ctx context.Context, policyUUID uuid.UUID, tagName string) (Policy, error)
+	Get(ctx context.Context, policyUUID uuid.UUID) (Policy, error)
+	GetAll(ctx context.Context, po PageOptions) (Page[Policy], error)
+	Update(ctx context.Context, policy Policy) (Policy, error)
+}
+
+// PolicyViolationAPI is the interface implemented by PolicyViolationService.
+type PolicyViolationAPI interface {
+	GetAll(ctx context.Context, suppressed bool, po PageOptions) (Page[PolicyViolation], error)
+	GetAllForComponent(ctx context.Context, componentUUID uuid.UUID, suppressed bool, po PageOptions) (Page[PolicyViolation], error)
+	GetAllForProject(ctx context.Context, projectUUID uuid.UUID, suppressed bool, po PageOptions) (Page[PolicyViolation], error)
+}
+
+// ProjectPropertyAPI is the interface implemented by ProjectPropertyService.
+type ProjectPropertyAPI interface {
+	Create(ctx context.Context, projectUUID uuid.UUID, property ProjectProperty) (ProjectProperty, error)
+	Delete(ctx context.Context, projectUUID uuid.UUID, groupName, propertyName string) error
+	GetAll(ctx context.Context, projectUUID uuid.UUID, po PageOptions) (Page[ProjectProperty], error)
+	Update(ctx context.Context, projectUUID uuid.UUID, property ProjectProperty) (ProjectProperty, error)
+}
+
+// ProjectAPI is the interface implemented by ProjectService.
+type ProjectAPI interface {
+	BulkDelete(ctx context.Context, selector ProjectSelector, opts BulkDeleteOptions) ([]Project, error)
+	Clone(ctx context.Context, cloneReq ProjectCloneRequest) (EventToken, error)
+	Create(ctx context.Context, project Project) (Project, error)
+	Delete(ctx context.Context, projectUUID uuid.UUID) error
+	Get(ctx context.Context, projectUUID uuid.UUID) (Project, error)
+	GetAll(ctx context.Context, po PageOptions) (Page[Project], error)
+	GetAllFiltered(ctx context.Context, po PageOptions, filterOptions ProjectFilterOptions) (Page[Project], error)
+	GetAllByClassifier(ctx context.Context, classifier string, excludeInactive, onlyRoot bool, po PageOptions) (Page[Project], error)
+	GetAllByTag(ctx context.Context, tag string, excludeInactive, onlyRoot bool, po PageOptions) (Page[Project], error)
+	GetChildren(ctx context.Context, projectUUID uuid.UUID, po PageOptions) (Page[Project], error)
+	GetProjectsForName(ctx context.Context, name string, excludeInactive, onlyRoot bool) ([]Project, error)
+	GetTree(ctx context.Context, projectUUID uuid.UUID) (ProjectTree, error)
+	Latest(ctx context.Context, name string) (Project, error)
+	Lookup(ctx context.Context, name, version string) (Project, error)
+	Patch(ctx context.Context, projectUUID uuid.UUID, project Project) (Project, error)
+	Select(ctx context.Context, selector ProjectSelector) ([]Project, error)
+	Update(ctx context.Context, project Project) (Project, error)
+}
+
+// RepositoryAPI is the interface implemented by RepositoryService.
+type RepositoryAPI interface {
+	Create(ctx context.Context, repo Repository) (Repository, error)
+	Delete(ctx context.Context, reposUUID uuid.UUID) error
+	GetAll(ctx context.Context, po PageOptions) (Page[Repository], error)
+	GetByType(ctx context.Context, repoType RepositoryType, po PageOptions) (Page[Repository], error)
+	GetMetaComponent(ctx context.Context, purl string) (RepositoryMetaComponent, error)
+	Update(ctx context.Context, repo Repository) (Repository, error)
+}
+
+// TagAPI is the interface implemented by TagService.
+type TagAPI interface {
+	Create(ctx context.Context, names []string) error
+	Delete(ctx context.Context, names []string) error
+	GetAll(ctx context.Context, po PageOptions, so SortOptions) (Page[TagListResponseItem], error)
+	GetNotificationRules(ctx context.Context, tag string, po PageOptions, so SortOptions) (Page[TaggedPolicyListResponseItem], error)
+	GetPolicies(ctx context.Context, tag string, po PageOptions, so SortOptions) (Page[TaggedPolicyListResponseItem], error)
+	GetProjects(ctx context.Context, tag string, po PageOptions, so SortOptions) (Page[TaggedProjectListResponseItem], error)
+	GetTagsForPolicy(ctx context.Context, policy uuid.UUID, po PageOptions, so SortOptions) (Page[Tag], error)
+	TagNotificationRules(ctx context.Context, tag string, rules []uuid.UUID) error
+	TagPolicies(ctx context.Context, tag string, policies []uuid.UUID) error
+	TagProjects(ctx context.Context, tag string, projects []uuid.UUID) error
+	UntagNotificationRules(ctx context.Context, tag string, rules []uuid.UUID) error
+	UntagPolicies(ctx context.Context, tag string, policies []uuid.UUID) error
+	UntagProjects(ctx context.Context, tag string, projects []uuid.UUID) error
+}
+
+// TeamAPI is the interface implemented by TeamService.
+type TeamAPI interface {
+	Create(ctx context.Context, team Team) (Team, error)
+	Delete(ctx context.Context, team Team) error
+	DeleteAPIKey(ctx context.Context, publicIdOrKey string) error
+	GenerateAPIKey(ctx context.Context, teamUUID uuid.UUID) (APIKey, error)
+	Get(ctx context.Context, teamUUID uuid.UUID) (Team, error)
+	GetAPIKeys(ctx context.Context, teamUUID uuid.UUID) ([]APIKey, error)
+	GetAll(ctx context.Context, po PageOptions) (Page[Team], error)
+	GetSelf(ctx context.Context) (Team, error)
+	RegenerateAPIKey(ctx context.Context, publicIdOrKey string) (APIKey, error)
+	Update(ctx context.Context, team Team) (Team, error)
+	UpdateAPIKeyComment(ctx context.Context, publicIdOrKey, comment string) (string, error)
+}
+
+// UserAPI is the interface implemented by UserService.
+type UserAPI interface {
+	AddTeamToUser(ctx context.Context, username string, team uuid.UUID) (UserPrincipal, error)
+	CreateManaged(ctx context.Context, usr ManagedUser) (ManagedUser, error)
+	DeleteManaged(ctx context.Context, user ManagedUser) error
+	ForceChangePassword(ctx context.Context, username, password, newPassword string) error
+	GetAllManaged(ctx context.Context, po PageOptions) (Page[ManagedUser], error)
+	GetSelf(ctx context.Context) (UserPrincipal, error)
+	Login(ctx context.Context, username, password string) (string, error)
+	RemoveTeamFromUser(ctx context.Context, username string, team uuid.UUID) (UserPrincipal, error)
+	UpdateManaged(ctx context.Context, usr ManagedUser) (ManagedUser, error)
+	UpdateSelf(ctx context.Context, userReq ManagedUser) (ManagedUser, error)
+}
+
+// VEXAPI is the interface implemented by VEXService.
+type VEXAPI interface {
+	ExportCycloneDX(ctx context.Context, projectUUID uuid.UUID) (string, error)
+	PostVex(ctx context.Context, uploadReq VEXUploadRequest) (VEXUploadToken, error)
+	Upload(ctx context.Context, uploadReq VEXUploadRequest) (VEXUploadToken, error)
+}
+
+// ViolationAnalysisAPI is the interface implemented by ViolationAnalysisService.
+type ViolationAnalysisAPI interface {
+	AddComment(ctx context.Context, componentUUID, policyViolationUUID uuid.UUID, comment string) (ViolationAnalysis, error)
+	BulkUpdate(ctx context.Context, violations []PolicyViolation, decision ViolationAnalysisDecision, concurrency int) error
+	Get(ctx context.Context, componentUUID, policyViolationUUID uuid.UUID) (ViolationAnalysis, error)
+	Update(ctx context.Context, analysisReq ViolationAnalysisRequest) (ViolationAnalysis, error)
+}
+
+// VulnerabilityAPI is the interface implemented by VulnerabilityService.
+type VulnerabilityAPI interface {
+	Assign(ctx context.Context, vulnUUID, componentUUID uuid.UUID) error
+	AssignBySourceAndVulnID(ctx context.Context, source, vulnID string, componentUUID uuid.UUID) error
+	Create(ctx context.Context, vulnerability Vulnerability) (Vulnerability, error)
+	Delete(ctx context.Context, vulnUUID uuid.UUID) error
+	GenerateInternalID(ctx context.Context) (string, error)
+	Get(ctx context.Context, vulnUUID uuid.UUID) (Vulnerability, error)
+	GetAffectedComponents(ctx context.Context, source, vulnID string) ([]AffectedComponent, error)
+	GetAliases(ctx context.Context, source, vulnID string) ([]VulnerabilityAlias, error)
+	GetAllForComponent(ctx context.Context, componentUUID uuid.UUID, suppressed bool, po PageOptions) (Page[Vulnerability], error)
+	GetAllForProject(ctx context.Context, projectUUID uuid.UUID, suppressed bool, po PageOptions) (Page[Vulnerability], error)
+	GetBySourceAndVulnID(ctx context.Context, source, vulnID string) (Vulnerability, error)
+	Unassign(ctx context.Context, vulnUUID, componentUUID uuid.UUID) error
+	UnassignBySourceAndVulnID(ctx context.Context, source, vulnID string, componentUUID uuid.UUID) error
+	Update(ctx context.Context, vulnerability Vulnerability) (Vulnerability, error)
+}
+
+var (
+	_ ACLAPI               = ACLService{}
+	_ AboutAPI             = AboutService{}
+	_ AnalysisAPI          = AnalysisService{}
+	_ BOMAPI               = BOMService{}
+	_ BadgeAPI             = BadgeService{}
+	_ ComponentAPI         = ComponentService{}
+	_ ConfigAPI            = ConfigService{}
+	_ EventAPI             = EventService{}
+	_ FindingAPI           = FindingService{}
+	_ HealthAPI            = HealthService{}
+	_ LDAPAPI              = LDAPService{}
+	_ LicenseGroupAPI      = LicenseGroupService{}
+	_ LicenseAPI           = LicenseService{}
+	_ MetricsAPI           = MetricsService{}
+	_ OIDCAPI              = OIDCService{}
+	_ PermissionAPI        = PermissionService{}
+	_ PolicyConditionAPI   = PolicyConditionService{}
+	_ PolicyAPI            = PolicyService{}
+	_ PolicyViolationAPI   = PolicyViolationService{}
+	_ ProjectPropertyAPI   = ProjectPropertyService{}
+	_ ProjectAPI           = ProjectService{}
+	_ RepositoryAPI        = RepositoryService{}
+	_ TagAPI               = TagService{}
+	_ TeamAPI              = TeamService{}
+	_ UserAPI              = UserService{}
+	_ VEXAPI               = VEXService{}
+	_ ViolationAnalysisAPI = ViolationAnalysisService{}
+	_ VulnerabilityAPI     = VulnerabilityService{}
+)
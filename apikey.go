@@ -0,0 +1,69 @@
+package dtrack
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EnvAPIKeyProvider is an APIKeyProvider that reads the API key from the environment variable of the given name.
+type EnvAPIKeyProvider string
+
+func (p EnvAPIKeyProvider) APIKey(_ context.Context) (string, error) {
+	apiKey := os.Getenv(string(p))
+	if apiKey == "" {
+		return "", fmt.Errorf("environment variable %s is not set", string(p))
+	}
+
+	return apiKey, nil
+}
+
+// FileAPIKeyProvider is an APIKeyProvider that reads the API key from a file.
+//
+// The file is read again whenever its modification time or size changes, which makes it
+// suitable for keys that are rotated by updating the file, e.g. Kubernetes secrets mounted
+// as volumes. Leading and trailing whitespace is trimmed from the file's content.
+type FileAPIKeyProvider struct {
+	path    string
+	mutex   sync.Mutex
+	modTime time.Time
+	size    int64
+	apiKey  string
+}
+
+func NewFileAPIKeyProvider(path string) *FileAPIKeyProvider {
+	return &FileAPIKeyProvider{path: path}
+}
+
+func (p *FileAPIKeyProvider) APIKey(_ context.Context) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return "", err
+	}
+
+	if p.apiKey != "" && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return p.apiKey, nil
+	}
+
+	content, err := os.ReadFile(p.path)
+	if err != nil {
+		return "", err
+	}
+
+	apiKey := strings.TrimSpace(string(content))
+	if apiKey == "" {
+		return "", fmt.Errorf("api key file %s is empty", p.path)
+	}
+
+	p.apiKey = apiKey
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+
+	return p.apiKey, nil
+}
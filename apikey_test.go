@@ -0,0 +1,46 @@
+package dtrack
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvAPIKeyProvider(t *testing.T) {
+	t.Setenv("DTRACK_TEST_API_KEY", "odt_foo")
+
+	apiKey, err := EnvAPIKeyProvider("DTRACK_TEST_API_KEY").APIKey(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "odt_foo", apiKey)
+
+	_, err = EnvAPIKeyProvider("DTRACK_TEST_API_KEY_MISSING").APIKey(context.Background())
+	require.Error(t, err)
+}
+
+func TestFileAPIKeyProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-key")
+	require.NoError(t, os.WriteFile(path, []byte("odt_foo\n"), 0o600))
+
+	provider := NewFileAPIKeyProvider(path)
+
+	apiKey, err := provider.APIKey(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "odt_foo", apiKey)
+
+	// Rotate the key. Set the modification time explicitly,
+	// as the file system's timestamp resolution may be coarse.
+	require.NoError(t, os.WriteFile(path, []byte("odt_bar\n"), 0o600))
+	require.NoError(t, os.Chtimes(path, time.Now(), time.Now().Add(time.Minute)))
+
+	apiKey, err = provider.APIKey(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "odt_bar", apiKey)
+
+	require.NoError(t, os.Remove(path))
+	_, err = provider.APIKey(context.Background())
+	require.Error(t, err)
+}
@@ -3,7 +3,12 @@ package dtrack
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
 func WithAPIKey(apiKey string) ClientOption {
@@ -27,6 +32,35 @@ func WithAPIKey(apiKey string) ClientOption {
 	}
 }
 
+// APIKeyProvider provides the API key to authenticate with.
+// It is consulted for every request, which allows for keys to be rotated without re-creating the client.
+// Implementations that fetch keys from remote secret stores, like Vault, should cache them accordingly.
+type APIKeyProvider interface {
+	APIKey(ctx context.Context) (string, error)
+}
+
+// WithAPIKeyProvider is like WithAPIKey, but obtains the API key from provider.
+// Refer to EnvAPIKeyProvider and FileAPIKeyProvider for implementations.
+func WithAPIKeyProvider(provider APIKeyProvider) ClientOption {
+	return func(c *Client) error {
+		if provider == nil {
+			return fmt.Errorf("no api key provider provided")
+		}
+
+		currentTransport := c.httpClient.Transport
+		if currentTransport == nil {
+			currentTransport = http.DefaultTransport
+		}
+
+		c.httpClient.Transport = &apiKeyProviderTransport{
+			provider:  provider,
+			transport: currentTransport,
+		}
+
+		return nil
+	}
+}
+
 func WithBearerToken(token string) ClientOption {
 	return func(c *Client) error {
 		if token == "" {
@@ -48,6 +82,143 @@ func WithBearerToken(token string) ClientOption {
 	}
 }
 
+// WithUserCredentials configures the client to authenticate as a managed or LDAP user.
+// The client logs in when performing its first authenticated request, and uses the
+// resulting token for all subsequent requests. When the token expires, the client
+// logs in again.
+func WithUserCredentials(username, password string) ClientOption {
+	return func(c *Client) error {
+		if username == "" {
+			return fmt.Errorf("no username provided")
+		}
+
+		return WithUserCredentialsProvider(staticUserCredentials{username: username, password: password})(c)
+	}
+}
+
+// UserCredentialsProvider provides credentials of a managed or LDAP user.
+// It is consulted whenever the client needs to log in, which allows for credentials to be rotated.
+type UserCredentialsProvider interface {
+	UserCredentials(ctx context.Context) (username, password string, err error)
+}
+
+// WithUserCredentialsProvider is like WithUserCredentials, but obtains credentials from provider.
+func WithUserCredentialsProvider(provider UserCredentialsProvider) ClientOption {
+	return func(c *Client) error {
+		if provider == nil {
+			return fmt.Errorf("no credentials provider provided")
+		}
+
+		withLogin(c, func(ctx context.Context) (string, error) {
+			username, password, err := provider.UserCredentials(ctx)
+			if err != nil {
+				return "", fmt.Errorf("failed to obtain credentials: %w", err)
+			}
+
+			return c.User.Login(ctx, username, password)
+		})
+		return nil
+	}
+}
+
+type staticUserCredentials struct {
+	username string
+	password string
+}
+
+func (s staticUserCredentials) UserCredentials(_ context.Context) (string, string, error) {
+	return s.username, s.password, nil
+}
+
+// WithOIDCTokens configures the client to authenticate as an OIDC user.
+// The client exchanges the given tokens for a Dependency-Track token when performing
+// its first authenticated request, and uses it for all subsequent requests.
+func WithOIDCTokens(tokens OIDCTokens) ClientOption {
+	return func(c *Client) error {
+		if tokens.ID == "" {
+			return fmt.Errorf("no id token provided")
+		}
+
+		withLogin(c, func(ctx context.Context) (string, error) {
+			return c.OIDC.Login(ctx, tokens)
+		})
+		return nil
+	}
+}
+
+// WithOAuth2TokenSource configures the client to authenticate as an OIDC user, using tokens obtained from ts.
+// Tokens must include an ID token, i.e. the "openid" scope must be requested. The tokens are exchanged
+// for a Dependency-Track token, which is renewed using a new token from ts when it expires.
+func WithOAuth2TokenSource(ts oauth2.TokenSource) ClientOption {
+	return func(c *Client) error {
+		if ts == nil {
+			return fmt.Errorf("no token source provided")
+		}
+
+		withLogin(c, func(ctx context.Context) (string, error) {
+			token, err := ts.Token()
+			if err != nil {
+				return "", fmt.Errorf("failed to obtain oauth2 token: %w", err)
+			}
+
+			return c.loginWithOAuth2Token(ctx, token)
+		})
+		return nil
+	}
+}
+
+// WithOIDCClientCredentials configures the client to authenticate as the service account
+// of an OIDC client, using the OAuth2 client credentials flow. The "openid" scope is
+// requested in addition to the scopes configured in config.
+//
+// Tokens are requested using the client's transport, so that options like WithProxyURL,
+// WithMTLS or WithDebugWriter apply to them as well.
+func WithOIDCClientCredentials(config clientcredentials.Config) ClientOption {
+	if !containsString(config.Scopes, "openid") {
+		config.Scopes = append([]string{"openid"}, config.Scopes...)
+	}
+
+	return func(c *Client) error {
+		var login *loginTransport
+		login = withLogin(c, func(ctx context.Context) (string, error) {
+			// The transport wrapped by login is only known once all options have been applied.
+			httpClient := &http.Client{Transport: login.transport, Timeout: c.httpClient.Timeout}
+			token, err := config.Token(context.WithValue(ctx, oauth2.HTTPClient, httpClient))
+			if err != nil {
+				return "", fmt.Errorf("failed to obtain oauth2 token: %w", err)
+			}
+
+			return c.loginWithOAuth2Token(ctx, token)
+		})
+		return nil
+	}
+}
+
+// loginWithOAuth2Token exchanges the ID and access tokens of token for a Dependency-Track token.
+func (c *Client) loginWithOAuth2Token(ctx context.Context, token *oauth2.Token) (string, error) {
+	idToken, _ := token.Extra("id_token").(string)
+	if idToken == "" {
+		return "", fmt.Errorf("oauth2 token does not include an id token")
+	}
+
+	return c.OIDC.Login(ctx, OIDCTokens{ID: idToken, Access: token.AccessToken})
+}
+
+func withLogin(c *Client, loginFunc func(ctx context.Context) (string, error)) *loginTransport {
+	currentTransport := c.httpClient.Transport
+	if currentTransport == nil {
+		currentTransport = http.DefaultTransport
+	}
+
+	login := &loginTransport{
+		login:     loginFunc,
+		transport: currentTransport,
+	}
+	c.httpClient.Transport = login
+
+	return login
+}
+
 const contextKeyNoAuth contextKey = "noauth"
 
 func withoutAuth() requestOption {
@@ -64,12 +235,111 @@ type authHeaderTransport struct {
 	transport http.RoundTripper
 }
 
+func (t *authHeaderTransport) wrappedTransport() *http.RoundTripper {
+	return &t.transport
+}
+
 func (t authHeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	unauthenticated, ok := req.Context().Value(contextKeyNoAuth).(bool)
 	if ok && unauthenticated {
 		return t.transport.RoundTrip(req)
 	}
 
+	return t.transport.RoundTrip(withHeader(req, t.name, t.value))
+}
+
+type apiKeyProviderTransport struct {
+	provider  APIKeyProvider
+	transport http.RoundTripper
+}
+
+func (t *apiKeyProviderTransport) wrappedTransport() *http.RoundTripper {
+	return &t.transport
+}
+
+func (t apiKeyProviderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	unauthenticated, ok := req.Context().Value(contextKeyNoAuth).(bool)
+	if ok && unauthenticated {
+		return t.transport.RoundTrip(req)
+	}
+
+	apiKey, err := t.provider.APIKey(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain api key: %w", err)
+	}
+
+	return t.transport.RoundTrip(withHeader(req, "X-Api-Key", apiKey))
+}
+
+// loginTransport authenticates requests with a bearer token obtained by logging in.
+// Logging in is deferred until the first authenticated request. When a request is rejected
+// with status 401, the token is considered expired, and the request is retried once with a new token.
+type loginTransport struct {
+	login     func(ctx context.Context) (string, error)
+	transport http.RoundTripper
+	mutex     sync.Mutex
+	token     string
+}
+
+func (t *loginTransport) wrappedTransport() *http.RoundTripper {
+	return &t.transport
+}
+
+func (t *loginTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	unauthenticated, ok := req.Context().Value(contextKeyNoAuth).(bool)
+	if ok && unauthenticated {
+		return t.transport.RoundTrip(req)
+	}
+
+	token, err := t.getToken(req.Context(), "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to log in: %w", err)
+	}
+
+	res, err := t.transport.RoundTrip(withHeader(req, "Authorization", fmt.Sprintf("Bearer %s", token)))
+	if err != nil || res.StatusCode != http.StatusUnauthorized || !isReplayable(req) {
+		return res, err
+	}
+
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+
+	token, err = t.getToken(req.Context(), token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to log in: %w", err)
+	}
+
+	retryReq := withHeader(req, "Authorization", fmt.Sprintf("Bearer %s", token))
+	if req.GetBody != nil {
+		retryReq.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t.transport.RoundTrip(retryReq)
+}
+
+// getToken returns the current token, logging in if there is none yet.
+// When expiredToken is not empty and still current, it is replaced by logging in again.
+// This ensures that concurrent requests rejected due to the same expired token only cause a single login.
+func (t *loginTransport) getToken(ctx context.Context, expiredToken string) (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.token == "" || t.token == expiredToken {
+		token, err := t.login(ctx)
+		if err != nil {
+			return "", err
+		}
+		t.token = token
+	}
+
+	return t.token, nil
+}
+
+// withHeader returns a shallow copy of req, with the header name set to value.
+func withHeader(req *http.Request, name, value string) *http.Request {
 	reqCopy := *req // Shallow copy of req
 
 	// Deep copy of request headers, because we'll modify them
@@ -78,7 +348,7 @@ func (t authHeaderTransport) RoundTrip(req *http.Request) (*http.Response, error
 		reqCopy.Header[hn] = append([]string(nil), hv...)
 	}
 
-	reqCopy.Header.Set(t.name, t.value)
+	reqCopy.Header.Set(name, value)
 
-	return t.transport.RoundTrip(&reqCopy)
+	return &reqCopy
 }
@@ -0,0 +1,189 @@
+package dtrack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+func TestWithUserCredentials(t *testing.T) {
+	logins := 0
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/user/login" {
+			if r.Header.Get("Authorization") != "" || r.FormValue("username") != "admin" || r.FormValue("password") != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			logins++
+			_, _ = w.Write([]byte("test-token"))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"test-project"}`))
+	}), WithUserCredentials("admin", "secret"))
+
+	for i := 0; i < 2; i++ {
+		project, err := client.Project.Lookup(context.Background(), "test-project", "1.0.0")
+		require.NoError(t, err)
+		require.Equal(t, "test-project", project.Name)
+	}
+
+	require.Equal(t, 1, logins)
+}
+
+func TestWithUserCredentials_LoginFailure(t *testing.T) {
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}), WithUserCredentials("admin", "wrong"))
+
+	_, err := client.Project.Lookup(context.Background(), "test-project", "1.0.0")
+	require.ErrorContains(t, err, "failed to log in")
+}
+
+func TestWithUserCredentialsProvider_Relogin(t *testing.T) {
+	var (
+		currentToken = "token-1"
+		logins       = 0
+		bodies       []string
+	)
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/user/login" {
+			logins++
+			_, _ = fmt.Fprintf(w, "token-%d", logins)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+currentToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		_, _ = w.Write([]byte(`{"name":"test-project"}`))
+	}), WithUserCredentialsProvider(staticUserCredentials{username: "admin", password: "secret"}))
+
+	_, err := client.Project.Create(context.Background(), Project{Name: "test-project"})
+	require.NoError(t, err)
+	require.Equal(t, 1, logins)
+
+	// Simulate expiry of the token.
+	currentToken = "token-2"
+
+	_, err = client.Project.Create(context.Background(), Project{Name: "test-project"})
+	require.NoError(t, err)
+	require.Equal(t, 2, logins)
+
+	require.Len(t, bodies, 2)
+	require.Equal(t, bodies[0], bodies[1])
+
+	// Simulate the server rejecting any token, which must not result in a retry loop.
+	currentToken = "invalid"
+
+	_, err = client.Project.Create(context.Background(), Project{Name: "test-project"})
+	require.Error(t, err)
+	require.Equal(t, 3, logins)
+}
+
+func TestWithOIDCClientCredentials(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("grant_type") != "client_credentials" || r.FormValue("scope") != "openid" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"access-token","id_token":"id-token","token_type":"Bearer","expires_in":300}`))
+	}))
+	t.Cleanup(tokenServer.Close)
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/user/oidc/login" {
+			if r.FormValue("idToken") != "id-token" || r.FormValue("accessToken") != "access-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte("test-token"))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer test-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"test-project"}`))
+	}), WithOIDCClientCredentials(clientcredentials.Config{
+		ClientID:     "dtrack-client",
+		ClientSecret: "secret",
+		TokenURL:     "http://tokens.example.com/token",
+	}), WithDialContext(func(ctx context.Context, network, addr string) (net.Conn, error) {
+		// Tokens must be requested using the client's transport.
+		if addr == "tokens.example.com:80" {
+			addr = tokenServer.Listener.Addr().String()
+		}
+		return (&net.Dialer{}).DialContext(ctx, network, addr)
+	}))
+
+	project, err := client.Project.Lookup(context.Background(), "test-project", "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "test-project", project.Name)
+}
+
+func TestWithOIDCClientCredentials_Cancel(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body) // Enables detection of closed connections
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(tokenServer.Close)
+
+	client := setUpTestServer(t, "4.11.0", nil, WithOIDCClientCredentials(clientcredentials.Config{
+		ClientID:     "dtrack-client",
+		ClientSecret: "secret",
+		TokenURL:     tokenServer.URL,
+	}))
+
+	// Token requests must be aborted once the context of the request that triggered them is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.Project.Lookup(ctx, "test-project", "1.0.0")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithAPIKeyProvider(t *testing.T) {
+	t.Setenv("DTRACK_TEST_API_KEY", "odt_foo")
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "odt_bar" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"test-project"}`))
+	}), WithAPIKeyProvider(EnvAPIKeyProvider("DTRACK_TEST_API_KEY")))
+
+	_, err := client.Project.Lookup(context.Background(), "test-project", "1.0.0")
+	require.Error(t, err)
+
+	t.Setenv("DTRACK_TEST_API_KEY", "odt_bar")
+
+	_, err = client.Project.Lookup(context.Background(), "test-project", "1.0.0")
+	require.NoError(t, err)
+}
@@ -0,0 +1,53 @@
+package dtrack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+const badgeContentType = "image/svg+xml"
+
+// BadgeService provides access to SVG badges of projects.
+// Badges require the VIEW_BADGES permission.
+//
+// The counts shown by badges are the current metrics of a project,
+// which are available in structured form via MetricsService.LatestProjectMetrics.
+type BadgeService struct {
+	client *Client
+}
+
+// GetProjectVulnerabilitiesBadge fetches a badge showing the vulnerabilities of a project, by severity.
+func (bs BadgeService) GetProjectVulnerabilitiesBadge(ctx context.Context, projectUUID uuid.UUID) ([]byte, error) {
+	return bs.getBadge(ctx, fmt.Sprintf("api/v1/badge/vulns/project/%s", projectUUID))
+}
+
+// GetProjectVulnerabilitiesBadgeByNameVersion is like GetProjectVulnerabilitiesBadge,
+// but identifies the project by its name and version.
+func (bs BadgeService) GetProjectVulnerabilitiesBadgeByNameVersion(ctx context.Context, name, version string) ([]byte, error) {
+	return bs.getBadge(ctx, fmt.Sprintf("api/v1/badge/vulns/project/%s/%s", url.PathEscape(name), url.PathEscape(version)))
+}
+
+// GetProjectPolicyViolationsBadge fetches a badge showing the policy violations of a project, by state.
+func (bs BadgeService) GetProjectPolicyViolationsBadge(ctx context.Context, projectUUID uuid.UUID) ([]byte, error) {
+	return bs.getBadge(ctx, fmt.Sprintf("api/v1/badge/violations/project/%s", projectUUID))
+}
+
+// GetProjectPolicyViolationsBadgeByNameVersion is like GetProjectPolicyViolationsBadge,
+// but identifies the project by its name and version.
+func (bs BadgeService) GetProjectPolicyViolationsBadgeByNameVersion(ctx context.Context, name, version string) ([]byte, error) {
+	return bs.getBadge(ctx, fmt.Sprintf("api/v1/badge/violations/project/%s/%s", url.PathEscape(name), url.PathEscape(version)))
+}
+
+func (bs BadgeService) getBadge(ctx context.Context, path string) (svg []byte, err error) {
+	req, err := bs.client.newRequest(ctx, http.MethodGet, path, withAcceptContentType(badgeContentType))
+	if err != nil {
+		return
+	}
+
+	_, err = bs.client.doRequest(req, &svg)
+	return
+}
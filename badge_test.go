@@ -0,0 +1,25 @@
+package dtrack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadgeService_GetProjectVulnerabilitiesBadgeByNameVersion(t *testing.T) {
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/v1/badge/vulns/project/acme%2Fapp/1.0.0" || r.Header.Get("Accept") != "image/svg+xml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "image/svg+xml")
+		_, _ = w.Write([]byte("<svg></svg>\n"))
+	}))
+
+	svg, err := client.Badge.GetProjectVulnerabilitiesBadgeByNameVersion(context.Background(), "acme/app", "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, []byte("<svg></svg>\n"), svg)
+}
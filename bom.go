@@ -2,8 +2,11 @@ package dtrack
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -48,23 +51,23 @@ const (
 	BOMVariantWithVulnerabilities BOMVariant = "withVulnerabilities"
 )
 
+// ExportComponent exports a BOM describing a single component in the given format.
 func (bs BOMService) ExportComponent(ctx context.Context, componentUUID uuid.UUID, format BOMFormat) (bom string, err error) {
 	params := make(map[string]string)
 	if format != "" {
 		params["format"] = string(format)
 	}
 
-	req, err := bs.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/bom/cyclonedx/component/%s", componentUUID), withParams(params))
+	req, err := bs.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/bom/cyclonedx/component/%s", componentUUID), withParams(params), withAcceptContentType(bomContentType(format)))
 	if err != nil {
 		return
 	}
 
-	req.Header.Set("Accept", "application/vnd.cyclonedx+json")
-
 	_, err = bs.client.doRequest(req, &bom)
 	return
 }
 
+// ExportProject exports the BOM of a given project in the given format.
 func (bs BOMService) ExportProject(ctx context.Context, projectUUID uuid.UUID, format BOMFormat, variant BOMVariant) (bom string, err error) {
 	params := make(map[string]string)
 	if format != "" {
@@ -74,17 +77,46 @@ func (bs BOMService) ExportProject(ctx context.Context, projectUUID uuid.UUID, f
 		params["variant"] = string(variant)
 	}
 
-	req, err := bs.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/bom/cyclonedx/project/%s", projectUUID), withParams(params))
+	req, err := bs.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/bom/cyclonedx/project/%s", projectUUID), withParams(params), withAcceptContentType(bomContentType(format)))
 	if err != nil {
 		return
 	}
 
-	req.Header.Set("Accept", "application/vnd.cyclonedx+json")
-
 	_, err = bs.client.doRequest(req, &bom)
 	return
 }
 
+// ExportProjectJSON exports the BOM of a given project in JSON format,
+// and decodes it into the value pointed to by v, e.g. a *cdx.BOM
+// from github.com/CycloneDX/cyclonedx-go.
+func (bs BOMService) ExportProjectJSON(ctx context.Context, projectUUID uuid.UUID, variant BOMVariant, v interface{}) (err error) {
+	params := map[string]string{
+		"format": string(BOMFormatJSON),
+	}
+	if variant != "" {
+		params["variant"] = string(variant)
+	}
+
+	req, err := bs.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/bom/cyclonedx/project/%s", projectUUID), withParams(params), withAcceptContentType(bomContentType(BOMFormatJSON)))
+	if err != nil {
+		return
+	}
+
+	_, err = bs.client.doRequest(req, v)
+	return
+}
+
+func bomContentType(format BOMFormat) string {
+	if format == BOMFormatXML {
+		return "application/vnd.cyclonedx+xml"
+	}
+
+	return "application/vnd.cyclonedx+json"
+}
+
+// Upload uploads a BOM using the PUT endpoint, which expects the BOM to be base64 encoded.
+// The returned token may be used to check whether the BOM is still being processed.
+// Since v4.12.0, BOMs rejected by the server's validation are reported as *BOMValidationError.
 func (bs BOMService) Upload(ctx context.Context, uploadReq BOMUploadRequest) (token BOMUploadToken, err error) {
 	req, err := bs.client.newRequest(ctx, http.MethodPut, "api/v1/bom", withBody(uploadReq))
 	if err != nil {
@@ -94,6 +126,7 @@ func (bs BOMService) Upload(ctx context.Context, uploadReq BOMUploadRequest) (to
 	var uploadRes bomUploadResponse
 	_, err = bs.client.doRequest(req, &uploadRes)
 	if err != nil {
+		err = asBOMValidationError(err)
 		return
 	}
 
@@ -101,7 +134,64 @@ func (bs BOMService) Upload(ctx context.Context, uploadReq BOMUploadRequest) (to
 	return
 }
 
+// UploadJSON encodes bom as JSON and uploads it using the PUT endpoint.
+// Any JSON-serializable BOM representation may be provided, including *cdx.BOM
+// from github.com/CycloneDX/cyclonedx-go. The BOM field of uploadReq is ignored.
+func (bs BOMService) UploadJSON(ctx context.Context, uploadReq BOMUploadRequest, bom interface{}) (token BOMUploadToken, err error) {
+	bomJSON, err := json.Marshal(bom)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode bom: %w", err)
+	}
+
+	uploadReq.BOM = base64.StdEncoding.EncodeToString(bomJSON)
+	return bs.Upload(ctx, uploadReq)
+}
+
+// PostBom uploads a BOM using the POST endpoint, which accepts the BOM as multipart form data.
+// Contrary to Upload, the BOM must not be base64 encoded.
 func (bs BOMService) PostBom(ctx context.Context, uploadReq BOMUploadRequest) (token BOMUploadToken, err error) {
+	params := bomUploadParams(uploadReq)
+	if uploadReq.BOM != "" {
+		params["bom"] = append(params["bom"], uploadReq.BOM)
+	}
+
+	req, err := bs.client.newRequest(ctx, http.MethodPost, "api/v1/bom", withMultiPart(params))
+	if err != nil {
+		return
+	}
+
+	var uploadRes bomUploadResponse
+	_, err = bs.client.doRequest(req, &uploadRes)
+	if err != nil {
+		err = asBOMValidationError(err)
+		return
+	}
+
+	token = uploadRes.Token
+	return
+}
+
+// PostBomStream uploads a BOM read from bom using the POST endpoint.
+// Contrary to PostBom, the BOM is streamed to the server instead of being buffered in memory,
+// which makes this method suitable for very large BOMs. The BOM field of uploadReq is ignored.
+func (bs BOMService) PostBomStream(ctx context.Context, uploadReq BOMUploadRequest, bom io.Reader) (token BOMUploadToken, err error) {
+	req, err := bs.client.newRequest(ctx, http.MethodPost, "api/v1/bom", withMultiPartStream(bomUploadParams(uploadReq), "bom", bom))
+	if err != nil {
+		return
+	}
+
+	var uploadRes bomUploadResponse
+	_, err = bs.client.doRequest(req, &uploadRes)
+	if err != nil {
+		err = asBOMValidationError(err)
+		return
+	}
+
+	token = uploadRes.Token
+	return
+}
+
+func bomUploadParams(uploadReq BOMUploadRequest) url.Values {
 	params := make(url.Values)
 	if uploadReq.ProjectUUID != nil {
 		params["project"] = append(params["project"], uploadReq.ProjectUUID.String())
@@ -134,23 +224,8 @@ func (bs BOMService) PostBom(ctx context.Context, uploadReq BOMUploadRequest) (t
 	if uploadReq.ParentVersion != "" {
 		params["parentVersion"] = append(params["parentVersion"], uploadReq.ParentVersion)
 	}
-	if uploadReq.BOM != "" {
-		params["bom"] = append(params["bom"], uploadReq.BOM)
-	}
-
-	req, err := bs.client.newRequest(ctx, http.MethodPost, "api/v1/bom", withMultiPart(params))
-	if err != nil {
-		return
-	}
-
-	var uploadRes bomUploadResponse
-	_, err = bs.client.doRequest(req, &uploadRes)
-	if err != nil {
-		return
-	}
 
-	token = uploadRes.Token
-	return
+	return params
 }
 
 type bomProcessingResponse struct {
@@ -178,3 +253,12 @@ func (bs BOMService) IsBeingProcessed(ctx context.Context, token BOMUploadToken)
 
 	return processingResponse.Processing, nil
 }
+
+// WaitForProcessing blocks until the BOM associated with a given token has been processed.
+// It returns an error when polling fails, the timeout configured in opts is exceeded, or ctx is done.
+func (bs BOMService) WaitForProcessing(ctx context.Context, token BOMUploadToken, opts PollingOptions) error {
+	return poll(ctx, bs.client.clock, opts, func(ctx context.Context) (bool, error) {
+		processing, err := bs.IsBeingProcessed(ctx, token)
+		return !processing, err
+	})
+}
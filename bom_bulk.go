@@ -0,0 +1,142 @@
+package dtrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// DefaultBOMFilePatterns matches files named according to the CycloneDX recommendations.
+var DefaultBOMFilePatterns = []string{"bom.json", "bom.xml", "*.cdx.json", "*.cdx.xml"}
+
+type BulkUploadOptions struct {
+	// Patterns of file names to upload, as understood by filepath.Match.
+	// Defaults to DefaultBOMFilePatterns.
+	Patterns []string
+
+	// Concurrency is the maximum number of uploads to perform at a time, defaults to 1.
+	Concurrency int
+
+	// UploadRequest derives the upload request for the BOM in file path.
+	// The BOM field of the returned request is ignored.
+	// Defaults to using the name and version of the BOM's metadata.component,
+	// and creating the project if it doesn't exist yet.
+	UploadRequest func(path string, bom []byte) (BOMUploadRequest, error)
+}
+
+// BOMUploadResult is the result of uploading a single BOM file.
+type BOMUploadResult struct {
+	Path  string
+	Token BOMUploadToken
+}
+
+// UploadDir uploads all BOM files in dir and its subdirectories, whose names match opts.Patterns.
+// Results are returned in lexical order of the files' paths. If uploading any of the files fails,
+// a *BulkError[string] is returned along with the results of the successful uploads,
+// holding the errors keyed by the path of the respective file.
+func (bs BOMService) UploadDir(ctx context.Context, dir string, opts BulkUploadOptions) (results []BOMUploadResult, err error) {
+	patterns := opts.Patterns
+	if len(patterns) == 0 {
+		patterns = DefaultBOMFilePatterns
+	}
+	uploadRequest := opts.UploadRequest
+	if uploadRequest == nil {
+		uploadRequest = uploadRequestFromMetadata
+	}
+
+	var paths []string
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		for _, pattern := range patterns {
+			matched, err := filepath.Match(pattern, d.Name())
+			if err != nil {
+				return err
+			}
+			if matched {
+				paths = append(paths, path)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find bom files: %w", err)
+	}
+
+	uploads := make([]*BOMUploadResult, len(paths))
+	for i := range paths {
+		uploads[i] = &BOMUploadResult{Path: paths[i]}
+	}
+
+	errs := forEachConcurrently(ctx, uploads, opts.Concurrency, func(ctx context.Context, upload *BOMUploadResult) error {
+		bom, err := os.ReadFile(upload.Path)
+		if err != nil {
+			return err
+		}
+
+		uploadReq, err := uploadRequest(upload.Path, bom)
+		if err != nil {
+			return err
+		}
+		uploadReq.BOM = string(bom)
+
+		upload.Token, err = bs.PostBom(ctx, uploadReq)
+		return err
+	})
+
+	bulkErr := &BulkError[string]{Errors: make(map[string]error)}
+	for i, err := range errs {
+		if err != nil {
+			bulkErr.Errors[paths[i]] = err
+		} else {
+			results = append(results, *uploads[i])
+		}
+	}
+	if len(bulkErr.Errors) > 0 {
+		return results, bulkErr
+	}
+
+	return results, nil
+}
+
+type bomMetadata struct {
+	Metadata struct {
+		Component struct {
+			Name    string `json:"name" xml:"name"`
+			Version string `json:"version" xml:"version"`
+		} `json:"component" xml:"component"`
+	} `json:"metadata" xml:"metadata"`
+}
+
+func uploadRequestFromMetadata(path string, bom []byte) (BOMUploadRequest, error) {
+	var (
+		metadata bomMetadata
+		err      error
+	)
+	if trimmed := bytes.TrimSpace(bom); len(trimmed) > 0 && trimmed[0] == '<' {
+		err = xml.Unmarshal(bom, &metadata)
+	} else {
+		err = json.Unmarshal(bom, &metadata)
+	}
+	if err != nil {
+		return BOMUploadRequest{}, fmt.Errorf("failed to decode bom metadata: %w", err)
+	}
+
+	component := metadata.Metadata.Component
+	if component.Name == "" {
+		return BOMUploadRequest{}, fmt.Errorf("bom %s does not specify a component name in its metadata", path)
+	}
+
+	return BOMUploadRequest{
+		ProjectName:    component.Name,
+		ProjectVersion: component.Version,
+		AutoCreate:     true,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package dtrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBOMService_UploadDir(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	writeFile("services/a/bom.json", `{"bomFormat":"CycloneDX","metadata":{"component":{"name":"acme-a","version":"1.0.0"}}}`)
+	writeFile("services/b/b.cdx.xml", `<?xml version="1.0"?><bom xmlns="http://cyclonedx.org/schema/bom/1.5"><metadata><component type="application"><name>acme-b</name><version>2.0.0</version></component></metadata></bom>`)
+	writeFile("services/c/bom.json", `{"bomFormat":"CycloneDX"}`)
+	writeFile("services/c/package.json", `{"name":"acme-c"}`)
+
+	client := setUpTestServer(t, "4.12.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/v1/bom", r.URL.Path)
+
+		err := r.ParseMultipartForm(1024)
+		require.NoError(t, err)
+		require.Equal(t, "true", r.FormValue("autoCreate"))
+		require.NotEmpty(t, r.FormValue("bom"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"token":"%s-%s"}`, r.FormValue("projectName"), r.FormValue("projectVersion"))
+	}))
+
+	results, err := client.BOM.UploadDir(context.Background(), dir, BulkUploadOptions{Concurrency: 2})
+	require.Equal(t, []BOMUploadResult{
+		{Path: filepath.Join(dir, "services/a/bom.json"), Token: "acme-a-1.0.0"},
+		{Path: filepath.Join(dir, "services/b/b.cdx.xml"), Token: "acme-b-2.0.0"},
+	}, results)
+
+	var bulkErr *BulkError[string]
+	require.True(t, errors.As(err, &bulkErr))
+	require.Len(t, bulkErr.Errors, 1)
+	require.Contains(t, bulkErr.Errors, filepath.Join(dir, "services/c/bom.json"))
+}
+
+func TestBOMService_UploadDir_UploadRequest(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "acme.cdx.json"), []byte(`{"bomFormat":"CycloneDX"}`), 0o644))
+
+	client := setUpTestServer(t, "4.12.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseMultipartForm(1024)
+		require.NoError(t, err)
+		require.Equal(t, "acme", r.FormValue("projectName"))
+		require.Equal(t, "main", r.FormValue("projectVersion"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"token":"token"}`))
+	}))
+
+	results, err := client.BOM.UploadDir(context.Background(), dir, BulkUploadOptions{
+		UploadRequest: func(path string, _ []byte) (BOMUploadRequest, error) {
+			return BOMUploadRequest{ProjectName: "acme", ProjectVersion: "main"}, nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []BOMUploadResult{{Path: filepath.Join(dir, "acme.cdx.json"), Token: "token"}}, results)
+}
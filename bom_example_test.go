@@ -29,42 +29,16 @@ func Example_uploadBOM() {
 		panic(err)
 	}
 
-	var (
-		doneChan = make(chan struct{})
-		errChan  = make(chan error)
-		ticker   = time.NewTicker(1 * time.Second)
-		timeout  = time.After(30 * time.Second)
-	)
-
-	go func() {
-		defer func() {
-			close(doneChan)
-			close(errChan)
-		}()
-
-		for {
-			select {
-			case <-ticker.C:
-				processing, err := client.Event.IsBeingProcessed(context.TODO(), dtrack.EventToken(uploadToken))
-				if err != nil {
-					errChan <- err
-					return
-				}
-				if !processing {
-					doneChan <- struct{}{}
-					return
-				}
-			case <-timeout:
-				errChan <- fmt.Errorf("timeout exceeded")
-				return
-			}
-		}
-	}()
-
-	select {
-	case <-doneChan:
-		fmt.Println("bom processing completed")
-	case err = <-errChan:
+	err = client.BOM.WaitForProcessing(context.TODO(), uploadToken, dtrack.PollingOptions{
+		Interval:    1 * time.Second,
+		MaxInterval: 5 * time.Second,
+		Multiplier:  1.5,
+		Timeout:     30 * time.Second,
+	})
+	if err != nil {
 		fmt.Printf("failed to wait for bom processing: %v\n", err)
+		return
 	}
+
+	fmt.Println("bom processing completed")
 }
@@ -3,8 +3,14 @@ package dtrack
 import (
 	"context"
 	"encoding/base64"
-	"github.com/stretchr/testify/require"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBOMService_Upload(t *testing.T) {
@@ -78,3 +84,92 @@ func TestBOMService_PostBom(t *testing.T) {
 	require.NotNil(t, project.IsLatest)
 	require.True(t, *project.IsLatest)
 }
+
+func TestBOMService_PostBomStream(t *testing.T) {
+	client := setUpTestServer(t, "4.12.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/v1/bom", r.URL.Path)
+
+		err := r.ParseMultipartForm(1024)
+		require.NoError(t, err)
+		require.Equal(t, "acme-app", r.FormValue("projectName"))
+		require.Equal(t, "1.2.3", r.FormValue("projectVersion"))
+		require.Equal(t, "true", r.FormValue("autoCreate"))
+
+		file, _, err := r.FormFile("bom")
+		require.NoError(t, err)
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		require.Equal(t, `{"bomFormat":"CycloneDX"}`, string(content))
+
+		_, _ = w.Write([]byte(`{"token":"foo"}`))
+	}))
+
+	token, err := client.BOM.PostBomStream(context.Background(), BOMUploadRequest{
+		ProjectName:    "acme-app",
+		ProjectVersion: "1.2.3",
+		AutoCreate:     true,
+	}, strings.NewReader(`{"bomFormat":"CycloneDX"}`))
+	require.NoError(t, err)
+	require.Equal(t, BOMUploadToken("foo"), token)
+}
+
+// testBOM is a minimal typed BOM representation, like *cdx.BOM from github.com/CycloneDX/cyclonedx-go.
+type testBOM struct {
+	BOMFormat   string `json:"bomFormat"`
+	SpecVersion string `json:"specVersion"`
+	Components  []struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"components"`
+}
+
+func TestBOMService_UploadJSON(t *testing.T) {
+	projectUUID := uuid.New()
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/api/v1/bom", r.URL.Path)
+
+		var uploadReq BOMUploadRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&uploadReq))
+		require.Equal(t, &projectUUID, uploadReq.ProjectUUID)
+
+		bom, err := base64.StdEncoding.DecodeString(uploadReq.BOM)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"bomFormat":"CycloneDX","specVersion":"1.5","components":[{"name":"acme-lib","version":"1.0.0"}]}`, string(bom))
+
+		_ = json.NewEncoder(w).Encode(bomUploadResponse{Token: "token"})
+	}))
+
+	var bom testBOM
+	require.NoError(t, json.Unmarshal([]byte(`{"bomFormat":"CycloneDX","specVersion":"1.5","components":[{"name":"acme-lib","version":"1.0.0"}]}`), &bom))
+
+	token, err := client.BOM.UploadJSON(context.Background(), BOMUploadRequest{ProjectUUID: &projectUUID, BOM: "ignored"}, &bom)
+	require.NoError(t, err)
+	require.Equal(t, BOMUploadToken("token"), token)
+
+	_, err = client.BOM.UploadJSON(context.Background(), BOMUploadRequest{ProjectUUID: &projectUUID}, func() {})
+	require.Error(t, err)
+}
+
+func TestBOMService_ExportProjectJSON(t *testing.T) {
+	projectUUID := uuid.New()
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/v1/bom/cyclonedx/project/"+projectUUID.String(), r.URL.Path)
+		require.Equal(t, "format=JSON&variant=withVulnerabilities", r.URL.RawQuery)
+		require.Equal(t, "application/vnd.cyclonedx+json", r.Header.Get("Accept"))
+
+		w.Header().Set("Content-Type", "application/vnd.cyclonedx+json")
+		_, _ = w.Write([]byte(`{"bomFormat":"CycloneDX","specVersion":"1.5","components":[{"name":"acme-lib","version":"1.0.0"}]}`))
+	}))
+
+	var bom testBOM
+	err := client.BOM.ExportProjectJSON(context.Background(), projectUUID, BOMVariantWithVulnerabilities, &bom)
+	require.NoError(t, err)
+	require.Equal(t, "CycloneDX", bom.BOMFormat)
+	require.Len(t, bom.Components, 1)
+	require.Equal(t, "acme-lib", bom.Components[0].Name)
+}
@@ -0,0 +1,171 @@
+package dtrack
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// BOMValidationError describes why a BOM was considered invalid,
+// either by ValidateBOM, or by the server during upload (since v4.12.0).
+type BOMValidationError struct {
+	StatusCode int      `json:"status"`
+	Title      string   `json:"title"`
+	Detail     string   `json:"detail"`
+	Errors     []string `json:"errors"`
+}
+
+func (e BOMValidationError) Error() string {
+	msg := e.Title
+	if e.Detail != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Detail)
+	}
+	if len(e.Errors) > 0 {
+		msg = fmt.Sprintf("%s (%s)", msg, strings.Join(e.Errors, "; "))
+	}
+	if e.StatusCode != 0 {
+		msg = fmt.Sprintf("%s (status: %d)", msg, e.StatusCode)
+	}
+	return msg
+}
+
+// asBOMValidationError converts err to a *BOMValidationError if it represents
+// a server response for a BOM that failed validation. Otherwise, err is returned as-is.
+func asBOMValidationError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		return err
+	}
+
+	var validationErr BOMValidationError
+	if jsonErr := json.Unmarshal([]byte(apiErr.Message), &validationErr); jsonErr != nil || len(validationErr.Errors) == 0 {
+		return err
+	}
+	if validationErr.StatusCode == 0 {
+		validationErr.StatusCode = apiErr.StatusCode
+	}
+
+	return &validationErr
+}
+
+var (
+	bomSpecVersions     = []string{"1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6"}
+	bomJSONSpecVersions = []string{"1.2", "1.3", "1.4", "1.5", "1.6"}
+	bomSerialNumberRe   = regexp.MustCompile(`^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+)
+
+// ValidateBOM performs a lightweight structural validation of a CycloneDX BOM in JSON or XML format.
+// It catches common mistakes before uploading a BOM, but does not perform a full schema validation.
+// Validation failures are reported as *BOMValidationError.
+func ValidateBOM(bom []byte) error {
+	trimmed := bytes.TrimSpace(bom)
+	if len(trimmed) == 0 {
+		return &BOMValidationError{Title: "bom is invalid", Detail: "bom is empty"}
+	}
+
+	var validationErrs []string
+	switch trimmed[0] {
+	case '{':
+		validationErrs = validateBOMJSON(trimmed)
+	case '<':
+		validationErrs = validateBOMXML(trimmed)
+	default:
+		return &BOMValidationError{Title: "bom is invalid", Detail: "bom is neither JSON nor XML"}
+	}
+
+	if len(validationErrs) > 0 {
+		return &BOMValidationError{Title: "bom is invalid", Errors: validationErrs}
+	}
+
+	return nil
+}
+
+func validateBOMJSON(bom []byte) (validationErrs []string) {
+	var doc struct {
+		BOMFormat    string `json:"bomFormat"`
+		SpecVersion  string `json:"specVersion"`
+		SerialNumber string `json:"serialNumber"`
+		Version      *int   `json:"version"`
+		Components   []struct {
+			Type string `json:"type"`
+			Name string `json:"name"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(bom, &doc); err != nil {
+		return []string{fmt.Sprintf("failed to parse json: %v", err)}
+	}
+
+	if doc.BOMFormat != "CycloneDX" {
+		validationErrs = append(validationErrs, fmt.Sprintf("bomFormat must be \"CycloneDX\", but is %q", doc.BOMFormat))
+	}
+	if !containsString(bomJSONSpecVersions, doc.SpecVersion) {
+		validationErrs = append(validationErrs, fmt.Sprintf("specVersion %q is not supported for json", doc.SpecVersion))
+	}
+	if doc.SerialNumber != "" && !bomSerialNumberRe.MatchString(doc.SerialNumber) {
+		validationErrs = append(validationErrs, fmt.Sprintf("serialNumber %q is not a valid uuid urn", doc.SerialNumber))
+	}
+	if doc.Version != nil && *doc.Version < 1 {
+		validationErrs = append(validationErrs, fmt.Sprintf("version must be at least 1, but is %d", *doc.Version))
+	}
+	for i, component := range doc.Components {
+		if component.Type == "" {
+			validationErrs = append(validationErrs, fmt.Sprintf("components[%d]: type is required", i))
+		}
+		if component.Name == "" {
+			validationErrs = append(validationErrs, fmt.Sprintf("components[%d]: name is required", i))
+		}
+	}
+
+	return
+}
+
+func validateBOMXML(bom []byte) (validationErrs []string) {
+	var doc struct {
+		XMLName      xml.Name
+		SerialNumber string `xml:"serialNumber,attr"`
+		Components   struct {
+			Components []struct {
+				Type string `xml:"type,attr"`
+				Name string `xml:"name"`
+			} `xml:"component"`
+		} `xml:"components"`
+	}
+	if err := xml.Unmarshal(bom, &doc); err != nil {
+		return []string{fmt.Sprintf("failed to parse xml: %v", err)}
+	}
+
+	if doc.XMLName.Local != "bom" {
+		validationErrs = append(validationErrs, fmt.Sprintf("root element must be \"bom\", but is %q", doc.XMLName.Local))
+	}
+	if specVersion := strings.TrimPrefix(doc.XMLName.Space, "http://cyclonedx.org/schema/bom/"); specVersion == doc.XMLName.Space || !containsString(bomSpecVersions, specVersion) {
+		validationErrs = append(validationErrs, fmt.Sprintf("namespace %q is not a supported cyclonedx namespace", doc.XMLName.Space))
+	}
+	if doc.SerialNumber != "" && !bomSerialNumberRe.MatchString(doc.SerialNumber) {
+		validationErrs = append(validationErrs, fmt.Sprintf("serialNumber %q is not a valid uuid urn", doc.SerialNumber))
+	}
+	for i, component := range doc.Components.Components {
+		if component.Type == "" {
+			validationErrs = append(validationErrs, fmt.Sprintf("components[%d]: type is required", i))
+		}
+		if component.Name == "" {
+			validationErrs = append(validationErrs, fmt.Sprintf("components[%d]: name is required", i))
+		}
+	}
+
+	return
+}
+
+func containsString(values []string, value string) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,83 @@
+package dtrack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBOM(t *testing.T) {
+	testCases := []struct {
+		name       string
+		bom        string
+		wantErrors []string
+	}{
+		{
+			name: "valid json",
+			bom:  `{"bomFormat":"CycloneDX","specVersion":"1.5","version":1,"components":[{"type":"library","name":"foo"}]}`,
+		},
+		{
+			name: "valid xml",
+			bom:  `<?xml version="1.0"?><bom xmlns="http://cyclonedx.org/schema/bom/1.4" version="1"><components><component type="library"><name>foo</name></component></components></bom>`,
+		},
+		{
+			name: "invalid json",
+			bom:  `{"bomFormat":"SPDX","specVersion":"1.1","version":0,"components":[{"name":"foo"}]}`,
+			wantErrors: []string{
+				`bomFormat must be "CycloneDX", but is "SPDX"`,
+				`specVersion "1.1" is not supported for json`,
+				`version must be at least 1, but is 0`,
+				`components[0]: type is required`,
+			},
+		},
+		{
+			name: "invalid xml",
+			bom:  `<bom xmlns="http://example.com" serialNumber="foo"><components><component type="library"/></components></bom>`,
+			wantErrors: []string{
+				`namespace "http://example.com" is not a supported cyclonedx namespace`,
+				`serialNumber "foo" is not a valid uuid urn`,
+				`components[0]: name is required`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateBOM([]byte(tc.bom))
+			if len(tc.wantErrors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var validationErr *BOMValidationError
+			require.True(t, errors.As(err, &validationErr))
+			require.Equal(t, tc.wantErrors, validationErr.Errors)
+		})
+	}
+}
+
+func TestValidateBOM_UnknownFormat(t *testing.T) {
+	var validationErr *BOMValidationError
+	require.True(t, errors.As(ValidateBOM([]byte("foo")), &validationErr))
+	require.Equal(t, "bom is neither JSON nor XML", validationErr.Detail)
+}
+
+func TestBOMService_Upload_ValidationError(t *testing.T) {
+	client := setUpTestServer(t, "4.12.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":400,"title":"The uploaded BOM is invalid","detail":"Schema validation failed","errors":["$.components[0].name: is missing but it is required"]}`))
+	}))
+
+	_, err := client.BOM.Upload(context.Background(), BOMUploadRequest{BOM: "foo"})
+
+	var validationErr *BOMValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.Equal(t, http.StatusBadRequest, validationErr.StatusCode)
+	require.Equal(t, "The uploaded BOM is invalid", validationErr.Title)
+	require.Equal(t, "Schema validation failed", validationErr.Detail)
+	require.Equal(t, []string{"$.components[0].name: is missing but it is required"}, validationErr.Errors)
+}
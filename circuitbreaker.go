@@ -0,0 +1,91 @@
+package dtrack
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const DefaultCircuitBreakerOpenDuration = 30 * time.Second
+
+// ErrCircuitOpen is returned for requests that were not sent, because the circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreakerOptions configures the circuit breaker of the client.
+//
+// After FailureThreshold consecutive requests failed with network errors or 5xx statuses,
+// the circuit breaker opens, and requests fail with ErrCircuitOpen without being sent.
+// Once OpenDuration elapsed, a single probing request is let through. If it succeeds,
+// the circuit breaker closes again, otherwise it remains open for another OpenDuration.
+type CircuitBreakerOptions struct {
+	FailureThreshold int           // Number of consecutive failures after which the circuit breaker opens
+	OpenDuration     time.Duration // Duration before probing whether the server recovered, defaults to DefaultCircuitBreakerOpenDuration
+}
+
+// WithCircuitBreaker configures the client to fail fast while the server is unavailable.
+func WithCircuitBreaker(opts CircuitBreakerOptions) ClientOption {
+	return func(c *Client) error {
+		if opts.FailureThreshold < 1 {
+			return errors.New("failure threshold must be at least 1")
+		}
+		if opts.OpenDuration <= 0 {
+			opts.OpenDuration = DefaultCircuitBreakerOpenDuration
+		}
+
+		c.circuitBreaker = &circuitBreaker{opts: opts}
+		return nil
+	}
+}
+
+type circuitBreaker struct {
+	opts     CircuitBreakerOptions
+	mutex    sync.Mutex
+	failures int       // Number of consecutive failures
+	openedAt time.Time // Zero while closed
+	probing  bool      // Whether a probing request is in flight
+}
+
+// allow determines whether a request may be sent.
+func (cb *circuitBreaker) allow(now time.Time) error {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	if cb.openedAt.IsZero() {
+		return nil
+	}
+	if cb.probing || now.Sub(cb.openedAt) < cb.opts.OpenDuration {
+		return ErrCircuitOpen
+	}
+
+	cb.probing = true
+	return nil
+}
+
+// done records the outcome of a request that was allowed to be sent.
+func (cb *circuitBreaker) done(now time.Time, failed bool) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	probe := cb.probing
+	cb.probing = false
+
+	if !failed {
+		cb.failures = 0
+		cb.openedAt = time.Time{}
+		return
+	}
+
+	cb.failures++
+	if probe || cb.failures >= cb.opts.FailureThreshold {
+		cb.openedAt = now
+	}
+}
+
+// cancel records that a request that was allowed to be sent was cancelled,
+// which says nothing about the server's health.
+func (cb *circuitBreaker) cancel() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	cb.probing = false
+}
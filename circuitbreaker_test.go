@@ -0,0 +1,103 @@
+package dtrack
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	cb := &circuitBreaker{opts: CircuitBreakerOptions{FailureThreshold: 2, OpenDuration: time.Minute}}
+
+	require.NoError(t, cb.allow(now))
+	cb.done(now, true)
+	require.NoError(t, cb.allow(now))
+	cb.done(now, false) // Success resets consecutive failures
+
+	require.NoError(t, cb.allow(now))
+	cb.done(now, true)
+	require.NoError(t, cb.allow(now))
+	cb.done(now, true)
+
+	// Open
+	require.ErrorIs(t, cb.allow(now), ErrCircuitOpen)
+	require.ErrorIs(t, cb.allow(now.Add(59*time.Second)), ErrCircuitOpen)
+
+	// Half-open, only a single probe is allowed
+	now = now.Add(time.Minute)
+	require.NoError(t, cb.allow(now))
+	require.ErrorIs(t, cb.allow(now), ErrCircuitOpen)
+
+	// Failed probe re-opens
+	cb.done(now, true)
+	require.ErrorIs(t, cb.allow(now.Add(time.Second)), ErrCircuitOpen)
+
+	// Cancelled probe allows another probe
+	now = now.Add(time.Minute)
+	require.NoError(t, cb.allow(now))
+	cb.cancel()
+	require.NoError(t, cb.allow(now))
+
+	// Successful probe closes
+	cb.done(now, false)
+	require.NoError(t, cb.allow(now))
+	require.NoError(t, cb.allow(now))
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	requests := 0
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	}), WithCircuitBreaker(CircuitBreakerOptions{FailureThreshold: 2}))
+
+	for i := 0; i < 2; i++ {
+		_, err := client.Metrics.LatestPortfolioMetrics(context.Background())
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+	}
+
+	_, err := client.Metrics.LatestPortfolioMetrics(context.Background())
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Equal(t, 2, requests)
+}
+
+type closeRecordingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *closeRecordingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestWithCircuitBreaker_ClosesRequestBody(t *testing.T) {
+	var bodies []*closeRecordingBody
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}), WithCircuitBreaker(CircuitBreakerOptions{FailureThreshold: 1}), WithRequestMiddleware(func(req *http.Request) error {
+		if req.Body != nil {
+			body := &closeRecordingBody{Reader: req.Body}
+			bodies = append(bodies, body)
+			req.Body = body
+		}
+		return nil
+	}))
+
+	_, err := client.Metrics.LatestPortfolioMetrics(context.Background())
+	require.Error(t, err)
+
+	// The body of streamed uploads is written by a goroutine, which blocks until the body is closed.
+	_, err = client.BOM.PostBomStream(context.Background(), BOMUploadRequest{ProjectName: "acme-app"}, strings.NewReader("{}"))
+	require.ErrorIs(t, err, ErrCircuitOpen)
+	require.Len(t, bodies, 1)
+	require.True(t, bodies[0].closed)
+}
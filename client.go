@@ -10,15 +10,17 @@ import (
 	"fmt"
 	"golang.org/x/mod/semver"
 	"io"
-	"log"
 	"mime/multipart"
+	"net"
 	"net/http"
-	"net/http/httputil"
 	"net/url"
 	"os"
 	"strconv"
 	"strings"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -29,15 +31,27 @@ const (
 type contextKey string
 
 type Client struct {
-	httpClient *http.Client
-	baseURL    *url.URL
-	userAgent  string
-	debug      bool
-	about      About
+	httpClient  *http.Client
+	transport   *http.Transport // Transport created by the client, see configureTransport.
+	baseURL     *url.URL
+	userAgent   string
+	debugWriter io.Writer
+	about       About
+
+	retryOptions   RetryOptions
+	rateLimiter    *rateLimiter
+	circuitBreaker *circuitBreaker
+	tracer         trace.Tracer
+	logger         requestLogger
+	clock          Clock
+
+	requestMiddleware []RequestMiddleware
+	responseHooks     []ResponseHook
 
 	About             AboutService
 	ACL               ACLService
 	Analysis          AnalysisService
+	Badge             BadgeService
 	BOM               BOMService
 	Component         ComponentService
 	Config            ConfigService
@@ -46,6 +60,7 @@ type Client struct {
 	Health            HealthService
 	LDAP              LDAPService
 	License           LicenseService
+	LicenseGroup      LicenseGroupService
 	Metrics           MetricsService
 	OIDC              OIDCService
 	Permission        PermissionService
@@ -79,7 +94,7 @@ func NewClient(baseURL string, options ...ClientOption) (*Client, error) {
 			Timeout: DefaultTimeout,
 		},
 		userAgent: DefaultUserAgent,
-		debug:     false,
+		clock:     systemClock{},
 	}
 
 	for _, option := range options {
@@ -88,9 +103,15 @@ func NewClient(baseURL string, options ...ClientOption) (*Client, error) {
 		}
 	}
 
+	if client.debugWriter != nil {
+		transport := client.innermostTransport()
+		*transport = &dumpTransport{transport: *transport, w: client.debugWriter}
+	}
+
 	client.About = AboutService{client: &client}
 	client.ACL = ACLService{client: &client}
 	client.Analysis = AnalysisService{client: &client}
+	client.Badge = BadgeService{client: &client}
 	client.BOM = BOMService{client: &client}
 	client.Component = ComponentService{client: &client}
 	client.Config = ConfigService{client: &client}
@@ -99,6 +120,7 @@ func NewClient(baseURL string, options ...ClientOption) (*Client, error) {
 	client.Health = HealthService{client: &client}
 	client.LDAP = LDAPService{client: &client}
 	client.License = LicenseService{client: &client}
+	client.LicenseGroup = LicenseGroupService{client: &client}
 	client.Metrics = MetricsService{client: &client}
 	client.OIDC = OIDCService{client: &client}
 	client.Permission = PermissionService{client: &client}
@@ -209,7 +231,7 @@ func withBody(body interface{}) requestOption {
 
 		var (
 			contentType string
-			bodyBuf     io.ReadWriter
+			bodyBuf     *bytes.Buffer
 		)
 
 		switch body := body.(type) {
@@ -234,7 +256,7 @@ func withBody(body interface{}) requestOption {
 			contentType = "application/json"
 		}
 
-		req.Body = io.NopCloser(bodyBuf)
+		setReplayableBody(req, bodyBuf.Bytes())
 		req.Header.Set("Content-Type", contentType)
 
 		return nil
@@ -257,30 +279,104 @@ func withMultiPart(body url.Values) requestOption {
 		}
 
 		_ = multipartWriter.Close()
-		req.Body = io.NopCloser(&bodyBuf)
+		setReplayableBody(req, bodyBuf.Bytes())
+		req.Header.Set("Content-Type", multipartWriter.FormDataContentType())
+
+		return nil
+	}
+}
+
+// setReplayableBody sets body as the body of req, such that it can be re-sent
+// when the request needs to be retried.
+func setReplayableBody(req *http.Request, body []byte) {
+	req.ContentLength = int64(len(body))
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+}
+
+// isReplayable determines whether req can be re-sent, i.e. whether it either has no body,
+// or its body can be obtained again.
+func isReplayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// withMultiPartStream streams fields, as well as the content of r as file named fileField,
+// as multipart form data. Other than withMultiPart, it does not buffer the body in memory.
+func withMultiPartStream(fields url.Values, fileField string, r io.Reader) requestOption {
+	return func(req *http.Request) error {
+		pipeReader, pipeWriter := io.Pipe()
+		multipartWriter := multipart.NewWriter(pipeWriter)
+
+		go func() {
+			pipeWriter.CloseWithError(writeMultiPart(multipartWriter, fields, fileField, r))
+		}()
+
+		req.Body = pipeReader
 		req.Header.Set("Content-Type", multipartWriter.FormDataContentType())
 
 		return nil
 	}
 }
 
+func writeMultiPart(multipartWriter *multipart.Writer, fields url.Values, fileField string, r io.Reader) error {
+	for key, valueList := range fields {
+		for _, value := range valueList {
+			if err := multipartWriter.WriteField(key, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	fw, err := multipartWriter.CreateFormFile(fileField, fileField)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(fw, r); err != nil {
+		return err
+	}
+
+	return multipartWriter.Close()
+}
+
 type Page[T any] struct {
 	Items      []T // Items on this page
 	TotalCount int // Total number of items
 }
 
+// PageOptions controls pagination and sorting of list endpoints.
+// Either PageNumber and PageSize, or Offset and Limit should be used.
+// Setting Offset or Limit selects offset-based pagination.
+//
+// SortName and SortOrder supersede SortOptions. For methods that accept both,
+// non-empty fields of SortOptions take precedence.
 type PageOptions struct {
-	Offset     int // Offset of the elements to return
-	PageNumber int // Page to return
-	PageSize   int // Amount of elements to return per page
+	Offset     int       // Offset of the elements to return
+	Limit      int       // Amount of elements to return, starting at Offset
+	PageNumber int       // Page to return
+	PageSize   int       // Amount of elements to return per page
+	SortName   string    // Name of the field to sort by, e.g. "name"
+	SortOrder  SortOrder // Order to sort in, defaults to the server's default order
 }
 
+// SortOrder is the order in which list endpoints sort their results.
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
 func withPageOptions(po PageOptions) requestOption {
 	return func(req *http.Request) error {
 		query := req.URL.Query()
 
-		if po.Offset > 0 {
+		if po.Offset > 0 || po.Limit > 0 {
 			query.Set("offset", strconv.Itoa(po.Offset))
+			if po.Limit > 0 {
+				query.Set("limit", strconv.Itoa(po.Limit))
+			}
 		} else if po.PageNumber > 0 {
 			query.Set("pageNumber", strconv.Itoa(po.PageNumber))
 		}
@@ -289,12 +385,22 @@ func withPageOptions(po PageOptions) requestOption {
 			query.Set("pageSize", strconv.Itoa(po.PageSize))
 		}
 
+		if po.SortName != "" {
+			query.Set("sortName", po.SortName)
+		}
+		if po.SortOrder != "" {
+			query.Set("sortOrder", string(po.SortOrder))
+		}
+
 		req.URL.RawQuery = query.Encode()
 
 		return nil
 	}
 }
 
+// SortOptions controls sorting for the methods that accepted it before sorting was added to PageOptions.
+// It is superseded by PageOptions.SortName and PageOptions.SortOrder, which work for all paginated methods.
+// Where both are given, non-empty fields of SortOptions take precedence over those of PageOptions.
 type SortOptions struct {
 	Name  string `json:"sortName"`
 	Order string `json:"sortOrder"`
@@ -324,20 +430,20 @@ func withAcceptContentType(contentType string) requestOption {
 }
 
 func (c Client) doRequest(req *http.Request, v interface{}) (a apiResponse, err error) {
-	if c.debug {
-		reqDump, _ := httputil.DumpRequestOut(req, true)
-		log.Printf("sending request:\n>>>>>>\n%s\n>>>>>>\n", string(reqDump))
+	var span trace.Span
+	if c.tracer != nil {
+		req, span = c.startSpan(req)
+		defer func() { endSpan(span, err) }()
 	}
 
-	res, err := c.httpClient.Do(req)
+	res, err := c.send(req)
 	if err != nil {
 		return
 	}
 	defer res.Body.Close()
 
-	if c.debug {
-		resDump, _ := httputil.DumpResponse(res, true)
-		log.Printf("received response:\n<<<<<<\n%s\n<<<<<<\n", string(resDump))
+	if span != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", res.StatusCode))
 	}
 
 	err = checkResponseForError(res)
@@ -354,6 +460,11 @@ func (c Client) doRequest(req *http.Request, v interface{}) (a apiResponse, err
 				err = readErr
 				return
 			}
+		case *[]byte:
+			*vt, err = io.ReadAll(res.Body)
+			if err != nil {
+				return
+			}
 		default:
 			err = json.NewDecoder(res.Body).Decode(v)
 			if err != nil {
@@ -366,6 +477,100 @@ func (c Client) doRequest(req *http.Request, v interface{}) (a apiResponse, err
 	return
 }
 
+// send sends req, and retries it according to the client's RetryOptions.
+// When a rate limit is configured, sending is delayed as necessary.
+// When a circuit breaker is configured and open, ErrCircuitOpen is returned.
+func (c Client) send(req *http.Request) (res *http.Response, err error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return
+			}
+		}
+
+		if c.rateLimiter != nil {
+			delay := c.rateLimiter.reserve(c.clock.Now())
+			if delay > 0 && c.logger != nil {
+				c.logger.rateLimited(req, delay)
+			}
+			if err = sleep(req.Context(), c.clock, delay); err != nil {
+				c.rateLimiter.cancel()
+				closeRequestBody(req)
+				return
+			}
+		}
+
+		if err = c.applyRequestMiddleware(req); err != nil {
+			if c.rateLimiter != nil {
+				c.rateLimiter.cancel()
+			}
+			closeRequestBody(req)
+			return
+		}
+
+		if c.circuitBreaker != nil {
+			if err = c.circuitBreaker.allow(c.clock.Now()); err != nil {
+				if c.rateLimiter != nil {
+					c.rateLimiter.cancel()
+				}
+				closeRequestBody(req)
+				return
+			}
+		}
+
+		if c.logger != nil {
+			c.logger.requestStarted(req, attempt)
+		}
+
+		start := c.clock.Now()
+		res, err = c.httpClient.Do(req)
+		if err == nil {
+			if hookErr := c.applyResponseHooks(res); hookErr != nil {
+				res, err = nil, hookErr
+			}
+		}
+
+		if c.logger != nil {
+			c.logger.requestFinished(req, attempt, res, err, c.clock.Now().Sub(start))
+		}
+
+		if c.circuitBreaker != nil {
+			if req.Context().Err() != nil {
+				c.circuitBreaker.cancel()
+			} else {
+				c.circuitBreaker.done(c.clock.Now(), err != nil || res.StatusCode >= 500)
+			}
+		}
+
+		if attempt >= c.retryOptions.MaxRetries || !c.retryOptions.shouldRetry(req, res, err) {
+			return
+		}
+
+		backoff := c.retryOptions.backoff(attempt+1, res, c.clock.Now())
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+
+		if c.logger != nil {
+			c.logger.retrying(req, attempt+1, backoff)
+		}
+
+		if sleepErr := sleep(req.Context(), c.clock, backoff); sleepErr != nil {
+			return nil, sleepErr
+		}
+	}
+}
+
+// closeRequestBody closes the body of req, if any. Like http.Client.Do, send must close the body
+// even if the request is never sent. Otherwise, the writer of a streamed body would block forever.
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+}
+
 type apiResponse struct {
 	*http.Response
 	TotalCount int
@@ -390,11 +595,15 @@ func (c Client) newAPIResponse(res *http.Response) (a apiResponse, err error) {
 type ClientOption func(*Client) error
 
 // WithDebug toggles the debug mode.
-// When enabled, HTTP requests and responses will be logged to stderr.
-// DO NOT USE IN PRODUCTION, authorization headers are not cleared!
+// When enabled, HTTP requests and responses will be dumped to stderr.
+// See WithDebugWriter for details.
 func WithDebug(debug bool) ClientOption {
 	return func(c *Client) error {
-		c.debug = debug
+		if debug {
+			c.debugWriter = os.Stderr
+		} else {
+			c.debugWriter = nil
+		}
 		return nil
 	}
 }
@@ -415,60 +624,201 @@ func WithTimeout(timeout time.Duration) ClientOption {
 	}
 }
 
-// WithMTLS configures the http client to use client certificates
+// WithMTLS configures the http client to use client certificates,
+// and to trust the certificate authorities in caCertFile in addition to those of the system.
+// Unless configured otherwise using WithTLSMinVersion, TLS 1.2 is required.
 func WithMTLS(caCertFile string, clientCertFile string, clientKeyFile string) ClientOption {
+	return func(c *Client) error {
+		if err := WithCACertFile(caCertFile)(c); err != nil {
+			return err
+		}
+		if err := WithClientCertificate(clientCertFile, clientKeyFile)(c); err != nil {
+			return err
+		}
+
+		return c.configureTLS(func(tlsConfig *tls.Config) error {
+			if tlsConfig.MinVersion == 0 {
+				tlsConfig.MinVersion = tls.VersionTLS12
+			}
+			return nil
+		})
+	}
+}
+
+// WithCACertFile configures the http client to trust the PEM encoded certificate authorities
+// in caCertFile, in addition to those of the system.
+func WithCACertFile(caCertFile string) ClientOption {
 	return func(c *Client) error {
 		caCert, err := os.ReadFile(caCertFile)
 		if err != nil {
 			return fmt.Errorf("failed to load ca cert file: %w", err)
 		}
 
-		certPool, _ := x509.SystemCertPool()
-		if certPool == nil {
-			certPool = x509.NewCertPool()
-		}
+		return WithCACerts(caCert)(c)
+	}
+}
+
+// WithCACerts is like WithCACertFile, but takes the PEM encoded certificates directly.
+func WithCACerts(caCertsPEM []byte) ClientOption {
+	return func(c *Client) error {
+		return c.configureTLS(func(tlsConfig *tls.Config) error {
+			certPool := tlsConfig.RootCAs
+			if certPool == nil {
+				certPool, _ = x509.SystemCertPool()
+				if certPool == nil {
+					certPool = x509.NewCertPool()
+				}
+			}
+
+			if !certPool.AppendCertsFromPEM(caCertsPEM) {
+				return errors.New("no valid ca certificates provided")
+			}
 
-		certPool.AppendCertsFromPEM(caCert)
+			tlsConfig.RootCAs = certPool
+			return nil
+		})
+	}
+}
 
+// WithClientCertificate configures the http client to authenticate using the
+// PEM encoded client certificate and key in clientCertFile and clientKeyFile.
+func WithClientCertificate(clientCertFile, clientKeyFile string) ClientOption {
+	return func(c *Client) error {
 		keyPair, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
 		if err != nil {
 			return fmt.Errorf("failed to load client key pair: %w", err)
 		}
 
-		tlsConfig := &tls.Config{
-			RootCAs:      certPool,
-			Certificates: []tls.Certificate{keyPair},
-			MinVersion:   tls.VersionTLS12,
-		}
+		return c.configureTLS(func(tlsConfig *tls.Config) error {
+			tlsConfig.Certificates = append(tlsConfig.Certificates, keyPair)
+			return nil
+		})
+	}
+}
 
-		if c.httpClient.Transport == nil {
-			httpTransport := http.DefaultTransport.(*http.Transport)
-			httpTransport.TLSClientConfig = tlsConfig
-			c.httpClient.Transport = httpTransport
+// WithTLSMinVersion configures the minimum TLS version the http client accepts, e.g. tls.VersionTLS13.
+func WithTLSMinVersion(version uint16) ClientOption {
+	return func(c *Client) error {
+		return c.configureTLS(func(tlsConfig *tls.Config) error {
+			tlsConfig.MinVersion = version
 			return nil
+		})
+	}
+}
+
+// WithProxyURL configures the http client to send all requests via the proxy at proxyURL,
+// e.g. http://proxy.example.com:3128. Credentials may be provided as part of the URL.
+func WithProxyURL(proxyURL string) ClientOption {
+	return func(c *Client) error {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return fmt.Errorf("failed to parse proxy url: %w", err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid proxy url: %s", proxyURL)
 		}
 
-		httpTransport, ok := c.httpClient.Transport.(*http.Transport)
-		if ok {
-			httpTransport.TLSClientConfig = tlsConfig
+		return c.configureTransport(func(t *http.Transport) error {
+			t.Proxy = http.ProxyURL(u)
+			return nil
+		})
+	}
+}
+
+// WithProxyFromEnvironment configures the http client to use the proxy configured via the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables. This is the default behavior,
+// unless a custom transport is provided via WithHttpClient.
+func WithProxyFromEnvironment() ClientOption {
+	return func(c *Client) error {
+		return c.configureTransport(func(t *http.Transport) error {
+			t.Proxy = http.ProxyFromEnvironment
 			return nil
+		})
+	}
+}
+
+// WithDialContext configures the function the http client uses to establish network connections.
+func WithDialContext(dialContext func(ctx context.Context, network, addr string) (net.Conn, error)) ClientOption {
+	return func(c *Client) error {
+		if dialContext == nil {
+			return fmt.Errorf("no dial function provided")
 		}
 
-		authTransport, ok := c.httpClient.Transport.(*authHeaderTransport)
-		if ok {
-			httpTransport = authTransport.transport.(*http.Transport)
-			httpTransport.TLSClientConfig = tlsConfig
+		return c.configureTransport(func(t *http.Transport) error {
+			t.DialContext = dialContext
 			return nil
+		})
+	}
+}
+
+// wrappingTransport is implemented by transports that delegate to another transport.
+type wrappingTransport interface {
+	wrappedTransport() *http.RoundTripper
+}
+
+// innermostTransport returns the transport at the end of the http client's chain of transports.
+func (c *Client) innermostTransport() *http.RoundTripper {
+	transport := &c.httpClient.Transport
+	for {
+		wrapping, ok := (*transport).(wrappingTransport)
+		if !ok {
+			return transport
 		}
+		transport = wrapping.wrappedTransport()
+	}
+}
 
-		return errors.New("could not set tls options")
+// configureTransport invokes configureFunc with the *http.Transport at the end of the http client's
+// chain of transports. Transports not created by the client, like http.DefaultTransport or the
+// transport of a client provided via WithHttpClient, are cloned beforehand, so that they are never modified.
+func (c *Client) configureTransport(configureFunc func(t *http.Transport) error) error {
+	transport := c.innermostTransport()
+	if *transport == nil {
+		*transport = http.DefaultTransport
 	}
+
+	httpTransport, ok := (*transport).(*http.Transport)
+	if !ok {
+		return fmt.Errorf("could not configure transport: unsupported transport %T", *transport)
+	}
+	if httpTransport != c.transport {
+		httpTransport = httpTransport.Clone()
+		c.transport = httpTransport
+		*transport = httpTransport
+	}
+
+	return configureFunc(httpTransport)
+}
+
+// configureTLS is like configureTransport, but for the transport's TLS config.
+func (c *Client) configureTLS(configureFunc func(tlsConfig *tls.Config) error) error {
+	return c.configureTransport(func(t *http.Transport) error {
+		tlsConfig := &tls.Config{}
+		if t.TLSClientConfig != nil {
+			tlsConfig = t.TLSClientConfig.Clone()
+		}
+
+		if err := configureFunc(tlsConfig); err != nil {
+			return err
+		}
+
+		t.TLSClientConfig = tlsConfig
+		return nil
+	})
 }
 
 // WithHttpClient overrides the default HttpClient.
+// The client is copied, so that options wrapping its transport, e.g. for authentication
+// or debugging, don't modify it. Options configuring its transport, e.g. WithProxyURL or
+// WithTLSMinVersion, operate on a clone of it. It may thus be shared by multiple clients.
 func WithHttpClient(client *http.Client) ClientOption {
 	return func(c *Client) error {
-		c.httpClient = client
+		if client == nil {
+			return fmt.Errorf("no http client provided")
+		}
+
+		httpClient := *client
+		c.httpClient = &httpClient
 		return nil
 	}
 }
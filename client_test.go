@@ -0,0 +1,223 @@
+package dtrack
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// setUpTestServer starts an HTTP server that serves version information for the given
+// server version, and delegates all other requests to handler.
+func setUpTestServer(t *testing.T, version string, handler http.Handler, options ...ClientOption) *Client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/version", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(About{Version: version})
+	})
+	if handler != nil {
+		mux.Handle("/", handler)
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(server.URL, options...)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestWithMTLS(t *testing.T) {
+	// Generate a CA, and a client certificate signed by it.
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caCertDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caCertDER)
+	require.NoError(t, err)
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	clientCertDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "test-client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, caCert, &clientKey.PublicKey, caKey)
+	require.NoError(t, err)
+	clientKeyDER, err := x509.MarshalECPrivateKey(clientKey)
+	require.NoError(t, err)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(caCert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "test-client" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(About{Version: "4.11.0"})
+	}))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	writePEM := func(name, blockType string, der []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+		return path
+	}
+	serverCAFile := writePEM("server-ca.pem", "CERTIFICATE", server.Certificate().Raw)
+	clientCertFile := writePEM("client.pem", "CERTIFICATE", clientCertDER)
+	clientKeyFile := writePEM("client-key.pem", "EC PRIVATE KEY", clientKeyDER)
+
+	t.Run("WithoutClientCertificate", func(t *testing.T) {
+		_, err := NewClient(server.URL, WithCACertFile(serverCAFile))
+		require.Error(t, err)
+	})
+
+	t.Run("WithClientCertificate", func(t *testing.T) {
+		client, err := NewClient(server.URL,
+			WithAPIKey("odt_foo"),
+			WithMTLS(serverCAFile, clientCertFile, clientKeyFile),
+			WithTLSMinVersion(tls.VersionTLS13))
+		require.NoError(t, err)
+
+		tlsConfig := client.httpClient.Transport.(*authHeaderTransport).transport.(*http.Transport).TLSClientConfig
+		require.Equal(t, uint16(tls.VersionTLS13), tlsConfig.MinVersion)
+	})
+
+	// http.DefaultTransport must not have been modified.
+	if defaultTLSConfig := http.DefaultTransport.(*http.Transport).TLSClientConfig; defaultTLSConfig != nil {
+		require.Empty(t, defaultTLSConfig.Certificates)
+		require.Nil(t, defaultTLSConfig.RootCAs)
+	}
+}
+
+func TestWithProxyURL(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Proxies receive the absolute URL of the request.
+		if r.URL.Host != "dtrack.example.com" || r.URL.Path != "/api/version" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(About{Version: "4.11.0"})
+	}))
+	t.Cleanup(proxy.Close)
+
+	client, err := NewClient("http://dtrack.example.com", WithProxyURL(proxy.URL))
+	require.NoError(t, err)
+	require.Equal(t, "4.11.0", client.about.Version)
+
+	_, err = NewClient("http://dtrack.example.com", WithProxyURL("proxy.example.com"))
+	require.Error(t, err)
+}
+
+func TestWithDialContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(About{Version: "4.11.0"})
+	}))
+	t.Cleanup(server.Close)
+
+	var dialedAddrs []string
+	client, err := NewClient("http://dtrack.example.com", WithDialContext(func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialedAddrs = append(dialedAddrs, addr)
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}))
+	require.NoError(t, err)
+	require.Equal(t, "4.11.0", client.about.Version)
+	require.Equal(t, []string{"dtrack.example.com:80"}, dialedAddrs)
+}
+
+func TestWithHttpClient_ConfigureTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(About{Version: "4.11.0"})
+	}))
+	t.Cleanup(server.Close)
+
+	transport := &http.Transport{}
+	hc := &http.Client{Transport: transport}
+
+	client, err := NewClient(server.URL,
+		WithHttpClient(hc),
+		WithTLSMinVersion(tls.VersionTLS13),
+		WithProxyURL("http://proxy.example.com:3128"),
+		WithDialContext(func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		}))
+	require.NoError(t, err)
+
+	// The options must have been applied to a single clone of the provided transport.
+	clone, ok := client.httpClient.Transport.(*http.Transport)
+	require.True(t, ok)
+	require.NotSame(t, transport, clone)
+	require.Equal(t, uint16(tls.VersionTLS13), clone.TLSClientConfig.MinVersion)
+	require.NotNil(t, clone.Proxy)
+	require.NotNil(t, clone.DialContext)
+
+	// The provided client and its transport must not have been modified.
+	require.Same(t, transport, hc.Transport)
+	if transport.TLSClientConfig != nil { // Set up for HTTP/2 by http.Transport.Clone.
+		require.Zero(t, transport.TLSClientConfig.MinVersion)
+	}
+	require.Nil(t, transport.Proxy)
+	require.Nil(t, transport.DialContext)
+}
+
+func TestWithPageOptions(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/api/v1/project", nil)
+	require.NoError(t, err)
+
+	err = withPageOptions(PageOptions{PageNumber: 2, PageSize: 10, SortName: "name", SortOrder: SortOrderDesc})(req)
+	require.NoError(t, err)
+	require.Equal(t, "pageNumber=2&pageSize=10&sortName=name&sortOrder=desc", req.URL.RawQuery)
+
+	// Explicit sort options take precedence.
+	err = withSortOptions(SortOptions{Name: "version", Order: "asc"})(req)
+	require.NoError(t, err)
+	require.Equal(t, "pageNumber=2&pageSize=10&sortName=version&sortOrder=asc", req.URL.RawQuery)
+}
+
+func TestWithPageOptions_OffsetLimit(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/api/v1/project", nil)
+	require.NoError(t, err)
+
+	err = withPageOptions(PageOptions{Limit: 25})(req)
+	require.NoError(t, err)
+	require.Equal(t, "limit=25&offset=0", req.URL.RawQuery)
+
+	err = withPageOptions(PageOptions{Offset: 50, Limit: 25, PageNumber: 3})(req)
+	require.NoError(t, err)
+	require.Equal(t, "limit=25&offset=50", req.URL.RawQuery)
+}
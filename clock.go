@@ -0,0 +1,68 @@
+package dtrack
+
+import (
+	"context"
+	"time"
+)
+
+// Clock provides the current time, and timers, to the client.
+// It may be replaced via WithClock, so that tests of time-dependent behavior,
+// such as retries, rate limiting and polling, do not need to wait in real time.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a timer created by a Clock. It behaves like time.Timer.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+// WithClock overrides the clock used by the client, which defaults to the system's clock.
+// Note that it does not affect timeouts of the underlying http.Client.
+func WithClock(clock Clock) ClientOption {
+	return func(c *Client) error {
+		c.clock = clock
+		return nil
+	}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+type systemTimer struct {
+	timer *time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+// sleep blocks for the given duration according to clock, or until ctx is done.
+func sleep(ctx context.Context, clock Clock, d time.Duration) error {
+	if d <= 0 || ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	timer := clock.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C():
+		return nil
+	}
+}
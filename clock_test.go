@@ -0,0 +1,108 @@
+package dtrack
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a Clock whose timers fire immediately, advancing the clock by their duration.
+type fakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return fakeTimer{ch}
+}
+
+type fakeTimer struct {
+	ch chan time.Time
+}
+
+func (t fakeTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t fakeTimer) Stop() bool {
+	return false
+}
+
+func TestPoll_FakeClock(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+
+	var calls int
+	err := poll(context.Background(), clock, PollingOptions{
+		Interval:    time.Minute,
+		MaxInterval: 4 * time.Minute,
+		Multiplier:  2,
+		Timeout:     10 * time.Minute,
+	}, func(_ context.Context) (bool, error) {
+		calls++
+		return false, nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 5, calls)
+	require.Equal(t, []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 3 * time.Minute}, clock.sleeps)
+}
+
+func TestWithClock(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+
+	attempts := 0
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.Header().Set("Retry-After", "20")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}), WithClock(clock), WithRetry(RetryOptions{MaxRetries: 2, MaxBackoff: time.Minute}))
+
+	start := time.Now()
+	_, err := client.Metrics.LatestPortfolioMetrics(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{20 * time.Second, 20 * time.Second}, clock.sleeps)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestWithClock_RetryAfterDate(t *testing.T) {
+	// Far from wall time, so that backoffs computed from wall time would be obvious.
+	clock := &fakeClock{now: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	attempts := 0
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.Header().Set("Retry-After", clock.Now().Add(30*time.Second).Format(http.TimeFormat))
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}), WithClock(clock), WithRetry(RetryOptions{MaxRetries: 1, MaxBackoff: time.Minute}))
+
+	_, err := client.Metrics.LatestPortfolioMetrics(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{30 * time.Second}, clock.sleeps)
+}
@@ -9,8 +9,10 @@ import (
 
 type Component struct {
 	UUID               uuid.UUID                `json:"uuid,omitempty"`
-	Author             string                   `json:"author,omitempty"`
+	Author             string                   `json:"author,omitempty"`  // Deprecated since v4.12.0, use Authors instead
+	Authors            []OrganizationalContact  `json:"authors,omitempty"` // Since v4.12.0
 	Publisher          string                   `json:"publisher,omitempty"`
+	Supplier           *OrganizationalEntity    `json:"supplier,omitempty"`
 	Group              string                   `json:"group,omitempty"`
 	Name               string                   `json:"name"`
 	Version            string                   `json:"version"`
@@ -36,12 +38,27 @@ type Component struct {
 	Description        string                   `json:"description,omitempty"`
 	Copyright          string                   `json:"copyright,omitempty"`
 	License            string                   `json:"license,omitempty"`
+	LicenseExpression  string                   `json:"licenseExpression,omitempty"`
+	LicenseURL         string                   `json:"licenseUrl,omitempty"`
 	ResolvedLicense    *License                 `json:"resolvedLicense,omitempty"`
 	DirectDependencies string                   `json:"directDependencies,omitempty"`
 	Notes              string                   `json:"notes,omitempty"`
 	ExternalReferences []ExternalReference      `json:"externalReferences,omitempty"`
 	Project            *Project                 `json:"project,omitempty"`
 	RepositoryMeta     *RepositoryMetaComponent `json:"repositoryMeta,omitempty"`
+	LastRiskScore      float64                  `json:"lastInheritedRiskScore,omitempty"`
+}
+
+type OrganizationalContact struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+type OrganizationalEntity struct {
+	Name     string                  `json:"name,omitempty"`
+	URLs     []string                `json:"urls,omitempty"`
+	Contacts []OrganizationalContact `json:"contacts,omitempty"`
 }
 
 type ExternalReference struct {
@@ -57,6 +74,7 @@ type ComponentService struct {
 type ComponentFilterOptions struct {
 	OnlyOutdated bool
 	OnlyDirect   bool
+	SearchText   string
 }
 
 type ComponentProperty struct {
@@ -68,6 +86,16 @@ type ComponentProperty struct {
 	UUID        uuid.UUID `json:"uuid"`
 }
 
+// ComponentOccurrence describes where a component was found, as reported by the evidence of an uploaded BOM.
+type ComponentOccurrence struct {
+	UUID      uuid.UUID `json:"uuid"`
+	Location  string    `json:"location"`
+	Line      *int      `json:"line,omitempty"`
+	Offset    *int      `json:"offset,omitempty"`
+	Symbol    string    `json:"symbol,omitempty"`
+	CreatedAt int       `json:"createdAt"`
+}
+
 type ComponentIdentityQueryOptions struct {
 	Group     string
 	Name      string
@@ -93,6 +121,39 @@ func (cs ComponentService) Get(ctx context.Context, componentUUID uuid.UUID) (c
 	return
 }
 
+// GetRepositoryMeta retrieves the metadata, e.g. the latest version, that was
+// fetched from the component's repository, as identified by its package URL.
+// The server refreshes repository metadata periodically. There is no endpoint
+// to trigger a refresh for a single component.
+func (cs ComponentService) GetRepositoryMeta(ctx context.Context, componentUUID uuid.UUID) (r RepositoryMetaComponent, err error) {
+	err = cs.client.assertServerVersionAtLeast("4.0.0")
+	if err != nil {
+		return
+	}
+
+	params := map[string]string{
+		"includeRepositoryMetaData": "true",
+	}
+
+	req, err := cs.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/component/%s", componentUUID), withParams(params))
+	if err != nil {
+		return
+	}
+
+	var c Component
+	_, err = cs.client.doRequest(req, &c)
+	if err != nil {
+		return
+	}
+
+	if c.RepositoryMeta == nil {
+		return r, fmt.Errorf("no repository metadata available for component %s", componentUUID)
+	}
+
+	r = *c.RepositoryMeta
+	return
+}
+
 func (cs ComponentService) GetAll(ctx context.Context, projectUUID uuid.UUID, po PageOptions, filterOptions ComponentFilterOptions) (p Page[Component], err error) {
 	err = cs.client.assertServerVersionAtLeast("4.0.0")
 	if err != nil {
@@ -122,6 +183,9 @@ func withComponentFilterOptions(filterOptions ComponentFilterOptions) requestOpt
 		if filterOptions.OnlyOutdated {
 			query.Set("onlyOutdated", "true")
 		}
+		if len(filterOptions.SearchText) > 0 {
+			query.Set("searchText", filterOptions.SearchText)
+		}
 		req.URL.RawQuery = query.Encode()
 		return nil
 	}
@@ -217,6 +281,28 @@ func (cs ComponentService) DeleteProperty(ctx context.Context, componentUUID, pr
 	return
 }
 
+// GetOccurrences retrieves the occurrences of a given component.
+// Occurrences are populated from the evidence of uploaded BOMs, and can't be modified via the API.
+func (cs ComponentService) GetOccurrences(ctx context.Context, componentUUID uuid.UUID, po PageOptions) (p Page[ComponentOccurrence], err error) {
+	err = cs.client.assertServerVersionAtLeast("4.13.0")
+	if err != nil {
+		return
+	}
+
+	req, err := cs.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/component/%s/occurrence", componentUUID), withPageOptions(po))
+	if err != nil {
+		return
+	}
+
+	res, err := cs.client.doRequest(req, &p.Items)
+	if err != nil {
+		return
+	}
+
+	p.TotalCount = res.TotalCount
+	return
+}
+
 func (cs ComponentService) GetByHash(ctx context.Context, hash string, po PageOptions, so SortOptions) (p Page[Component], err error) {
 	err = cs.client.assertServerVersionAtLeast("3.0.0")
 	if err != nil {
@@ -290,6 +376,7 @@ func withComponentIdentityOptions(identityOptions ComponentIdentityQueryOptions)
 	}
 }
 
+// IdentifyInternal triggers the identification of internal components across the entire portfolio.
 func (cs ComponentService) IdentifyInternal(ctx context.Context) (err error) {
 	err = cs.client.assertServerVersionAtLeast("4.0.0")
 	if err != nil {
@@ -304,3 +391,57 @@ func (cs ComponentService) IdentifyInternal(ctx context.Context) (err error) {
 	_, err = cs.client.doRequest(req, nil)
 	return
 }
+
+const (
+	configGroupInternalComponents = "internal-components"
+	configNameGroupsRegex         = "groups.regex"
+	configNameNamesRegex          = "names.regex"
+)
+
+// InternalComponentIdentification holds the regular expressions used to identify internal components.
+type InternalComponentIdentification struct {
+	GroupsRegex string // Components with a group matching this expression are considered internal
+	NamesRegex  string // Components with a name matching this expression are considered internal
+}
+
+// GetInternalIdentification retrieves the regular expressions used to identify internal components.
+func (cs ComponentService) GetInternalIdentification(ctx context.Context) (ici InternalComponentIdentification, err error) {
+	cps, err := cs.client.Config.GetAll(ctx)
+	if err != nil {
+		return
+	}
+
+	for _, cp := range cps {
+		if cp.GroupName != configGroupInternalComponents {
+			continue
+		}
+		switch cp.Name {
+		case configNameGroupsRegex:
+			ici.GroupsRegex = cp.Value
+		case configNameNamesRegex:
+			ici.NamesRegex = cp.Value
+		}
+	}
+
+	return
+}
+
+// UpdateInternalIdentification updates the regular expressions used to identify internal components.
+// Use IdentifyInternal to apply the updated expressions to existing components.
+func (cs ComponentService) UpdateInternalIdentification(ctx context.Context, ici InternalComponentIdentification) (err error) {
+	_, err = cs.client.Config.UpdateAll(ctx, []ConfigProperty{
+		{
+			GroupName: configGroupInternalComponents,
+			Name:      configNameGroupsRegex,
+			Value:     ici.GroupsRegex,
+			Type:      "STRING",
+		},
+		{
+			GroupName: configGroupInternalComponents,
+			Name:      configNameNamesRegex,
+			Value:     ici.NamesRegex,
+			Type:      "STRING",
+		},
+	})
+	return
+}
@@ -2,8 +2,12 @@ package dtrack
 
 import (
 	"context"
-	"github.com/stretchr/testify/require"
+	"encoding/json"
+	"net/http"
 	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
 )
 
 func TestComponentLifecycle(t *testing.T) {
@@ -66,6 +70,17 @@ func TestComponentLifecycle(t *testing.T) {
 		require.Equal(t, singleComponent.Name, "Component-Name-With-Change")
 	}
 
+	// Search
+	{
+		components, err := client.Component.GetAll(context.Background(), project.UUID, po, ComponentFilterOptions{SearchText: "With-Change"})
+		require.NoError(t, err)
+		require.Equal(t, components.TotalCount, 1)
+
+		components, err = client.Component.GetAll(context.Background(), project.UUID, po, ComponentFilterOptions{SearchText: "Does-Not-Exist"})
+		require.NoError(t, err)
+		require.Equal(t, components.TotalCount, 0)
+	}
+
 	// Delete
 	{
 		err := client.Component.Delete(context.Background(), component.UUID)
@@ -216,3 +231,81 @@ func TestComponentIdentifyInternal(t *testing.T) {
 	err := client.Component.IdentifyInternal(context.Background())
 	require.NoError(t, err)
 }
+
+func TestComponentInternalIdentification(t *testing.T) {
+	client := setUpContainer(t, testContainerOptions{
+		APIPermissions: []string{
+			PermissionSystemConfiguration,
+		},
+	})
+
+	err := client.Component.UpdateInternalIdentification(context.Background(), InternalComponentIdentification{
+		GroupsRegex: "^com\\.acme\\..*",
+		NamesRegex:  "^acme-.*",
+	})
+	require.NoError(t, err)
+
+	ici, err := client.Component.GetInternalIdentification(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "^com\\.acme\\..*", ici.GroupsRegex)
+	require.Equal(t, "^acme-.*", ici.NamesRegex)
+
+	err = client.Component.IdentifyInternal(context.Background())
+	require.NoError(t, err)
+}
+
+func TestComponentService_GetRepositoryMeta(t *testing.T) {
+	withMeta := uuid.New()
+	withoutMeta := uuid.New()
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "true", r.URL.Query().Get("includeRepositoryMetaData"))
+
+		component := Component{Name: "acme-lib", Version: "1.0.0"}
+		switch r.URL.Path {
+		case "/api/v1/component/" + withMeta.String():
+			component.RepositoryMeta = &RepositoryMetaComponent{RepositoryType: "MAVEN", Name: "acme-lib", LatestVersion: "1.2.0"}
+		case "/api/v1/component/" + withoutMeta.String():
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(component)
+	}))
+
+	meta, err := client.Component.GetRepositoryMeta(context.Background(), withMeta)
+	require.NoError(t, err)
+	require.Equal(t, "1.2.0", meta.LatestVersion)
+
+	_, err = client.Component.GetRepositoryMeta(context.Background(), withoutMeta)
+	require.ErrorContains(t, err, "no repository metadata available")
+}
+
+func TestComponentService_GetOccurrences(t *testing.T) {
+	componentUUID := uuid.New()
+	line := 42
+
+	client := setUpTestServer(t, "4.13.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/v1/component/"+componentUUID.String()+"/occurrence", r.URL.Path)
+		require.Equal(t, "pageNumber=1&pageSize=10", r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", "1")
+		_, _ = w.Write([]byte(`[{"uuid":"` + uuid.NewString() + `","location":"/usr/lib/libacme.so","line":42,"createdAt":1700000000000}]`))
+	}))
+
+	occurrences, err := client.Component.GetOccurrences(context.Background(), componentUUID, PageOptions{PageNumber: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Equal(t, 1, occurrences.TotalCount)
+	require.Equal(t, "/usr/lib/libacme.so", occurrences.Items[0].Location)
+	require.Equal(t, &line, occurrences.Items[0].Line)
+	require.Nil(t, occurrences.Items[0].Offset)
+
+	client = setUpTestServer(t, "4.12.0", nil)
+	_, err = client.Component.GetOccurrences(context.Background(), componentUUID, PageOptions{})
+	require.ErrorContains(t, err, "server version must be at least 4.13.0")
+}
@@ -0,0 +1,118 @@
+package dtrack
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+)
+
+// redactedHeaders are headers whose values are never included in debug dumps.
+var redactedHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization", "Set-Cookie", "X-Api-Key"}
+
+// WithDebugWriter enables the debug mode, dumping HTTP requests and responses to w.
+// Credentials in headers, and API keys in URL paths are redacted. Bodies are dumped as-is,
+// except for streamed request bodies (e.g. BOM uploads from an io.Reader), which are omitted.
+// Bodies may still contain sensitive data, such as passwords or generated API keys.
+func WithDebugWriter(w io.Writer) ClientOption {
+	return func(c *Client) error {
+		c.debugWriter = w
+		return nil
+	}
+}
+
+// dumpTransport dumps requests and responses, after all other transports of the client modified them.
+type dumpTransport struct {
+	transport http.RoundTripper
+	w         io.Writer
+	mutex     sync.Mutex
+}
+
+func (t *dumpTransport) wrappedTransport() *http.RoundTripper {
+	return &t.transport
+}
+
+func (t *dumpTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.dump("sending request", ">>>>>>", dumpRequest(req))
+
+	transport := t.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	res, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resDump, err := dumpResponse(res)
+	if err != nil {
+		_ = res.Body.Close()
+		return nil, err
+	}
+	t.dump("received response", "<<<<<<", resDump)
+
+	return res, nil
+}
+
+func (t *dumpTransport) dump(title, separator string, dump []byte) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	_, _ = fmt.Fprintf(t.w, "%s:\n%s\n%s\n%s\n", title, separator, dump, separator)
+}
+
+func dumpRequest(req *http.Request) []byte {
+	clone := req.Clone(req.Context())
+	clone.Header = redactHeader(req.Header)
+	clone.URL.Path = redactPath(req.URL.Path)
+	clone.URL.RawPath = ""
+
+	// Dumping consumes the body, so use a copy if possible.
+	dumpBody := req.Body == nil || req.Body == http.NoBody
+	if !dumpBody && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			clone.Body = body
+			dumpBody = true
+		}
+	}
+	if !dumpBody {
+		clone.Body = nil
+	}
+
+	dump, err := httputil.DumpRequestOut(clone, dumpBody)
+	if err != nil {
+		return []byte(fmt.Sprintf("failed to dump request: %v", err))
+	}
+
+	return dump
+}
+
+func dumpResponse(res *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	redacted := *res
+	redacted.Header = redactHeader(res.Header)
+	redacted.Body = io.NopCloser(bytes.NewReader(body))
+
+	return httputil.DumpResponse(&redacted, true)
+}
+
+// redactHeader returns a copy of header, with the values of credential headers redacted.
+func redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	for _, name := range redactedHeaders {
+		if _, ok := header[name]; ok {
+			header.Set(name, "REDACTED")
+		}
+	}
+
+	return header
+}
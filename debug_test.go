@@ -0,0 +1,58 @@
+package dtrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDebugWriter(t *testing.T) {
+	var buf bytes.Buffer
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Api-Key") != "odt_secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Set-Cookie", "session=secret")
+		_, _ = w.Write([]byte(`{"comment":"dumped"}`))
+	}), WithDebugWriter(&buf), WithAPIKey("odt_secret"))
+	buf.Reset()
+
+	apiKey, err := client.Team.UpdateAPIKeyComment(context.Background(), "odt_secret", "dumped")
+	require.NoError(t, err)
+	require.Equal(t, "dumped", apiKey)
+
+	dump := buf.String()
+	require.Contains(t, dump, "POST /api/v1/team/key/REDACTED/comment HTTP/1.1")
+	require.Contains(t, dump, "X-Api-Key: REDACTED")
+	require.Contains(t, dump, "Set-Cookie: REDACTED")
+	require.Contains(t, dump, "\r\n\r\ndumped")
+	require.Contains(t, dump, `{"comment":"dumped"}`)
+	require.NotContains(t, dump, "secret")
+}
+
+func TestWithDebugWriter_WithHttpClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(About{Version: "4.11.0"})
+	}))
+	t.Cleanup(server.Close)
+
+	transport := &http.Transport{}
+	hc := &http.Client{Transport: transport}
+
+	var buf bytes.Buffer
+	for i := 0; i < 2; i++ {
+		_, err := NewClient(server.URL, WithHttpClient(hc), WithDebugWriter(&buf), WithAPIKey("odt_secret"))
+		require.NoError(t, err)
+	}
+
+	// The transport of the provided client must not be wrapped, otherwise the second client would dump everything twice.
+	require.Same(t, transport, hc.Transport)
+	require.Equal(t, 2, strings.Count(buf.String(), "GET /api/version HTTP/1.1"))
+}
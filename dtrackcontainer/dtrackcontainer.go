@@ -0,0 +1,166 @@
+// Package dtrackcontainer runs the Dependency-Track API server in a container
+// using testcontainers-go, for end-to-end tests against a real server.
+//
+//	func TestSomething(t *testing.T) {
+//		container := dtrackcontainer.SetUp(t, dtrackcontainer.Options{
+//			Version:     "4.12.0",
+//			Permissions: []string{"VIEW_PORTFOLIO", "BOM_UPLOAD"},
+//		})
+//		project, err := container.Client.Project.Create(ctx, dtrack.Project{...})
+//		// ...
+//	}
+//
+// Starting the container requires a Docker-compatible runtime.
+package dtrackcontainer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	DefaultImage          = "dependencytrack/apiserver"
+	DefaultVersion        = "latest"
+	DefaultStartupTimeout = 5 * time.Minute
+
+	adminUsername        = "admin"
+	adminDefaultPassword = "admin"
+	adminPassword        = "dtrackcontainer"
+)
+
+// Options configures the container.
+type Options struct {
+	Image          string                // Defaults to DefaultImage
+	Version        string                // Image tag, defaults to DefaultVersion
+	Env            map[string]string     // Additional environment variables for the container
+	StartupTimeout time.Duration         // Defaults to DefaultStartupTimeout
+	Permissions    []string              // Permissions of the team whose API key is used by Client
+	ClientOptions  []dtrack.ClientOption // Additional options for Client
+}
+
+// Container is a running Dependency-Track API server.
+type Container struct {
+	testcontainers.Container
+
+	URL    string         // Base URL of the API server
+	APIKey string         // API key of a team with the configured permissions
+	Client *dtrack.Client // Client authenticated with APIKey
+}
+
+// Run starts the container, waits until the API server is ready, and bootstraps it:
+// the admin's initial password is changed, and a team with the configured permissions
+// and an API key is created. The caller is responsible for terminating the container.
+func Run(ctx context.Context, opts Options) (*Container, error) {
+	if opts.Image == "" {
+		opts.Image = DefaultImage
+	}
+	if opts.Version == "" {
+		opts.Version = DefaultVersion
+	}
+	if opts.StartupTimeout <= 0 {
+		opts.StartupTimeout = DefaultStartupTimeout
+	}
+
+	env := map[string]string{
+		"JAVA_OPTIONS":                     "-Xmx1g",
+		"SYSTEM_REQUIREMENT_CHECK_ENABLED": "false",
+	}
+	for k, v := range opts.Env {
+		env[k] = v
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        fmt.Sprintf("%s:%s", opts.Image, opts.Version),
+			Env:          env,
+			ExposedPorts: []string{"8080/tcp"},
+			WaitingFor:   wait.ForLog("Dependency-Track is ready").WithStartupTimeout(opts.StartupTimeout),
+		},
+		Started: true,
+	})
+	if err != nil {
+		if container != nil {
+			_ = container.Terminate(ctx)
+		}
+		return nil, fmt.Errorf("failed to start container: %w", err)
+	}
+
+	c := &Container{Container: container}
+	if err = c.bootstrap(ctx, opts); err != nil {
+		_ = container.Terminate(ctx)
+		return nil, fmt.Errorf("failed to bootstrap container: %w", err)
+	}
+
+	return c, nil
+}
+
+// SetUp is like Run, but fails t if the container can not be started,
+// and terminates the container when t finishes.
+func SetUp(t testing.TB, opts Options) *Container {
+	t.Helper()
+
+	ctx := context.Background()
+
+	c, err := Run(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := c.Terminate(ctx); err != nil {
+			t.Errorf("failed to terminate container: %v", err)
+		}
+	})
+
+	return c
+}
+
+func (c *Container) bootstrap(ctx context.Context, opts Options) (err error) {
+	c.URL, err = c.Endpoint(ctx, "http")
+	if err != nil {
+		return
+	}
+
+	client, err := dtrack.NewClient(c.URL)
+	if err != nil {
+		return
+	}
+
+	err = client.User.ForceChangePassword(ctx, adminUsername, adminDefaultPassword, adminPassword)
+	if err != nil {
+		return fmt.Errorf("failed to change admin password: %w", err)
+	}
+
+	client, err = dtrack.NewClient(c.URL, dtrack.WithUserCredentials(adminUsername, adminPassword))
+	if err != nil {
+		return
+	}
+
+	team, err := client.Team.Create(ctx, dtrack.Team{Name: "dtrackcontainer"})
+	if err != nil {
+		return fmt.Errorf("failed to create team: %w", err)
+	}
+
+	for _, permissionName := range opts.Permissions {
+		_, err = client.Permission.AddPermissionToTeam(ctx, dtrack.Permission{Name: permissionName}, team.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to add permission %s: %w", permissionName, err)
+		}
+	}
+
+	apiKey, err := client.Team.GenerateAPIKey(ctx, team.UUID)
+	if err != nil {
+		return fmt.Errorf("failed to generate api key: %w", err)
+	}
+	c.APIKey = apiKey.Key
+
+	clientOptions := append([]dtrack.ClientOption{dtrack.WithAPIKey(c.APIKey)}, opts.ClientOptions...)
+	c.Client, err = dtrack.NewClient(c.URL, clientOptions...)
+	return
+}
@@ -0,0 +1,24 @@
+package dtrackcontainer
+
+import (
+	"context"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetUp(t *testing.T) {
+	container := SetUp(t, Options{
+		Permissions: []string{dtrack.PermissionPortfolioManagement, dtrack.PermissionViewPortfolio},
+	})
+	require.NotEmpty(t, container.URL)
+	require.NotEmpty(t, container.APIKey)
+
+	project, err := container.Client.Project.Create(context.Background(), dtrack.Project{Name: "acme-app", Version: "1.0.0"})
+	require.NoError(t, err)
+
+	project, err = container.Client.Project.Get(context.Background(), project.UUID)
+	require.NoError(t, err)
+	require.Equal(t, "acme-app", project.Name)
+}
@@ -0,0 +1,93 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AboutAPI is an autogenerated mock type for the AboutAPI type
+type AboutAPI struct {
+	mock.Mock
+}
+
+type AboutAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AboutAPI) EXPECT() *AboutAPI_Expecter {
+	return &AboutAPI_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx
+func (_m *AboutAPI) Get(ctx context.Context) (dtrack.About, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.About
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dtrack.About, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dtrack.About); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dtrack.About)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AboutAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type AboutAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AboutAPI_Expecter) Get(ctx interface{}) *AboutAPI_Get_Call {
+	return &AboutAPI_Get_Call{Call: _e.mock.On("Get", ctx)}
+}
+
+func (_c *AboutAPI_Get_Call) Run(run func(ctx context.Context)) *AboutAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AboutAPI_Get_Call) Return(_a0 dtrack.About, _a1 error) *AboutAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AboutAPI_Get_Call) RunAndReturn(run func(context.Context) (dtrack.About, error)) *AboutAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAboutAPI creates a new instance of AboutAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAboutAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AboutAPI {
+	mock := &AboutAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
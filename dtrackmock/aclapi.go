@@ -0,0 +1,192 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ACLAPI is an autogenerated mock type for the ACLAPI type
+type ACLAPI struct {
+	mock.Mock
+}
+
+type ACLAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ACLAPI) EXPECT() *ACLAPI_Expecter {
+	return &ACLAPI_Expecter{mock: &_m.Mock}
+}
+
+// AddProjectMapping provides a mock function with given fields: ctx, mapping
+func (_m *ACLAPI) AddProjectMapping(ctx context.Context, mapping dtrack.ACLMappingRequest) error {
+	ret := _m.Called(ctx, mapping)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProjectMapping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ACLMappingRequest) error); ok {
+		r0 = rf(ctx, mapping)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ACLAPI_AddProjectMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProjectMapping'
+type ACLAPI_AddProjectMapping_Call struct {
+	*mock.Call
+}
+
+// AddProjectMapping is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapping dtrack.ACLMappingRequest
+func (_e *ACLAPI_Expecter) AddProjectMapping(ctx interface{}, mapping interface{}) *ACLAPI_AddProjectMapping_Call {
+	return &ACLAPI_AddProjectMapping_Call{Call: _e.mock.On("AddProjectMapping", ctx, mapping)}
+}
+
+func (_c *ACLAPI_AddProjectMapping_Call) Run(run func(ctx context.Context, mapping dtrack.ACLMappingRequest)) *ACLAPI_AddProjectMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.ACLMappingRequest))
+	})
+	return _c
+}
+
+func (_c *ACLAPI_AddProjectMapping_Call) Return(_a0 error) *ACLAPI_AddProjectMapping_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ACLAPI_AddProjectMapping_Call) RunAndReturn(run func(context.Context, dtrack.ACLMappingRequest) error) *ACLAPI_AddProjectMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllProjects provides a mock function with given fields: ctx, team, po
+func (_m *ACLAPI) GetAllProjects(ctx context.Context, team uuid.UUID, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+	ret := _m.Called(ctx, team, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllProjects")
+	}
+
+	var r0 dtrack.Page[dtrack.Project]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions) (dtrack.Page[dtrack.Project], error)); ok {
+		return rf(ctx, team, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions) dtrack.Page[dtrack.Project]); ok {
+		r0 = rf(ctx, team, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Project])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, team, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ACLAPI_GetAllProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllProjects'
+type ACLAPI_GetAllProjects_Call struct {
+	*mock.Call
+}
+
+// GetAllProjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team uuid.UUID
+//   - po dtrack.PageOptions
+func (_e *ACLAPI_Expecter) GetAllProjects(ctx interface{}, team interface{}, po interface{}) *ACLAPI_GetAllProjects_Call {
+	return &ACLAPI_GetAllProjects_Call{Call: _e.mock.On("GetAllProjects", ctx, team, po)}
+}
+
+func (_c *ACLAPI_GetAllProjects_Call) Run(run func(ctx context.Context, team uuid.UUID, po dtrack.PageOptions)) *ACLAPI_GetAllProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *ACLAPI_GetAllProjects_Call) Return(_a0 dtrack.Page[dtrack.Project], _a1 error) *ACLAPI_GetAllProjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ACLAPI_GetAllProjects_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.PageOptions) (dtrack.Page[dtrack.Project], error)) *ACLAPI_GetAllProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveProjectMapping provides a mock function with given fields: ctx, team, project
+func (_m *ACLAPI) RemoveProjectMapping(ctx context.Context, team uuid.UUID, project uuid.UUID) error {
+	ret := _m.Called(ctx, team, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveProjectMapping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, team, project)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ACLAPI_RemoveProjectMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveProjectMapping'
+type ACLAPI_RemoveProjectMapping_Call struct {
+	*mock.Call
+}
+
+// RemoveProjectMapping is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team uuid.UUID
+//   - project uuid.UUID
+func (_e *ACLAPI_Expecter) RemoveProjectMapping(ctx interface{}, team interface{}, project interface{}) *ACLAPI_RemoveProjectMapping_Call {
+	return &ACLAPI_RemoveProjectMapping_Call{Call: _e.mock.On("RemoveProjectMapping", ctx, team, project)}
+}
+
+func (_c *ACLAPI_RemoveProjectMapping_Call) Run(run func(ctx context.Context, team uuid.UUID, project uuid.UUID)) *ACLAPI_RemoveProjectMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ACLAPI_RemoveProjectMapping_Call) Return(_a0 error) *ACLAPI_RemoveProjectMapping_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ACLAPI_RemoveProjectMapping_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *ACLAPI_RemoveProjectMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewACLAPI creates a new instance of ACLAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewACLAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ACLAPI {
+	mock := &ACLAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
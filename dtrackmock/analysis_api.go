@@ -0,0 +1,215 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// AnalysisAPI is an autogenerated mock type for the AnalysisAPI type
+type AnalysisAPI struct {
+	mock.Mock
+}
+
+type AnalysisAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnalysisAPI) EXPECT() *AnalysisAPI_Expecter {
+	return &AnalysisAPI_Expecter{mock: &_m.Mock}
+}
+
+// AddComment provides a mock function with given fields: ctx, component, project, vulnerability, comment
+func (_m *AnalysisAPI) AddComment(ctx context.Context, component uuid.UUID, project uuid.UUID, vulnerability uuid.UUID, comment string) (dtrack.Analysis, error) {
+	ret := _m.Called(ctx, component, project, vulnerability, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddComment")
+	}
+
+	var r0 dtrack.Analysis
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (dtrack.Analysis, error)); ok {
+		return rf(ctx, component, project, vulnerability, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) dtrack.Analysis); ok {
+		r0 = rf(ctx, component, project, vulnerability, comment)
+	} else {
+		r0 = ret.Get(0).(dtrack.Analysis)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, component, project, vulnerability, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalysisAPI_AddComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddComment'
+type AnalysisAPI_AddComment_Call struct {
+	*mock.Call
+}
+
+// AddComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - component uuid.UUID
+//   - project uuid.UUID
+//   - vulnerability uuid.UUID
+//   - comment string
+func (_e *AnalysisAPI_Expecter) AddComment(ctx interface{}, component interface{}, project interface{}, vulnerability interface{}, comment interface{}) *AnalysisAPI_AddComment_Call {
+	return &AnalysisAPI_AddComment_Call{Call: _e.mock.On("AddComment", ctx, component, project, vulnerability, comment)}
+}
+
+func (_c *AnalysisAPI_AddComment_Call) Run(run func(ctx context.Context, component uuid.UUID, project uuid.UUID, vulnerability uuid.UUID, comment string)) *AnalysisAPI_AddComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *AnalysisAPI_AddComment_Call) Return(_a0 dtrack.Analysis, _a1 error) *AnalysisAPI_AddComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalysisAPI_AddComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID, string) (dtrack.Analysis, error)) *AnalysisAPI_AddComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, analysisReq
+func (_m *AnalysisAPI) Create(ctx context.Context, analysisReq dtrack.AnalysisRequest) (dtrack.Analysis, error) {
+	ret := _m.Called(ctx, analysisReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.Analysis
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.AnalysisRequest) (dtrack.Analysis, error)); ok {
+		return rf(ctx, analysisReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.AnalysisRequest) dtrack.Analysis); ok {
+		r0 = rf(ctx, analysisReq)
+	} else {
+		r0 = ret.Get(0).(dtrack.Analysis)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.AnalysisRequest) error); ok {
+		r1 = rf(ctx, analysisReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalysisAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AnalysisAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - analysisReq dtrack.AnalysisRequest
+func (_e *AnalysisAPI_Expecter) Create(ctx interface{}, analysisReq interface{}) *AnalysisAPI_Create_Call {
+	return &AnalysisAPI_Create_Call{Call: _e.mock.On("Create", ctx, analysisReq)}
+}
+
+func (_c *AnalysisAPI_Create_Call) Run(run func(ctx context.Context, analysisReq dtrack.AnalysisRequest)) *AnalysisAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.AnalysisRequest))
+	})
+	return _c
+}
+
+func (_c *AnalysisAPI_Create_Call) Return(_a0 dtrack.Analysis, _a1 error) *AnalysisAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalysisAPI_Create_Call) RunAndReturn(run func(context.Context, dtrack.AnalysisRequest) (dtrack.Analysis, error)) *AnalysisAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, component, project, vulnerability
+func (_m *AnalysisAPI) Get(ctx context.Context, component uuid.UUID, project uuid.UUID, vulnerability uuid.UUID) (dtrack.Analysis, error) {
+	ret := _m.Called(ctx, component, project, vulnerability)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.Analysis
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (dtrack.Analysis, error)); ok {
+		return rf(ctx, component, project, vulnerability)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) dtrack.Analysis); ok {
+		r0 = rf(ctx, component, project, vulnerability)
+	} else {
+		r0 = ret.Get(0).(dtrack.Analysis)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, component, project, vulnerability)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalysisAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type AnalysisAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - component uuid.UUID
+//   - project uuid.UUID
+//   - vulnerability uuid.UUID
+func (_e *AnalysisAPI_Expecter) Get(ctx interface{}, component interface{}, project interface{}, vulnerability interface{}) *AnalysisAPI_Get_Call {
+	return &AnalysisAPI_Get_Call{Call: _e.mock.On("Get", ctx, component, project, vulnerability)}
+}
+
+func (_c *AnalysisAPI_Get_Call) Run(run func(ctx context.Context, component uuid.UUID, project uuid.UUID, vulnerability uuid.UUID)) *AnalysisAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AnalysisAPI_Get_Call) Return(_a0 dtrack.Analysis, _a1 error) *AnalysisAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalysisAPI_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) (dtrack.Analysis, error)) *AnalysisAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnalysisAPI creates a new instance of AnalysisAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnalysisAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnalysisAPI {
+	mock := &AnalysisAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,276 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// BadgeAPI is an autogenerated mock type for the BadgeAPI type
+type BadgeAPI struct {
+	mock.Mock
+}
+
+type BadgeAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BadgeAPI) EXPECT() *BadgeAPI_Expecter {
+	return &BadgeAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetProjectPolicyViolationsBadge provides a mock function with given fields: ctx, projectUUID
+func (_m *BadgeAPI) GetProjectPolicyViolationsBadge(ctx context.Context, projectUUID uuid.UUID) ([]byte, error) {
+	ret := _m.Called(ctx, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectPolicyViolationsBadge")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]byte, error)); ok {
+		return rf(ctx, projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []byte); ok {
+		r0 = rf(ctx, projectUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeAPI_GetProjectPolicyViolationsBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectPolicyViolationsBadge'
+type BadgeAPI_GetProjectPolicyViolationsBadge_Call struct {
+	*mock.Call
+}
+
+// GetProjectPolicyViolationsBadge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+func (_e *BadgeAPI_Expecter) GetProjectPolicyViolationsBadge(ctx interface{}, projectUUID interface{}) *BadgeAPI_GetProjectPolicyViolationsBadge_Call {
+	return &BadgeAPI_GetProjectPolicyViolationsBadge_Call{Call: _e.mock.On("GetProjectPolicyViolationsBadge", ctx, projectUUID)}
+}
+
+func (_c *BadgeAPI_GetProjectPolicyViolationsBadge_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID)) *BadgeAPI_GetProjectPolicyViolationsBadge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *BadgeAPI_GetProjectPolicyViolationsBadge_Call) Return(_a0 []byte, _a1 error) *BadgeAPI_GetProjectPolicyViolationsBadge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeAPI_GetProjectPolicyViolationsBadge_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]byte, error)) *BadgeAPI_GetProjectPolicyViolationsBadge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectPolicyViolationsBadgeByNameVersion provides a mock function with given fields: ctx, name, version
+func (_m *BadgeAPI) GetProjectPolicyViolationsBadgeByNameVersion(ctx context.Context, name string, version string) ([]byte, error) {
+	ret := _m.Called(ctx, name, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectPolicyViolationsBadgeByNameVersion")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]byte, error)); ok {
+		return rf(ctx, name, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []byte); ok {
+		r0 = rf(ctx, name, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeAPI_GetProjectPolicyViolationsBadgeByNameVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectPolicyViolationsBadgeByNameVersion'
+type BadgeAPI_GetProjectPolicyViolationsBadgeByNameVersion_Call struct {
+	*mock.Call
+}
+
+// GetProjectPolicyViolationsBadgeByNameVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - version string
+func (_e *BadgeAPI_Expecter) GetProjectPolicyViolationsBadgeByNameVersion(ctx interface{}, name interface{}, version interface{}) *BadgeAPI_GetProjectPolicyViolationsBadgeByNameVersion_Call {
+	return &BadgeAPI_GetProjectPolicyViolationsBadgeByNameVersion_Call{Call: _e.mock.On("GetProjectPolicyViolationsBadgeByNameVersion", ctx, name, version)}
+}
+
+func (_c *BadgeAPI_GetProjectPolicyViolationsBadgeByNameVersion_Call) Run(run func(ctx context.Context, name string, version string)) *BadgeAPI_GetProjectPolicyViolationsBadgeByNameVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *BadgeAPI_GetProjectPolicyViolationsBadgeByNameVersion_Call) Return(_a0 []byte, _a1 error) *BadgeAPI_GetProjectPolicyViolationsBadgeByNameVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeAPI_GetProjectPolicyViolationsBadgeByNameVersion_Call) RunAndReturn(run func(context.Context, string, string) ([]byte, error)) *BadgeAPI_GetProjectPolicyViolationsBadgeByNameVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectVulnerabilitiesBadge provides a mock function with given fields: ctx, projectUUID
+func (_m *BadgeAPI) GetProjectVulnerabilitiesBadge(ctx context.Context, projectUUID uuid.UUID) ([]byte, error) {
+	ret := _m.Called(ctx, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectVulnerabilitiesBadge")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]byte, error)); ok {
+		return rf(ctx, projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []byte); ok {
+		r0 = rf(ctx, projectUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeAPI_GetProjectVulnerabilitiesBadge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectVulnerabilitiesBadge'
+type BadgeAPI_GetProjectVulnerabilitiesBadge_Call struct {
+	*mock.Call
+}
+
+// GetProjectVulnerabilitiesBadge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+func (_e *BadgeAPI_Expecter) GetProjectVulnerabilitiesBadge(ctx interface{}, projectUUID interface{}) *BadgeAPI_GetProjectVulnerabilitiesBadge_Call {
+	return &BadgeAPI_GetProjectVulnerabilitiesBadge_Call{Call: _e.mock.On("GetProjectVulnerabilitiesBadge", ctx, projectUUID)}
+}
+
+func (_c *BadgeAPI_GetProjectVulnerabilitiesBadge_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID)) *BadgeAPI_GetProjectVulnerabilitiesBadge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *BadgeAPI_GetProjectVulnerabilitiesBadge_Call) Return(_a0 []byte, _a1 error) *BadgeAPI_GetProjectVulnerabilitiesBadge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeAPI_GetProjectVulnerabilitiesBadge_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]byte, error)) *BadgeAPI_GetProjectVulnerabilitiesBadge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectVulnerabilitiesBadgeByNameVersion provides a mock function with given fields: ctx, name, version
+func (_m *BadgeAPI) GetProjectVulnerabilitiesBadgeByNameVersion(ctx context.Context, name string, version string) ([]byte, error) {
+	ret := _m.Called(ctx, name, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectVulnerabilitiesBadgeByNameVersion")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]byte, error)); ok {
+		return rf(ctx, name, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []byte); ok {
+		r0 = rf(ctx, name, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BadgeAPI_GetProjectVulnerabilitiesBadgeByNameVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectVulnerabilitiesBadgeByNameVersion'
+type BadgeAPI_GetProjectVulnerabilitiesBadgeByNameVersion_Call struct {
+	*mock.Call
+}
+
+// GetProjectVulnerabilitiesBadgeByNameVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - version string
+func (_e *BadgeAPI_Expecter) GetProjectVulnerabilitiesBadgeByNameVersion(ctx interface{}, name interface{}, version interface{}) *BadgeAPI_GetProjectVulnerabilitiesBadgeByNameVersion_Call {
+	return &BadgeAPI_GetProjectVulnerabilitiesBadgeByNameVersion_Call{Call: _e.mock.On("GetProjectVulnerabilitiesBadgeByNameVersion", ctx, name, version)}
+}
+
+func (_c *BadgeAPI_GetProjectVulnerabilitiesBadgeByNameVersion_Call) Run(run func(ctx context.Context, name string, version string)) *BadgeAPI_GetProjectVulnerabilitiesBadgeByNameVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *BadgeAPI_GetProjectVulnerabilitiesBadgeByNameVersion_Call) Return(_a0 []byte, _a1 error) *BadgeAPI_GetProjectVulnerabilitiesBadgeByNameVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BadgeAPI_GetProjectVulnerabilitiesBadgeByNameVersion_Call) RunAndReturn(run func(context.Context, string, string) ([]byte, error)) *BadgeAPI_GetProjectVulnerabilitiesBadgeByNameVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBadgeAPI creates a new instance of BadgeAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBadgeAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BadgeAPI {
+	mock := &BadgeAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
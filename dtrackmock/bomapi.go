@@ -0,0 +1,602 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+	io "io"
+
+	dtrack "github.com/DependencyTrack/client-go"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// BOMAPI is an autogenerated mock type for the BOMAPI type
+type BOMAPI struct {
+	mock.Mock
+}
+
+type BOMAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BOMAPI) EXPECT() *BOMAPI_Expecter {
+	return &BOMAPI_Expecter{mock: &_m.Mock}
+}
+
+// ExportComponent provides a mock function with given fields: ctx, componentUUID, format
+func (_m *BOMAPI) ExportComponent(ctx context.Context, componentUUID uuid.UUID, format dtrack.BOMFormat) (string, error) {
+	ret := _m.Called(ctx, componentUUID, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportComponent")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.BOMFormat) (string, error)); ok {
+		return rf(ctx, componentUUID, format)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.BOMFormat) string); ok {
+		r0 = rf(ctx, componentUUID, format)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.BOMFormat) error); ok {
+		r1 = rf(ctx, componentUUID, format)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BOMAPI_ExportComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportComponent'
+type BOMAPI_ExportComponent_Call struct {
+	*mock.Call
+}
+
+// ExportComponent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - format dtrack.BOMFormat
+func (_e *BOMAPI_Expecter) ExportComponent(ctx interface{}, componentUUID interface{}, format interface{}) *BOMAPI_ExportComponent_Call {
+	return &BOMAPI_ExportComponent_Call{Call: _e.mock.On("ExportComponent", ctx, componentUUID, format)}
+}
+
+func (_c *BOMAPI_ExportComponent_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, format dtrack.BOMFormat)) *BOMAPI_ExportComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.BOMFormat))
+	})
+	return _c
+}
+
+func (_c *BOMAPI_ExportComponent_Call) Return(_a0 string, _a1 error) *BOMAPI_ExportComponent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BOMAPI_ExportComponent_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.BOMFormat) (string, error)) *BOMAPI_ExportComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportProject provides a mock function with given fields: ctx, projectUUID, format, variant
+func (_m *BOMAPI) ExportProject(ctx context.Context, projectUUID uuid.UUID, format dtrack.BOMFormat, variant dtrack.BOMVariant) (string, error) {
+	ret := _m.Called(ctx, projectUUID, format, variant)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportProject")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.BOMFormat, dtrack.BOMVariant) (string, error)); ok {
+		return rf(ctx, projectUUID, format, variant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.BOMFormat, dtrack.BOMVariant) string); ok {
+		r0 = rf(ctx, projectUUID, format, variant)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.BOMFormat, dtrack.BOMVariant) error); ok {
+		r1 = rf(ctx, projectUUID, format, variant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BOMAPI_ExportProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportProject'
+type BOMAPI_ExportProject_Call struct {
+	*mock.Call
+}
+
+// ExportProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - format dtrack.BOMFormat
+//   - variant dtrack.BOMVariant
+func (_e *BOMAPI_Expecter) ExportProject(ctx interface{}, projectUUID interface{}, format interface{}, variant interface{}) *BOMAPI_ExportProject_Call {
+	return &BOMAPI_ExportProject_Call{Call: _e.mock.On("ExportProject", ctx, projectUUID, format, variant)}
+}
+
+func (_c *BOMAPI_ExportProject_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, format dtrack.BOMFormat, variant dtrack.BOMVariant)) *BOMAPI_ExportProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.BOMFormat), args[3].(dtrack.BOMVariant))
+	})
+	return _c
+}
+
+func (_c *BOMAPI_ExportProject_Call) Return(_a0 string, _a1 error) *BOMAPI_ExportProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BOMAPI_ExportProject_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.BOMFormat, dtrack.BOMVariant) (string, error)) *BOMAPI_ExportProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportProjectJSON provides a mock function with given fields: ctx, projectUUID, variant, v
+func (_m *BOMAPI) ExportProjectJSON(ctx context.Context, projectUUID uuid.UUID, variant dtrack.BOMVariant, v interface{}) error {
+	ret := _m.Called(ctx, projectUUID, variant, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportProjectJSON")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.BOMVariant, interface{}) error); ok {
+		r0 = rf(ctx, projectUUID, variant, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BOMAPI_ExportProjectJSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportProjectJSON'
+type BOMAPI_ExportProjectJSON_Call struct {
+	*mock.Call
+}
+
+// ExportProjectJSON is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - variant dtrack.BOMVariant
+//   - v interface{}
+func (_e *BOMAPI_Expecter) ExportProjectJSON(ctx interface{}, projectUUID interface{}, variant interface{}, v interface{}) *BOMAPI_ExportProjectJSON_Call {
+	return &BOMAPI_ExportProjectJSON_Call{Call: _e.mock.On("ExportProjectJSON", ctx, projectUUID, variant, v)}
+}
+
+func (_c *BOMAPI_ExportProjectJSON_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, variant dtrack.BOMVariant, v interface{})) *BOMAPI_ExportProjectJSON_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.BOMVariant), args[3].(interface{}))
+	})
+	return _c
+}
+
+func (_c *BOMAPI_ExportProjectJSON_Call) Return(_a0 error) *BOMAPI_ExportProjectJSON_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BOMAPI_ExportProjectJSON_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.BOMVariant, interface{}) error) *BOMAPI_ExportProjectJSON_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBeingProcessed provides a mock function with given fields: ctx, token
+func (_m *BOMAPI) IsBeingProcessed(ctx context.Context, token dtrack.BOMUploadToken) (bool, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBeingProcessed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadToken) (bool, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadToken) bool); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.BOMUploadToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BOMAPI_IsBeingProcessed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBeingProcessed'
+type BOMAPI_IsBeingProcessed_Call struct {
+	*mock.Call
+}
+
+// IsBeingProcessed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token dtrack.BOMUploadToken
+func (_e *BOMAPI_Expecter) IsBeingProcessed(ctx interface{}, token interface{}) *BOMAPI_IsBeingProcessed_Call {
+	return &BOMAPI_IsBeingProcessed_Call{Call: _e.mock.On("IsBeingProcessed", ctx, token)}
+}
+
+func (_c *BOMAPI_IsBeingProcessed_Call) Run(run func(ctx context.Context, token dtrack.BOMUploadToken)) *BOMAPI_IsBeingProcessed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.BOMUploadToken))
+	})
+	return _c
+}
+
+func (_c *BOMAPI_IsBeingProcessed_Call) Return(_a0 bool, _a1 error) *BOMAPI_IsBeingProcessed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BOMAPI_IsBeingProcessed_Call) RunAndReturn(run func(context.Context, dtrack.BOMUploadToken) (bool, error)) *BOMAPI_IsBeingProcessed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostBom provides a mock function with given fields: ctx, uploadReq
+func (_m *BOMAPI) PostBom(ctx context.Context, uploadReq dtrack.BOMUploadRequest) (dtrack.BOMUploadToken, error) {
+	ret := _m.Called(ctx, uploadReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostBom")
+	}
+
+	var r0 dtrack.BOMUploadToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadRequest) (dtrack.BOMUploadToken, error)); ok {
+		return rf(ctx, uploadReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadRequest) dtrack.BOMUploadToken); ok {
+		r0 = rf(ctx, uploadReq)
+	} else {
+		r0 = ret.Get(0).(dtrack.BOMUploadToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.BOMUploadRequest) error); ok {
+		r1 = rf(ctx, uploadReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BOMAPI_PostBom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostBom'
+type BOMAPI_PostBom_Call struct {
+	*mock.Call
+}
+
+// PostBom is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadReq dtrack.BOMUploadRequest
+func (_e *BOMAPI_Expecter) PostBom(ctx interface{}, uploadReq interface{}) *BOMAPI_PostBom_Call {
+	return &BOMAPI_PostBom_Call{Call: _e.mock.On("PostBom", ctx, uploadReq)}
+}
+
+func (_c *BOMAPI_PostBom_Call) Run(run func(ctx context.Context, uploadReq dtrack.BOMUploadRequest)) *BOMAPI_PostBom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.BOMUploadRequest))
+	})
+	return _c
+}
+
+func (_c *BOMAPI_PostBom_Call) Return(_a0 dtrack.BOMUploadToken, _a1 error) *BOMAPI_PostBom_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BOMAPI_PostBom_Call) RunAndReturn(run func(context.Context, dtrack.BOMUploadRequest) (dtrack.BOMUploadToken, error)) *BOMAPI_PostBom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostBomStream provides a mock function with given fields: ctx, uploadReq, bom
+func (_m *BOMAPI) PostBomStream(ctx context.Context, uploadReq dtrack.BOMUploadRequest, bom io.Reader) (dtrack.BOMUploadToken, error) {
+	ret := _m.Called(ctx, uploadReq, bom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostBomStream")
+	}
+
+	var r0 dtrack.BOMUploadToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadRequest, io.Reader) (dtrack.BOMUploadToken, error)); ok {
+		return rf(ctx, uploadReq, bom)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadRequest, io.Reader) dtrack.BOMUploadToken); ok {
+		r0 = rf(ctx, uploadReq, bom)
+	} else {
+		r0 = ret.Get(0).(dtrack.BOMUploadToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.BOMUploadRequest, io.Reader) error); ok {
+		r1 = rf(ctx, uploadReq, bom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BOMAPI_PostBomStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostBomStream'
+type BOMAPI_PostBomStream_Call struct {
+	*mock.Call
+}
+
+// PostBomStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadReq dtrack.BOMUploadRequest
+//   - bom io.Reader
+func (_e *BOMAPI_Expecter) PostBomStream(ctx interface{}, uploadReq interface{}, bom interface{}) *BOMAPI_PostBomStream_Call {
+	return &BOMAPI_PostBomStream_Call{Call: _e.mock.On("PostBomStream", ctx, uploadReq, bom)}
+}
+
+func (_c *BOMAPI_PostBomStream_Call) Run(run func(ctx context.Context, uploadReq dtrack.BOMUploadRequest, bom io.Reader)) *BOMAPI_PostBomStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.BOMUploadRequest), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *BOMAPI_PostBomStream_Call) Return(_a0 dtrack.BOMUploadToken, _a1 error) *BOMAPI_PostBomStream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BOMAPI_PostBomStream_Call) RunAndReturn(run func(context.Context, dtrack.BOMUploadRequest, io.Reader) (dtrack.BOMUploadToken, error)) *BOMAPI_PostBomStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upload provides a mock function with given fields: ctx, uploadReq
+func (_m *BOMAPI) Upload(ctx context.Context, uploadReq dtrack.BOMUploadRequest) (dtrack.BOMUploadToken, error) {
+	ret := _m.Called(ctx, uploadReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upload")
+	}
+
+	var r0 dtrack.BOMUploadToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadRequest) (dtrack.BOMUploadToken, error)); ok {
+		return rf(ctx, uploadReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadRequest) dtrack.BOMUploadToken); ok {
+		r0 = rf(ctx, uploadReq)
+	} else {
+		r0 = ret.Get(0).(dtrack.BOMUploadToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.BOMUploadRequest) error); ok {
+		r1 = rf(ctx, uploadReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BOMAPI_Upload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upload'
+type BOMAPI_Upload_Call struct {
+	*mock.Call
+}
+
+// Upload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadReq dtrack.BOMUploadRequest
+func (_e *BOMAPI_Expecter) Upload(ctx interface{}, uploadReq interface{}) *BOMAPI_Upload_Call {
+	return &BOMAPI_Upload_Call{Call: _e.mock.On("Upload", ctx, uploadReq)}
+}
+
+func (_c *BOMAPI_Upload_Call) Run(run func(ctx context.Context, uploadReq dtrack.BOMUploadRequest)) *BOMAPI_Upload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.BOMUploadRequest))
+	})
+	return _c
+}
+
+func (_c *BOMAPI_Upload_Call) Return(_a0 dtrack.BOMUploadToken, _a1 error) *BOMAPI_Upload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BOMAPI_Upload_Call) RunAndReturn(run func(context.Context, dtrack.BOMUploadRequest) (dtrack.BOMUploadToken, error)) *BOMAPI_Upload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadDir provides a mock function with given fields: ctx, dir, opts
+func (_m *BOMAPI) UploadDir(ctx context.Context, dir string, opts dtrack.BulkUploadOptions) ([]dtrack.BOMUploadResult, error) {
+	ret := _m.Called(ctx, dir, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadDir")
+	}
+
+	var r0 []dtrack.BOMUploadResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dtrack.BulkUploadOptions) ([]dtrack.BOMUploadResult, error)); ok {
+		return rf(ctx, dir, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dtrack.BulkUploadOptions) []dtrack.BOMUploadResult); ok {
+		r0 = rf(ctx, dir, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.BOMUploadResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dtrack.BulkUploadOptions) error); ok {
+		r1 = rf(ctx, dir, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BOMAPI_UploadDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadDir'
+type BOMAPI_UploadDir_Call struct {
+	*mock.Call
+}
+
+// UploadDir is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dir string
+//   - opts dtrack.BulkUploadOptions
+func (_e *BOMAPI_Expecter) UploadDir(ctx interface{}, dir interface{}, opts interface{}) *BOMAPI_UploadDir_Call {
+	return &BOMAPI_UploadDir_Call{Call: _e.mock.On("UploadDir", ctx, dir, opts)}
+}
+
+func (_c *BOMAPI_UploadDir_Call) Run(run func(ctx context.Context, dir string, opts dtrack.BulkUploadOptions)) *BOMAPI_UploadDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(dtrack.BulkUploadOptions))
+	})
+	return _c
+}
+
+func (_c *BOMAPI_UploadDir_Call) Return(_a0 []dtrack.BOMUploadResult, _a1 error) *BOMAPI_UploadDir_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BOMAPI_UploadDir_Call) RunAndReturn(run func(context.Context, string, dtrack.BulkUploadOptions) ([]dtrack.BOMUploadResult, error)) *BOMAPI_UploadDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadJSON provides a mock function with given fields: ctx, uploadReq, bom
+func (_m *BOMAPI) UploadJSON(ctx context.Context, uploadReq dtrack.BOMUploadRequest, bom interface{}) (dtrack.BOMUploadToken, error) {
+	ret := _m.Called(ctx, uploadReq, bom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadJSON")
+	}
+
+	var r0 dtrack.BOMUploadToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadRequest, interface{}) (dtrack.BOMUploadToken, error)); ok {
+		return rf(ctx, uploadReq, bom)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadRequest, interface{}) dtrack.BOMUploadToken); ok {
+		r0 = rf(ctx, uploadReq, bom)
+	} else {
+		r0 = ret.Get(0).(dtrack.BOMUploadToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.BOMUploadRequest, interface{}) error); ok {
+		r1 = rf(ctx, uploadReq, bom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BOMAPI_UploadJSON_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadJSON'
+type BOMAPI_UploadJSON_Call struct {
+	*mock.Call
+}
+
+// UploadJSON is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadReq dtrack.BOMUploadRequest
+//   - bom interface{}
+func (_e *BOMAPI_Expecter) UploadJSON(ctx interface{}, uploadReq interface{}, bom interface{}) *BOMAPI_UploadJSON_Call {
+	return &BOMAPI_UploadJSON_Call{Call: _e.mock.On("UploadJSON", ctx, uploadReq, bom)}
+}
+
+func (_c *BOMAPI_UploadJSON_Call) Run(run func(ctx context.Context, uploadReq dtrack.BOMUploadRequest, bom interface{})) *BOMAPI_UploadJSON_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.BOMUploadRequest), args[2].(interface{}))
+	})
+	return _c
+}
+
+func (_c *BOMAPI_UploadJSON_Call) Return(_a0 dtrack.BOMUploadToken, _a1 error) *BOMAPI_UploadJSON_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BOMAPI_UploadJSON_Call) RunAndReturn(run func(context.Context, dtrack.BOMUploadRequest, interface{}) (dtrack.BOMUploadToken, error)) *BOMAPI_UploadJSON_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForProcessing provides a mock function with given fields: ctx, token, opts
+func (_m *BOMAPI) WaitForProcessing(ctx context.Context, token dtrack.BOMUploadToken, opts dtrack.PollingOptions) error {
+	ret := _m.Called(ctx, token, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForProcessing")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.BOMUploadToken, dtrack.PollingOptions) error); ok {
+		r0 = rf(ctx, token, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BOMAPI_WaitForProcessing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForProcessing'
+type BOMAPI_WaitForProcessing_Call struct {
+	*mock.Call
+}
+
+// WaitForProcessing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token dtrack.BOMUploadToken
+//   - opts dtrack.PollingOptions
+func (_e *BOMAPI_Expecter) WaitForProcessing(ctx interface{}, token interface{}, opts interface{}) *BOMAPI_WaitForProcessing_Call {
+	return &BOMAPI_WaitForProcessing_Call{Call: _e.mock.On("WaitForProcessing", ctx, token, opts)}
+}
+
+func (_c *BOMAPI_WaitForProcessing_Call) Run(run func(ctx context.Context, token dtrack.BOMUploadToken, opts dtrack.PollingOptions)) *BOMAPI_WaitForProcessing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.BOMUploadToken), args[2].(dtrack.PollingOptions))
+	})
+	return _c
+}
+
+func (_c *BOMAPI_WaitForProcessing_Call) Return(_a0 error) *BOMAPI_WaitForProcessing_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BOMAPI_WaitForProcessing_Call) RunAndReturn(run func(context.Context, dtrack.BOMUploadToken, dtrack.PollingOptions) error) *BOMAPI_WaitForProcessing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBOMAPI creates a new instance of BOMAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBOMAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BOMAPI {
+	mock := &BOMAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
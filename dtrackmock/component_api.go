@@ -0,0 +1,864 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ComponentAPI is an autogenerated mock type for the ComponentAPI type
+type ComponentAPI struct {
+	mock.Mock
+}
+
+type ComponentAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ComponentAPI) EXPECT() *ComponentAPI_Expecter {
+	return &ComponentAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, projectUUID, component
+func (_m *ComponentAPI) Create(ctx context.Context, projectUUID uuid.UUID, component dtrack.Component) (dtrack.Component, error) {
+	ret := _m.Called(ctx, projectUUID, component)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.Component) (dtrack.Component, error)); ok {
+		return rf(ctx, projectUUID, component)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.Component) dtrack.Component); ok {
+		r0 = rf(ctx, projectUUID, component)
+	} else {
+		r0 = ret.Get(0).(dtrack.Component)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.Component) error); ok {
+		r1 = rf(ctx, projectUUID, component)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ComponentAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - component dtrack.Component
+func (_e *ComponentAPI_Expecter) Create(ctx interface{}, projectUUID interface{}, component interface{}) *ComponentAPI_Create_Call {
+	return &ComponentAPI_Create_Call{Call: _e.mock.On("Create", ctx, projectUUID, component)}
+}
+
+func (_c *ComponentAPI_Create_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, component dtrack.Component)) *ComponentAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.Component))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_Create_Call) Return(_a0 dtrack.Component, _a1 error) *ComponentAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_Create_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.Component) (dtrack.Component, error)) *ComponentAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateProperty provides a mock function with given fields: ctx, componentUUID, property
+func (_m *ComponentAPI) CreateProperty(ctx context.Context, componentUUID uuid.UUID, property dtrack.ComponentProperty) (dtrack.ComponentProperty, error) {
+	ret := _m.Called(ctx, componentUUID, property)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProperty")
+	}
+
+	var r0 dtrack.ComponentProperty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.ComponentProperty) (dtrack.ComponentProperty, error)); ok {
+		return rf(ctx, componentUUID, property)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.ComponentProperty) dtrack.ComponentProperty); ok {
+		r0 = rf(ctx, componentUUID, property)
+	} else {
+		r0 = ret.Get(0).(dtrack.ComponentProperty)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.ComponentProperty) error); ok {
+		r1 = rf(ctx, componentUUID, property)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_CreateProperty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProperty'
+type ComponentAPI_CreateProperty_Call struct {
+	*mock.Call
+}
+
+// CreateProperty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - property dtrack.ComponentProperty
+func (_e *ComponentAPI_Expecter) CreateProperty(ctx interface{}, componentUUID interface{}, property interface{}) *ComponentAPI_CreateProperty_Call {
+	return &ComponentAPI_CreateProperty_Call{Call: _e.mock.On("CreateProperty", ctx, componentUUID, property)}
+}
+
+func (_c *ComponentAPI_CreateProperty_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, property dtrack.ComponentProperty)) *ComponentAPI_CreateProperty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.ComponentProperty))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_CreateProperty_Call) Return(_a0 dtrack.ComponentProperty, _a1 error) *ComponentAPI_CreateProperty_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_CreateProperty_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.ComponentProperty) (dtrack.ComponentProperty, error)) *ComponentAPI_CreateProperty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, componentUUID
+func (_m *ComponentAPI) Delete(ctx context.Context, componentUUID uuid.UUID) error {
+	ret := _m.Called(ctx, componentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, componentUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ComponentAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ComponentAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+func (_e *ComponentAPI_Expecter) Delete(ctx interface{}, componentUUID interface{}) *ComponentAPI_Delete_Call {
+	return &ComponentAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, componentUUID)}
+}
+
+func (_c *ComponentAPI_Delete_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID)) *ComponentAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_Delete_Call) Return(_a0 error) *ComponentAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ComponentAPI_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ComponentAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProperty provides a mock function with given fields: ctx, componentUUID, propertyUUID
+func (_m *ComponentAPI) DeleteProperty(ctx context.Context, componentUUID uuid.UUID, propertyUUID uuid.UUID) error {
+	ret := _m.Called(ctx, componentUUID, propertyUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProperty")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, componentUUID, propertyUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ComponentAPI_DeleteProperty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProperty'
+type ComponentAPI_DeleteProperty_Call struct {
+	*mock.Call
+}
+
+// DeleteProperty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - propertyUUID uuid.UUID
+func (_e *ComponentAPI_Expecter) DeleteProperty(ctx interface{}, componentUUID interface{}, propertyUUID interface{}) *ComponentAPI_DeleteProperty_Call {
+	return &ComponentAPI_DeleteProperty_Call{Call: _e.mock.On("DeleteProperty", ctx, componentUUID, propertyUUID)}
+}
+
+func (_c *ComponentAPI_DeleteProperty_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, propertyUUID uuid.UUID)) *ComponentAPI_DeleteProperty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_DeleteProperty_Call) Return(_a0 error) *ComponentAPI_DeleteProperty_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ComponentAPI_DeleteProperty_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *ComponentAPI_DeleteProperty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, componentUUID
+func (_m *ComponentAPI) Get(ctx context.Context, componentUUID uuid.UUID) (dtrack.Component, error) {
+	ret := _m.Called(ctx, componentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.Component, error)); ok {
+		return rf(ctx, componentUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.Component); ok {
+		r0 = rf(ctx, componentUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.Component)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, componentUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ComponentAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+func (_e *ComponentAPI_Expecter) Get(ctx interface{}, componentUUID interface{}) *ComponentAPI_Get_Call {
+	return &ComponentAPI_Get_Call{Call: _e.mock.On("Get", ctx, componentUUID)}
+}
+
+func (_c *ComponentAPI_Get_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID)) *ComponentAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_Get_Call) Return(_a0 dtrack.Component, _a1 error) *ComponentAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.Component, error)) *ComponentAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, projectUUID, po, filterOptions
+func (_m *ComponentAPI) GetAll(ctx context.Context, projectUUID uuid.UUID, po dtrack.PageOptions, filterOptions dtrack.ComponentFilterOptions) (dtrack.Page[dtrack.Component], error) {
+	ret := _m.Called(ctx, projectUUID, po, filterOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.Component]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions, dtrack.ComponentFilterOptions) (dtrack.Page[dtrack.Component], error)); ok {
+		return rf(ctx, projectUUID, po, filterOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions, dtrack.ComponentFilterOptions) dtrack.Page[dtrack.Component]); ok {
+		r0 = rf(ctx, projectUUID, po, filterOptions)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Component])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.PageOptions, dtrack.ComponentFilterOptions) error); ok {
+		r1 = rf(ctx, projectUUID, po, filterOptions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ComponentAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - po dtrack.PageOptions
+//   - filterOptions dtrack.ComponentFilterOptions
+func (_e *ComponentAPI_Expecter) GetAll(ctx interface{}, projectUUID interface{}, po interface{}, filterOptions interface{}) *ComponentAPI_GetAll_Call {
+	return &ComponentAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, projectUUID, po, filterOptions)}
+}
+
+func (_c *ComponentAPI_GetAll_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, po dtrack.PageOptions, filterOptions dtrack.ComponentFilterOptions)) *ComponentAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.PageOptions), args[3].(dtrack.ComponentFilterOptions))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.Component], _a1 error) *ComponentAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_GetAll_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.PageOptions, dtrack.ComponentFilterOptions) (dtrack.Page[dtrack.Component], error)) *ComponentAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByHash provides a mock function with given fields: ctx, hash, po, so
+func (_m *ComponentAPI) GetByHash(ctx context.Context, hash string, po dtrack.PageOptions, so dtrack.SortOptions) (dtrack.Page[dtrack.Component], error) {
+	ret := _m.Called(ctx, hash, po, so)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByHash")
+	}
+
+	var r0 dtrack.Page[dtrack.Component]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.Component], error)); ok {
+		return rf(ctx, hash, po, so)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) dtrack.Page[dtrack.Component]); ok {
+		r0 = rf(ctx, hash, po, so)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Component])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) error); ok {
+		r1 = rf(ctx, hash, po, so)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_GetByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByHash'
+type ComponentAPI_GetByHash_Call struct {
+	*mock.Call
+}
+
+// GetByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hash string
+//   - po dtrack.PageOptions
+//   - so dtrack.SortOptions
+func (_e *ComponentAPI_Expecter) GetByHash(ctx interface{}, hash interface{}, po interface{}, so interface{}) *ComponentAPI_GetByHash_Call {
+	return &ComponentAPI_GetByHash_Call{Call: _e.mock.On("GetByHash", ctx, hash, po, so)}
+}
+
+func (_c *ComponentAPI_GetByHash_Call) Run(run func(ctx context.Context, hash string, po dtrack.PageOptions, so dtrack.SortOptions)) *ComponentAPI_GetByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(dtrack.PageOptions), args[3].(dtrack.SortOptions))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_GetByHash_Call) Return(_a0 dtrack.Page[dtrack.Component], _a1 error) *ComponentAPI_GetByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_GetByHash_Call) RunAndReturn(run func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.Component], error)) *ComponentAPI_GetByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByIdentity provides a mock function with given fields: ctx, po, so, io
+func (_m *ComponentAPI) GetByIdentity(ctx context.Context, po dtrack.PageOptions, so dtrack.SortOptions, io dtrack.ComponentIdentityQueryOptions) (dtrack.Page[dtrack.Component], error) {
+	ret := _m.Called(ctx, po, so, io)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIdentity")
+	}
+
+	var r0 dtrack.Page[dtrack.Component]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions, dtrack.SortOptions, dtrack.ComponentIdentityQueryOptions) (dtrack.Page[dtrack.Component], error)); ok {
+		return rf(ctx, po, so, io)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions, dtrack.SortOptions, dtrack.ComponentIdentityQueryOptions) dtrack.Page[dtrack.Component]); ok {
+		r0 = rf(ctx, po, so, io)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Component])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions, dtrack.SortOptions, dtrack.ComponentIdentityQueryOptions) error); ok {
+		r1 = rf(ctx, po, so, io)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_GetByIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIdentity'
+type ComponentAPI_GetByIdentity_Call struct {
+	*mock.Call
+}
+
+// GetByIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+//   - so dtrack.SortOptions
+//   - io dtrack.ComponentIdentityQueryOptions
+func (_e *ComponentAPI_Expecter) GetByIdentity(ctx interface{}, po interface{}, so interface{}, io interface{}) *ComponentAPI_GetByIdentity_Call {
+	return &ComponentAPI_GetByIdentity_Call{Call: _e.mock.On("GetByIdentity", ctx, po, so, io)}
+}
+
+func (_c *ComponentAPI_GetByIdentity_Call) Run(run func(ctx context.Context, po dtrack.PageOptions, so dtrack.SortOptions, io dtrack.ComponentIdentityQueryOptions)) *ComponentAPI_GetByIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions), args[2].(dtrack.SortOptions), args[3].(dtrack.ComponentIdentityQueryOptions))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_GetByIdentity_Call) Return(_a0 dtrack.Page[dtrack.Component], _a1 error) *ComponentAPI_GetByIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_GetByIdentity_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions, dtrack.SortOptions, dtrack.ComponentIdentityQueryOptions) (dtrack.Page[dtrack.Component], error)) *ComponentAPI_GetByIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInternalIdentification provides a mock function with given fields: ctx
+func (_m *ComponentAPI) GetInternalIdentification(ctx context.Context) (dtrack.InternalComponentIdentification, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInternalIdentification")
+	}
+
+	var r0 dtrack.InternalComponentIdentification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dtrack.InternalComponentIdentification, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dtrack.InternalComponentIdentification); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dtrack.InternalComponentIdentification)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_GetInternalIdentification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInternalIdentification'
+type ComponentAPI_GetInternalIdentification_Call struct {
+	*mock.Call
+}
+
+// GetInternalIdentification is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ComponentAPI_Expecter) GetInternalIdentification(ctx interface{}) *ComponentAPI_GetInternalIdentification_Call {
+	return &ComponentAPI_GetInternalIdentification_Call{Call: _e.mock.On("GetInternalIdentification", ctx)}
+}
+
+func (_c *ComponentAPI_GetInternalIdentification_Call) Run(run func(ctx context.Context)) *ComponentAPI_GetInternalIdentification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_GetInternalIdentification_Call) Return(_a0 dtrack.InternalComponentIdentification, _a1 error) *ComponentAPI_GetInternalIdentification_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_GetInternalIdentification_Call) RunAndReturn(run func(context.Context) (dtrack.InternalComponentIdentification, error)) *ComponentAPI_GetInternalIdentification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOccurrences provides a mock function with given fields: ctx, componentUUID, po
+func (_m *ComponentAPI) GetOccurrences(ctx context.Context, componentUUID uuid.UUID, po dtrack.PageOptions) (dtrack.Page[dtrack.ComponentOccurrence], error) {
+	ret := _m.Called(ctx, componentUUID, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOccurrences")
+	}
+
+	var r0 dtrack.Page[dtrack.ComponentOccurrence]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions) (dtrack.Page[dtrack.ComponentOccurrence], error)); ok {
+		return rf(ctx, componentUUID, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions) dtrack.Page[dtrack.ComponentOccurrence]); ok {
+		r0 = rf(ctx, componentUUID, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.ComponentOccurrence])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, componentUUID, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_GetOccurrences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOccurrences'
+type ComponentAPI_GetOccurrences_Call struct {
+	*mock.Call
+}
+
+// GetOccurrences is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - po dtrack.PageOptions
+func (_e *ComponentAPI_Expecter) GetOccurrences(ctx interface{}, componentUUID interface{}, po interface{}) *ComponentAPI_GetOccurrences_Call {
+	return &ComponentAPI_GetOccurrences_Call{Call: _e.mock.On("GetOccurrences", ctx, componentUUID, po)}
+}
+
+func (_c *ComponentAPI_GetOccurrences_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, po dtrack.PageOptions)) *ComponentAPI_GetOccurrences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_GetOccurrences_Call) Return(_a0 dtrack.Page[dtrack.ComponentOccurrence], _a1 error) *ComponentAPI_GetOccurrences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_GetOccurrences_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.PageOptions) (dtrack.Page[dtrack.ComponentOccurrence], error)) *ComponentAPI_GetOccurrences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProperties provides a mock function with given fields: ctx, componentUUID
+func (_m *ComponentAPI) GetProperties(ctx context.Context, componentUUID uuid.UUID) ([]dtrack.ComponentProperty, error) {
+	ret := _m.Called(ctx, componentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProperties")
+	}
+
+	var r0 []dtrack.ComponentProperty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dtrack.ComponentProperty, error)); ok {
+		return rf(ctx, componentUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dtrack.ComponentProperty); ok {
+		r0 = rf(ctx, componentUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.ComponentProperty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, componentUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_GetProperties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProperties'
+type ComponentAPI_GetProperties_Call struct {
+	*mock.Call
+}
+
+// GetProperties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+func (_e *ComponentAPI_Expecter) GetProperties(ctx interface{}, componentUUID interface{}) *ComponentAPI_GetProperties_Call {
+	return &ComponentAPI_GetProperties_Call{Call: _e.mock.On("GetProperties", ctx, componentUUID)}
+}
+
+func (_c *ComponentAPI_GetProperties_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID)) *ComponentAPI_GetProperties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_GetProperties_Call) Return(_a0 []dtrack.ComponentProperty, _a1 error) *ComponentAPI_GetProperties_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_GetProperties_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dtrack.ComponentProperty, error)) *ComponentAPI_GetProperties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepositoryMeta provides a mock function with given fields: ctx, componentUUID
+func (_m *ComponentAPI) GetRepositoryMeta(ctx context.Context, componentUUID uuid.UUID) (dtrack.RepositoryMetaComponent, error) {
+	ret := _m.Called(ctx, componentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepositoryMeta")
+	}
+
+	var r0 dtrack.RepositoryMetaComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.RepositoryMetaComponent, error)); ok {
+		return rf(ctx, componentUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.RepositoryMetaComponent); ok {
+		r0 = rf(ctx, componentUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.RepositoryMetaComponent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, componentUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_GetRepositoryMeta_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepositoryMeta'
+type ComponentAPI_GetRepositoryMeta_Call struct {
+	*mock.Call
+}
+
+// GetRepositoryMeta is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+func (_e *ComponentAPI_Expecter) GetRepositoryMeta(ctx interface{}, componentUUID interface{}) *ComponentAPI_GetRepositoryMeta_Call {
+	return &ComponentAPI_GetRepositoryMeta_Call{Call: _e.mock.On("GetRepositoryMeta", ctx, componentUUID)}
+}
+
+func (_c *ComponentAPI_GetRepositoryMeta_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID)) *ComponentAPI_GetRepositoryMeta_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_GetRepositoryMeta_Call) Return(_a0 dtrack.RepositoryMetaComponent, _a1 error) *ComponentAPI_GetRepositoryMeta_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_GetRepositoryMeta_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.RepositoryMetaComponent, error)) *ComponentAPI_GetRepositoryMeta_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IdentifyInternal provides a mock function with given fields: ctx
+func (_m *ComponentAPI) IdentifyInternal(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IdentifyInternal")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ComponentAPI_IdentifyInternal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IdentifyInternal'
+type ComponentAPI_IdentifyInternal_Call struct {
+	*mock.Call
+}
+
+// IdentifyInternal is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ComponentAPI_Expecter) IdentifyInternal(ctx interface{}) *ComponentAPI_IdentifyInternal_Call {
+	return &ComponentAPI_IdentifyInternal_Call{Call: _e.mock.On("IdentifyInternal", ctx)}
+}
+
+func (_c *ComponentAPI_IdentifyInternal_Call) Run(run func(ctx context.Context)) *ComponentAPI_IdentifyInternal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_IdentifyInternal_Call) Return(_a0 error) *ComponentAPI_IdentifyInternal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ComponentAPI_IdentifyInternal_Call) RunAndReturn(run func(context.Context) error) *ComponentAPI_IdentifyInternal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, component
+func (_m *ComponentAPI) Update(ctx context.Context, component dtrack.Component) (dtrack.Component, error) {
+	ret := _m.Called(ctx, component)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Component) (dtrack.Component, error)); ok {
+		return rf(ctx, component)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Component) dtrack.Component); ok {
+		r0 = rf(ctx, component)
+	} else {
+		r0 = ret.Get(0).(dtrack.Component)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Component) error); ok {
+		r1 = rf(ctx, component)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ComponentAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - component dtrack.Component
+func (_e *ComponentAPI_Expecter) Update(ctx interface{}, component interface{}) *ComponentAPI_Update_Call {
+	return &ComponentAPI_Update_Call{Call: _e.mock.On("Update", ctx, component)}
+}
+
+func (_c *ComponentAPI_Update_Call) Run(run func(ctx context.Context, component dtrack.Component)) *ComponentAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Component))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_Update_Call) Return(_a0 dtrack.Component, _a1 error) *ComponentAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ComponentAPI_Update_Call) RunAndReturn(run func(context.Context, dtrack.Component) (dtrack.Component, error)) *ComponentAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateInternalIdentification provides a mock function with given fields: ctx, ici
+func (_m *ComponentAPI) UpdateInternalIdentification(ctx context.Context, ici dtrack.InternalComponentIdentification) error {
+	ret := _m.Called(ctx, ici)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateInternalIdentification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.InternalComponentIdentification) error); ok {
+		r0 = rf(ctx, ici)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ComponentAPI_UpdateInternalIdentification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInternalIdentification'
+type ComponentAPI_UpdateInternalIdentification_Call struct {
+	*mock.Call
+}
+
+// UpdateInternalIdentification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ici dtrack.InternalComponentIdentification
+func (_e *ComponentAPI_Expecter) UpdateInternalIdentification(ctx interface{}, ici interface{}) *ComponentAPI_UpdateInternalIdentification_Call {
+	return &ComponentAPI_UpdateInternalIdentification_Call{Call: _e.mock.On("UpdateInternalIdentification", ctx, ici)}
+}
+
+func (_c *ComponentAPI_UpdateInternalIdentification_Call) Run(run func(ctx context.Context, ici dtrack.InternalComponentIdentification)) *ComponentAPI_UpdateInternalIdentification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.InternalComponentIdentification))
+	})
+	return _c
+}
+
+func (_c *ComponentAPI_UpdateInternalIdentification_Call) Return(_a0 error) *ComponentAPI_UpdateInternalIdentification_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ComponentAPI_UpdateInternalIdentification_Call) RunAndReturn(run func(context.Context, dtrack.InternalComponentIdentification) error) *ComponentAPI_UpdateInternalIdentification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewComponentAPI creates a new instance of ComponentAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewComponentAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ComponentAPI {
+	mock := &ComponentAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
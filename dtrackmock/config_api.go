@@ -0,0 +1,269 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConfigAPI is an autogenerated mock type for the ConfigAPI type
+type ConfigAPI struct {
+	mock.Mock
+}
+
+type ConfigAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ConfigAPI) EXPECT() *ConfigAPI_Expecter {
+	return &ConfigAPI_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, groupName, propertyName
+func (_m *ConfigAPI) Get(ctx context.Context, groupName string, propertyName string) (dtrack.ConfigProperty, error) {
+	ret := _m.Called(ctx, groupName, propertyName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.ConfigProperty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (dtrack.ConfigProperty, error)); ok {
+		return rf(ctx, groupName, propertyName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) dtrack.ConfigProperty); ok {
+		r0 = rf(ctx, groupName, propertyName)
+	} else {
+		r0 = ret.Get(0).(dtrack.ConfigProperty)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, groupName, propertyName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ConfigAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupName string
+//   - propertyName string
+func (_e *ConfigAPI_Expecter) Get(ctx interface{}, groupName interface{}, propertyName interface{}) *ConfigAPI_Get_Call {
+	return &ConfigAPI_Get_Call{Call: _e.mock.On("Get", ctx, groupName, propertyName)}
+}
+
+func (_c *ConfigAPI_Get_Call) Run(run func(ctx context.Context, groupName string, propertyName string)) *ConfigAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ConfigAPI_Get_Call) Return(_a0 dtrack.ConfigProperty, _a1 error) *ConfigAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConfigAPI_Get_Call) RunAndReturn(run func(context.Context, string, string) (dtrack.ConfigProperty, error)) *ConfigAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *ConfigAPI) GetAll(ctx context.Context) ([]dtrack.ConfigProperty, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 []dtrack.ConfigProperty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]dtrack.ConfigProperty, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []dtrack.ConfigProperty); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.ConfigProperty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ConfigAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ConfigAPI_Expecter) GetAll(ctx interface{}) *ConfigAPI_GetAll_Call {
+	return &ConfigAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+}
+
+func (_c *ConfigAPI_GetAll_Call) Run(run func(ctx context.Context)) *ConfigAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ConfigAPI_GetAll_Call) Return(_a0 []dtrack.ConfigProperty, _a1 error) *ConfigAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConfigAPI_GetAll_Call) RunAndReturn(run func(context.Context) ([]dtrack.ConfigProperty, error)) *ConfigAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, config
+func (_m *ConfigAPI) Update(ctx context.Context, config dtrack.ConfigProperty) (dtrack.ConfigProperty, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.ConfigProperty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ConfigProperty) (dtrack.ConfigProperty, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ConfigProperty) dtrack.ConfigProperty); ok {
+		r0 = rf(ctx, config)
+	} else {
+		r0 = ret.Get(0).(dtrack.ConfigProperty)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.ConfigProperty) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ConfigAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config dtrack.ConfigProperty
+func (_e *ConfigAPI_Expecter) Update(ctx interface{}, config interface{}) *ConfigAPI_Update_Call {
+	return &ConfigAPI_Update_Call{Call: _e.mock.On("Update", ctx, config)}
+}
+
+func (_c *ConfigAPI_Update_Call) Run(run func(ctx context.Context, config dtrack.ConfigProperty)) *ConfigAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.ConfigProperty))
+	})
+	return _c
+}
+
+func (_c *ConfigAPI_Update_Call) Return(_a0 dtrack.ConfigProperty, _a1 error) *ConfigAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConfigAPI_Update_Call) RunAndReturn(run func(context.Context, dtrack.ConfigProperty) (dtrack.ConfigProperty, error)) *ConfigAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAll provides a mock function with given fields: ctx, configs
+func (_m *ConfigAPI) UpdateAll(ctx context.Context, configs []dtrack.ConfigProperty) ([]dtrack.ConfigProperty, error) {
+	ret := _m.Called(ctx, configs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAll")
+	}
+
+	var r0 []dtrack.ConfigProperty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []dtrack.ConfigProperty) ([]dtrack.ConfigProperty, error)); ok {
+		return rf(ctx, configs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []dtrack.ConfigProperty) []dtrack.ConfigProperty); ok {
+		r0 = rf(ctx, configs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.ConfigProperty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []dtrack.ConfigProperty) error); ok {
+		r1 = rf(ctx, configs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigAPI_UpdateAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAll'
+type ConfigAPI_UpdateAll_Call struct {
+	*mock.Call
+}
+
+// UpdateAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - configs []dtrack.ConfigProperty
+func (_e *ConfigAPI_Expecter) UpdateAll(ctx interface{}, configs interface{}) *ConfigAPI_UpdateAll_Call {
+	return &ConfigAPI_UpdateAll_Call{Call: _e.mock.On("UpdateAll", ctx, configs)}
+}
+
+func (_c *ConfigAPI_UpdateAll_Call) Run(run func(ctx context.Context, configs []dtrack.ConfigProperty)) *ConfigAPI_UpdateAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]dtrack.ConfigProperty))
+	})
+	return _c
+}
+
+func (_c *ConfigAPI_UpdateAll_Call) Return(_a0 []dtrack.ConfigProperty, _a1 error) *ConfigAPI_UpdateAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConfigAPI_UpdateAll_Call) RunAndReturn(run func(context.Context, []dtrack.ConfigProperty) ([]dtrack.ConfigProperty, error)) *ConfigAPI_UpdateAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConfigAPI creates a new instance of ConfigAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConfigAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConfigAPI {
+	mock := &ConfigAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
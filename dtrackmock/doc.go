@@ -0,0 +1,5 @@
+// Package dtrackmock provides mocks of the service interfaces of the dtrack package,
+// based on github.com/stretchr/testify/mock.
+//
+// The mocks are generated using mockery, run "go generate" in the repository's root to update them.
+package dtrackmock
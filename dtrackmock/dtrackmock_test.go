@@ -0,0 +1,31 @@
+package dtrackmock
+
+import (
+	"context"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectAPI(t *testing.T) {
+	projectUUID := uuid.New()
+
+	projects := NewProjectAPI(t)
+	projects.EXPECT().
+		Lookup(mock.Anything, "acme-app", "1.0.0").
+		Return(dtrack.Project{UUID: projectUUID, Name: "acme-app", Version: "1.0.0"}, nil).
+		Once()
+
+	// Code under test accepts the interface, rather than a *dtrack.Client.
+	lookup := func(ctx context.Context, api dtrack.ProjectAPI) (uuid.UUID, error) {
+		project, err := api.Lookup(ctx, "acme-app", "1.0.0")
+		return project.UUID, err
+	}
+
+	actualUUID, err := lookup(context.Background(), projects)
+	require.NoError(t, err)
+	require.Equal(t, projectUUID, actualUUID)
+}
@@ -0,0 +1,142 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventAPI is an autogenerated mock type for the EventAPI type
+type EventAPI struct {
+	mock.Mock
+}
+
+type EventAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EventAPI) EXPECT() *EventAPI_Expecter {
+	return &EventAPI_Expecter{mock: &_m.Mock}
+}
+
+// IsBeingProcessed provides a mock function with given fields: ctx, token
+func (_m *EventAPI) IsBeingProcessed(ctx context.Context, token dtrack.EventToken) (bool, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBeingProcessed")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.EventToken) (bool, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.EventToken) bool); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.EventToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventAPI_IsBeingProcessed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBeingProcessed'
+type EventAPI_IsBeingProcessed_Call struct {
+	*mock.Call
+}
+
+// IsBeingProcessed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token dtrack.EventToken
+func (_e *EventAPI_Expecter) IsBeingProcessed(ctx interface{}, token interface{}) *EventAPI_IsBeingProcessed_Call {
+	return &EventAPI_IsBeingProcessed_Call{Call: _e.mock.On("IsBeingProcessed", ctx, token)}
+}
+
+func (_c *EventAPI_IsBeingProcessed_Call) Run(run func(ctx context.Context, token dtrack.EventToken)) *EventAPI_IsBeingProcessed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.EventToken))
+	})
+	return _c
+}
+
+func (_c *EventAPI_IsBeingProcessed_Call) Return(_a0 bool, _a1 error) *EventAPI_IsBeingProcessed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EventAPI_IsBeingProcessed_Call) RunAndReturn(run func(context.Context, dtrack.EventToken) (bool, error)) *EventAPI_IsBeingProcessed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForProcessing provides a mock function with given fields: ctx, token, opts
+func (_m *EventAPI) WaitForProcessing(ctx context.Context, token dtrack.EventToken, opts dtrack.PollingOptions) error {
+	ret := _m.Called(ctx, token, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForProcessing")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.EventToken, dtrack.PollingOptions) error); ok {
+		r0 = rf(ctx, token, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EventAPI_WaitForProcessing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForProcessing'
+type EventAPI_WaitForProcessing_Call struct {
+	*mock.Call
+}
+
+// WaitForProcessing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token dtrack.EventToken
+//   - opts dtrack.PollingOptions
+func (_e *EventAPI_Expecter) WaitForProcessing(ctx interface{}, token interface{}, opts interface{}) *EventAPI_WaitForProcessing_Call {
+	return &EventAPI_WaitForProcessing_Call{Call: _e.mock.On("WaitForProcessing", ctx, token, opts)}
+}
+
+func (_c *EventAPI_WaitForProcessing_Call) Run(run func(ctx context.Context, token dtrack.EventToken, opts dtrack.PollingOptions)) *EventAPI_WaitForProcessing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.EventToken), args[2].(dtrack.PollingOptions))
+	})
+	return _c
+}
+
+func (_c *EventAPI_WaitForProcessing_Call) Return(_a0 error) *EventAPI_WaitForProcessing_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EventAPI_WaitForProcessing_Call) RunAndReturn(run func(context.Context, dtrack.EventToken, dtrack.PollingOptions) error) *EventAPI_WaitForProcessing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEventAPI creates a new instance of EventAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventAPI {
+	mock := &EventAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,451 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// FindingAPI is an autogenerated mock type for the FindingAPI type
+type FindingAPI struct {
+	mock.Mock
+}
+
+type FindingAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FindingAPI) EXPECT() *FindingAPI_Expecter {
+	return &FindingAPI_Expecter{mock: &_m.Mock}
+}
+
+// AnalyzeProject provides a mock function with given fields: ctx, projectUUID
+func (_m *FindingAPI) AnalyzeProject(ctx context.Context, projectUUID uuid.UUID) (dtrack.BOMUploadToken, error) {
+	ret := _m.Called(ctx, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnalyzeProject")
+	}
+
+	var r0 dtrack.BOMUploadToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.BOMUploadToken, error)); ok {
+		return rf(ctx, projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.BOMUploadToken); ok {
+		r0 = rf(ctx, projectUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.BOMUploadToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindingAPI_AnalyzeProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnalyzeProject'
+type FindingAPI_AnalyzeProject_Call struct {
+	*mock.Call
+}
+
+// AnalyzeProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+func (_e *FindingAPI_Expecter) AnalyzeProject(ctx interface{}, projectUUID interface{}) *FindingAPI_AnalyzeProject_Call {
+	return &FindingAPI_AnalyzeProject_Call{Call: _e.mock.On("AnalyzeProject", ctx, projectUUID)}
+}
+
+func (_c *FindingAPI_AnalyzeProject_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID)) *FindingAPI_AnalyzeProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *FindingAPI_AnalyzeProject_Call) Return(_a0 dtrack.BOMUploadToken, _a1 error) *FindingAPI_AnalyzeProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FindingAPI_AnalyzeProject_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.BOMUploadToken, error)) *FindingAPI_AnalyzeProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportFPF provides a mock function with given fields: ctx, projectUUID
+func (_m *FindingAPI) ExportFPF(ctx context.Context, projectUUID uuid.UUID) ([]byte, error) {
+	ret := _m.Called(ctx, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportFPF")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]byte, error)); ok {
+		return rf(ctx, projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []byte); ok {
+		r0 = rf(ctx, projectUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindingAPI_ExportFPF_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportFPF'
+type FindingAPI_ExportFPF_Call struct {
+	*mock.Call
+}
+
+// ExportFPF is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+func (_e *FindingAPI_Expecter) ExportFPF(ctx interface{}, projectUUID interface{}) *FindingAPI_ExportFPF_Call {
+	return &FindingAPI_ExportFPF_Call{Call: _e.mock.On("ExportFPF", ctx, projectUUID)}
+}
+
+func (_c *FindingAPI_ExportFPF_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID)) *FindingAPI_ExportFPF_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *FindingAPI_ExportFPF_Call) Return(_a0 []byte, _a1 error) *FindingAPI_ExportFPF_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FindingAPI_ExportFPF_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]byte, error)) *FindingAPI_ExportFPF_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, projectUUID, suppressed, po
+func (_m *FindingAPI) GetAll(ctx context.Context, projectUUID uuid.UUID, suppressed bool, po dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error) {
+	ret := _m.Called(ctx, projectUUID, suppressed, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.Finding]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error)); ok {
+		return rf(ctx, projectUUID, suppressed, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) dtrack.Page[dtrack.Finding]); ok {
+		r0 = rf(ctx, projectUUID, suppressed, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Finding])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, projectUUID, suppressed, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindingAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type FindingAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - suppressed bool
+//   - po dtrack.PageOptions
+func (_e *FindingAPI_Expecter) GetAll(ctx interface{}, projectUUID interface{}, suppressed interface{}, po interface{}) *FindingAPI_GetAll_Call {
+	return &FindingAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, projectUUID, suppressed, po)}
+}
+
+func (_c *FindingAPI_GetAll_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, suppressed bool, po dtrack.PageOptions)) *FindingAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *FindingAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.Finding], _a1 error) *FindingAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FindingAPI_GetAll_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error)) *FindingAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllBySource provides a mock function with given fields: ctx, projectUUID, suppressed, source, po
+func (_m *FindingAPI) GetAllBySource(ctx context.Context, projectUUID uuid.UUID, suppressed bool, source string, po dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error) {
+	ret := _m.Called(ctx, projectUUID, suppressed, source, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllBySource")
+	}
+
+	var r0 dtrack.Page[dtrack.Finding]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, string, dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error)); ok {
+		return rf(ctx, projectUUID, suppressed, source, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, string, dtrack.PageOptions) dtrack.Page[dtrack.Finding]); ok {
+		r0 = rf(ctx, projectUUID, suppressed, source, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Finding])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, string, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, projectUUID, suppressed, source, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindingAPI_GetAllBySource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllBySource'
+type FindingAPI_GetAllBySource_Call struct {
+	*mock.Call
+}
+
+// GetAllBySource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - suppressed bool
+//   - source string
+//   - po dtrack.PageOptions
+func (_e *FindingAPI_Expecter) GetAllBySource(ctx interface{}, projectUUID interface{}, suppressed interface{}, source interface{}, po interface{}) *FindingAPI_GetAllBySource_Call {
+	return &FindingAPI_GetAllBySource_Call{Call: _e.mock.On("GetAllBySource", ctx, projectUUID, suppressed, source, po)}
+}
+
+func (_c *FindingAPI_GetAllBySource_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, suppressed bool, source string, po dtrack.PageOptions)) *FindingAPI_GetAllBySource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(string), args[4].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *FindingAPI_GetAllBySource_Call) Return(_a0 dtrack.Page[dtrack.Finding], _a1 error) *FindingAPI_GetAllBySource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FindingAPI_GetAllBySource_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, string, dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error)) *FindingAPI_GetAllBySource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllFiltered provides a mock function with given fields: ctx, projectUUID, suppressed, filter
+func (_m *FindingAPI) GetAllFiltered(ctx context.Context, projectUUID uuid.UUID, suppressed bool, filter dtrack.FindingFilter) ([]dtrack.Finding, error) {
+	ret := _m.Called(ctx, projectUUID, suppressed, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllFiltered")
+	}
+
+	var r0 []dtrack.Finding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.FindingFilter) ([]dtrack.Finding, error)); ok {
+		return rf(ctx, projectUUID, suppressed, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.FindingFilter) []dtrack.Finding); ok {
+		r0 = rf(ctx, projectUUID, suppressed, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.Finding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, dtrack.FindingFilter) error); ok {
+		r1 = rf(ctx, projectUUID, suppressed, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindingAPI_GetAllFiltered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllFiltered'
+type FindingAPI_GetAllFiltered_Call struct {
+	*mock.Call
+}
+
+// GetAllFiltered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - suppressed bool
+//   - filter dtrack.FindingFilter
+func (_e *FindingAPI_Expecter) GetAllFiltered(ctx interface{}, projectUUID interface{}, suppressed interface{}, filter interface{}) *FindingAPI_GetAllFiltered_Call {
+	return &FindingAPI_GetAllFiltered_Call{Call: _e.mock.On("GetAllFiltered", ctx, projectUUID, suppressed, filter)}
+}
+
+func (_c *FindingAPI_GetAllFiltered_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, suppressed bool, filter dtrack.FindingFilter)) *FindingAPI_GetAllFiltered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(dtrack.FindingFilter))
+	})
+	return _c
+}
+
+func (_c *FindingAPI_GetAllFiltered_Call) Return(_a0 []dtrack.Finding, _a1 error) *FindingAPI_GetAllFiltered_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FindingAPI_GetAllFiltered_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, dtrack.FindingFilter) ([]dtrack.Finding, error)) *FindingAPI_GetAllFiltered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllForPortfolio provides a mock function with given fields: ctx, filterOptions, po
+func (_m *FindingAPI) GetAllForPortfolio(ctx context.Context, filterOptions dtrack.PortfolioFindingFilterOptions, po dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error) {
+	ret := _m.Called(ctx, filterOptions, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllForPortfolio")
+	}
+
+	var r0 dtrack.Page[dtrack.Finding]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PortfolioFindingFilterOptions, dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error)); ok {
+		return rf(ctx, filterOptions, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PortfolioFindingFilterOptions, dtrack.PageOptions) dtrack.Page[dtrack.Finding]); ok {
+		r0 = rf(ctx, filterOptions, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Finding])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PortfolioFindingFilterOptions, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, filterOptions, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindingAPI_GetAllForPortfolio_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllForPortfolio'
+type FindingAPI_GetAllForPortfolio_Call struct {
+	*mock.Call
+}
+
+// GetAllForPortfolio is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filterOptions dtrack.PortfolioFindingFilterOptions
+//   - po dtrack.PageOptions
+func (_e *FindingAPI_Expecter) GetAllForPortfolio(ctx interface{}, filterOptions interface{}, po interface{}) *FindingAPI_GetAllForPortfolio_Call {
+	return &FindingAPI_GetAllForPortfolio_Call{Call: _e.mock.On("GetAllForPortfolio", ctx, filterOptions, po)}
+}
+
+func (_c *FindingAPI_GetAllForPortfolio_Call) Run(run func(ctx context.Context, filterOptions dtrack.PortfolioFindingFilterOptions, po dtrack.PageOptions)) *FindingAPI_GetAllForPortfolio_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PortfolioFindingFilterOptions), args[2].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *FindingAPI_GetAllForPortfolio_Call) Return(_a0 dtrack.Page[dtrack.Finding], _a1 error) *FindingAPI_GetAllForPortfolio_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FindingAPI_GetAllForPortfolio_Call) RunAndReturn(run func(context.Context, dtrack.PortfolioFindingFilterOptions, dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error)) *FindingAPI_GetAllForPortfolio_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllGrouped provides a mock function with given fields: ctx, filterOptions, po
+func (_m *FindingAPI) GetAllGrouped(ctx context.Context, filterOptions dtrack.PortfolioFindingFilterOptions, po dtrack.PageOptions) (dtrack.Page[dtrack.GroupedFinding], error) {
+	ret := _m.Called(ctx, filterOptions, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllGrouped")
+	}
+
+	var r0 dtrack.Page[dtrack.GroupedFinding]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PortfolioFindingFilterOptions, dtrack.PageOptions) (dtrack.Page[dtrack.GroupedFinding], error)); ok {
+		return rf(ctx, filterOptions, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PortfolioFindingFilterOptions, dtrack.PageOptions) dtrack.Page[dtrack.GroupedFinding]); ok {
+		r0 = rf(ctx, filterOptions, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.GroupedFinding])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PortfolioFindingFilterOptions, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, filterOptions, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindingAPI_GetAllGrouped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllGrouped'
+type FindingAPI_GetAllGrouped_Call struct {
+	*mock.Call
+}
+
+// GetAllGrouped is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filterOptions dtrack.PortfolioFindingFilterOptions
+//   - po dtrack.PageOptions
+func (_e *FindingAPI_Expecter) GetAllGrouped(ctx interface{}, filterOptions interface{}, po interface{}) *FindingAPI_GetAllGrouped_Call {
+	return &FindingAPI_GetAllGrouped_Call{Call: _e.mock.On("GetAllGrouped", ctx, filterOptions, po)}
+}
+
+func (_c *FindingAPI_GetAllGrouped_Call) Run(run func(ctx context.Context, filterOptions dtrack.PortfolioFindingFilterOptions, po dtrack.PageOptions)) *FindingAPI_GetAllGrouped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PortfolioFindingFilterOptions), args[2].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *FindingAPI_GetAllGrouped_Call) Return(_a0 dtrack.Page[dtrack.GroupedFinding], _a1 error) *FindingAPI_GetAllGrouped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FindingAPI_GetAllGrouped_Call) RunAndReturn(run func(context.Context, dtrack.PortfolioFindingFilterOptions, dtrack.PageOptions) (dtrack.Page[dtrack.GroupedFinding], error)) *FindingAPI_GetAllGrouped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFindingAPI creates a new instance of FindingAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFindingAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FindingAPI {
+	mock := &FindingAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
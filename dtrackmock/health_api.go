@@ -0,0 +1,93 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HealthAPI is an autogenerated mock type for the HealthAPI type
+type HealthAPI struct {
+	mock.Mock
+}
+
+type HealthAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HealthAPI) EXPECT() *HealthAPI_Expecter {
+	return &HealthAPI_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx
+func (_m *HealthAPI) Get(ctx context.Context) (dtrack.Health, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.Health
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dtrack.Health, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dtrack.Health); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dtrack.Health)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HealthAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type HealthAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *HealthAPI_Expecter) Get(ctx interface{}) *HealthAPI_Get_Call {
+	return &HealthAPI_Get_Call{Call: _e.mock.On("Get", ctx)}
+}
+
+func (_c *HealthAPI_Get_Call) Run(run func(ctx context.Context)) *HealthAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *HealthAPI_Get_Call) Return(_a0 dtrack.Health, _a1 error) *HealthAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HealthAPI_Get_Call) RunAndReturn(run func(context.Context) (dtrack.Health, error)) *HealthAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHealthAPI creates a new instance of HealthAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealthAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HealthAPI {
+	mock := &HealthAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
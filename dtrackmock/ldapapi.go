@@ -0,0 +1,420 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// LDAPAPI is an autogenerated mock type for the LDAPAPI type
+type LDAPAPI struct {
+	mock.Mock
+}
+
+type LDAPAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LDAPAPI) EXPECT() *LDAPAPI_Expecter {
+	return &LDAPAPI_Expecter{mock: &_m.Mock}
+}
+
+// AddMapping provides a mock function with given fields: ctx, mapping
+func (_m *LDAPAPI) AddMapping(ctx context.Context, mapping dtrack.MappedLdapGroupRequest) (dtrack.MappedLdapGroup, error) {
+	ret := _m.Called(ctx, mapping)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMapping")
+	}
+
+	var r0 dtrack.MappedLdapGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.MappedLdapGroupRequest) (dtrack.MappedLdapGroup, error)); ok {
+		return rf(ctx, mapping)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.MappedLdapGroupRequest) dtrack.MappedLdapGroup); ok {
+		r0 = rf(ctx, mapping)
+	} else {
+		r0 = ret.Get(0).(dtrack.MappedLdapGroup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.MappedLdapGroupRequest) error); ok {
+		r1 = rf(ctx, mapping)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LDAPAPI_AddMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddMapping'
+type LDAPAPI_AddMapping_Call struct {
+	*mock.Call
+}
+
+// AddMapping is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapping dtrack.MappedLdapGroupRequest
+func (_e *LDAPAPI_Expecter) AddMapping(ctx interface{}, mapping interface{}) *LDAPAPI_AddMapping_Call {
+	return &LDAPAPI_AddMapping_Call{Call: _e.mock.On("AddMapping", ctx, mapping)}
+}
+
+func (_c *LDAPAPI_AddMapping_Call) Run(run func(ctx context.Context, mapping dtrack.MappedLdapGroupRequest)) *LDAPAPI_AddMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.MappedLdapGroupRequest))
+	})
+	return _c
+}
+
+func (_c *LDAPAPI_AddMapping_Call) Return(_a0 dtrack.MappedLdapGroup, _a1 error) *LDAPAPI_AddMapping_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LDAPAPI_AddMapping_Call) RunAndReturn(run func(context.Context, dtrack.MappedLdapGroupRequest) (dtrack.MappedLdapGroup, error)) *LDAPAPI_AddMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, user
+func (_m *LDAPAPI) CreateUser(ctx context.Context, user dtrack.LdapUser) (dtrack.LdapUser, error) {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 dtrack.LdapUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.LdapUser) (dtrack.LdapUser, error)); ok {
+		return rf(ctx, user)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.LdapUser) dtrack.LdapUser); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Get(0).(dtrack.LdapUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.LdapUser) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LDAPAPI_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type LDAPAPI_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user dtrack.LdapUser
+func (_e *LDAPAPI_Expecter) CreateUser(ctx interface{}, user interface{}) *LDAPAPI_CreateUser_Call {
+	return &LDAPAPI_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, user)}
+}
+
+func (_c *LDAPAPI_CreateUser_Call) Run(run func(ctx context.Context, user dtrack.LdapUser)) *LDAPAPI_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.LdapUser))
+	})
+	return _c
+}
+
+func (_c *LDAPAPI_CreateUser_Call) Return(_a0 dtrack.LdapUser, _a1 error) *LDAPAPI_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LDAPAPI_CreateUser_Call) RunAndReturn(run func(context.Context, dtrack.LdapUser) (dtrack.LdapUser, error)) *LDAPAPI_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: ctx, user
+func (_m *LDAPAPI) DeleteUser(ctx context.Context, user dtrack.LdapUser) error {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.LdapUser) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LDAPAPI_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type LDAPAPI_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user dtrack.LdapUser
+func (_e *LDAPAPI_Expecter) DeleteUser(ctx interface{}, user interface{}) *LDAPAPI_DeleteUser_Call {
+	return &LDAPAPI_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, user)}
+}
+
+func (_c *LDAPAPI_DeleteUser_Call) Run(run func(ctx context.Context, user dtrack.LdapUser)) *LDAPAPI_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.LdapUser))
+	})
+	return _c
+}
+
+func (_c *LDAPAPI_DeleteUser_Call) Return(_a0 error) *LDAPAPI_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LDAPAPI_DeleteUser_Call) RunAndReturn(run func(context.Context, dtrack.LdapUser) error) *LDAPAPI_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllAccessibleGroups provides a mock function with given fields: ctx, po
+func (_m *LDAPAPI) GetAllAccessibleGroups(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[string], error) {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllAccessibleGroups")
+	}
+
+	var r0 dtrack.Page[string]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) (dtrack.Page[string], error)); ok {
+		return rf(ctx, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) dtrack.Page[string]); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[string])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LDAPAPI_GetAllAccessibleGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllAccessibleGroups'
+type LDAPAPI_GetAllAccessibleGroups_Call struct {
+	*mock.Call
+}
+
+// GetAllAccessibleGroups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+func (_e *LDAPAPI_Expecter) GetAllAccessibleGroups(ctx interface{}, po interface{}) *LDAPAPI_GetAllAccessibleGroups_Call {
+	return &LDAPAPI_GetAllAccessibleGroups_Call{Call: _e.mock.On("GetAllAccessibleGroups", ctx, po)}
+}
+
+func (_c *LDAPAPI_GetAllAccessibleGroups_Call) Run(run func(ctx context.Context, po dtrack.PageOptions)) *LDAPAPI_GetAllAccessibleGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *LDAPAPI_GetAllAccessibleGroups_Call) Return(_a0 dtrack.Page[string], _a1 error) *LDAPAPI_GetAllAccessibleGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LDAPAPI_GetAllAccessibleGroups_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions) (dtrack.Page[string], error)) *LDAPAPI_GetAllAccessibleGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTeamMappings provides a mock function with given fields: ctx, teamUUID
+func (_m *LDAPAPI) GetTeamMappings(ctx context.Context, teamUUID uuid.UUID) ([]dtrack.MappedLdapGroup, error) {
+	ret := _m.Called(ctx, teamUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamMappings")
+	}
+
+	var r0 []dtrack.MappedLdapGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dtrack.MappedLdapGroup, error)); ok {
+		return rf(ctx, teamUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dtrack.MappedLdapGroup); ok {
+		r0 = rf(ctx, teamUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.MappedLdapGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LDAPAPI_GetTeamMappings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTeamMappings'
+type LDAPAPI_GetTeamMappings_Call struct {
+	*mock.Call
+}
+
+// GetTeamMappings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamUUID uuid.UUID
+func (_e *LDAPAPI_Expecter) GetTeamMappings(ctx interface{}, teamUUID interface{}) *LDAPAPI_GetTeamMappings_Call {
+	return &LDAPAPI_GetTeamMappings_Call{Call: _e.mock.On("GetTeamMappings", ctx, teamUUID)}
+}
+
+func (_c *LDAPAPI_GetTeamMappings_Call) Run(run func(ctx context.Context, teamUUID uuid.UUID)) *LDAPAPI_GetTeamMappings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *LDAPAPI_GetTeamMappings_Call) Return(_a0 []dtrack.MappedLdapGroup, _a1 error) *LDAPAPI_GetTeamMappings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LDAPAPI_GetTeamMappings_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dtrack.MappedLdapGroup, error)) *LDAPAPI_GetTeamMappings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsers provides a mock function with given fields: ctx, po
+func (_m *LDAPAPI) GetUsers(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.LdapUser], error) {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsers")
+	}
+
+	var r0 dtrack.Page[dtrack.LdapUser]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.LdapUser], error)); ok {
+		return rf(ctx, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) dtrack.Page[dtrack.LdapUser]); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.LdapUser])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LDAPAPI_GetUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsers'
+type LDAPAPI_GetUsers_Call struct {
+	*mock.Call
+}
+
+// GetUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+func (_e *LDAPAPI_Expecter) GetUsers(ctx interface{}, po interface{}) *LDAPAPI_GetUsers_Call {
+	return &LDAPAPI_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx, po)}
+}
+
+func (_c *LDAPAPI_GetUsers_Call) Run(run func(ctx context.Context, po dtrack.PageOptions)) *LDAPAPI_GetUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *LDAPAPI_GetUsers_Call) Return(_a0 dtrack.Page[dtrack.LdapUser], _a1 error) *LDAPAPI_GetUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LDAPAPI_GetUsers_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.LdapUser], error)) *LDAPAPI_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveMapping provides a mock function with given fields: ctx, mappingId
+func (_m *LDAPAPI) RemoveMapping(ctx context.Context, mappingId uuid.UUID) error {
+	ret := _m.Called(ctx, mappingId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMapping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, mappingId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LDAPAPI_RemoveMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveMapping'
+type LDAPAPI_RemoveMapping_Call struct {
+	*mock.Call
+}
+
+// RemoveMapping is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mappingId uuid.UUID
+func (_e *LDAPAPI_Expecter) RemoveMapping(ctx interface{}, mappingId interface{}) *LDAPAPI_RemoveMapping_Call {
+	return &LDAPAPI_RemoveMapping_Call{Call: _e.mock.On("RemoveMapping", ctx, mappingId)}
+}
+
+func (_c *LDAPAPI_RemoveMapping_Call) Run(run func(ctx context.Context, mappingId uuid.UUID)) *LDAPAPI_RemoveMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *LDAPAPI_RemoveMapping_Call) Return(_a0 error) *LDAPAPI_RemoveMapping_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LDAPAPI_RemoveMapping_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *LDAPAPI_RemoveMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLDAPAPI creates a new instance of LDAPAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLDAPAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LDAPAPI {
+	mock := &LDAPAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,430 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// LicenseAPI is an autogenerated mock type for the LicenseAPI type
+type LicenseAPI struct {
+	mock.Mock
+}
+
+type LicenseAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LicenseAPI) EXPECT() *LicenseAPI_Expecter {
+	return &LicenseAPI_Expecter{mock: &_m.Mock}
+}
+
+// ComplianceReport provides a mock function with given fields: ctx, projectUUID, opts
+func (_m *LicenseAPI) ComplianceReport(ctx context.Context, projectUUID uuid.UUID, opts dtrack.LicenseComplianceOptions) (dtrack.LicenseComplianceReport, error) {
+	ret := _m.Called(ctx, projectUUID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComplianceReport")
+	}
+
+	var r0 dtrack.LicenseComplianceReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.LicenseComplianceOptions) (dtrack.LicenseComplianceReport, error)); ok {
+		return rf(ctx, projectUUID, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.LicenseComplianceOptions) dtrack.LicenseComplianceReport); ok {
+		r0 = rf(ctx, projectUUID, opts)
+	} else {
+		r0 = ret.Get(0).(dtrack.LicenseComplianceReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.LicenseComplianceOptions) error); ok {
+		r1 = rf(ctx, projectUUID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseAPI_ComplianceReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ComplianceReport'
+type LicenseAPI_ComplianceReport_Call struct {
+	*mock.Call
+}
+
+// ComplianceReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - opts dtrack.LicenseComplianceOptions
+func (_e *LicenseAPI_Expecter) ComplianceReport(ctx interface{}, projectUUID interface{}, opts interface{}) *LicenseAPI_ComplianceReport_Call {
+	return &LicenseAPI_ComplianceReport_Call{Call: _e.mock.On("ComplianceReport", ctx, projectUUID, opts)}
+}
+
+func (_c *LicenseAPI_ComplianceReport_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, opts dtrack.LicenseComplianceOptions)) *LicenseAPI_ComplianceReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.LicenseComplianceOptions))
+	})
+	return _c
+}
+
+func (_c *LicenseAPI_ComplianceReport_Call) Return(_a0 dtrack.LicenseComplianceReport, _a1 error) *LicenseAPI_ComplianceReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseAPI_ComplianceReport_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.LicenseComplianceOptions) (dtrack.LicenseComplianceReport, error)) *LicenseAPI_ComplianceReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, license
+func (_m *LicenseAPI) Create(ctx context.Context, license dtrack.License) (dtrack.License, error) {
+	ret := _m.Called(ctx, license)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.License
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.License) (dtrack.License, error)); ok {
+		return rf(ctx, license)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.License) dtrack.License); ok {
+		r0 = rf(ctx, license)
+	} else {
+		r0 = ret.Get(0).(dtrack.License)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.License) error); ok {
+		r1 = rf(ctx, license)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type LicenseAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - license dtrack.License
+func (_e *LicenseAPI_Expecter) Create(ctx interface{}, license interface{}) *LicenseAPI_Create_Call {
+	return &LicenseAPI_Create_Call{Call: _e.mock.On("Create", ctx, license)}
+}
+
+func (_c *LicenseAPI_Create_Call) Run(run func(ctx context.Context, license dtrack.License)) *LicenseAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.License))
+	})
+	return _c
+}
+
+func (_c *LicenseAPI_Create_Call) Return(_a0 dtrack.License, _a1 error) *LicenseAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseAPI_Create_Call) RunAndReturn(run func(context.Context, dtrack.License) (dtrack.License, error)) *LicenseAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, licenseID
+func (_m *LicenseAPI) Delete(ctx context.Context, licenseID string) error {
+	ret := _m.Called(ctx, licenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, licenseID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LicenseAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type LicenseAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - licenseID string
+func (_e *LicenseAPI_Expecter) Delete(ctx interface{}, licenseID interface{}) *LicenseAPI_Delete_Call {
+	return &LicenseAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, licenseID)}
+}
+
+func (_c *LicenseAPI_Delete_Call) Run(run func(ctx context.Context, licenseID string)) *LicenseAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LicenseAPI_Delete_Call) Return(_a0 error) *LicenseAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LicenseAPI_Delete_Call) RunAndReturn(run func(context.Context, string) error) *LicenseAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, licenseID
+func (_m *LicenseAPI) Get(ctx context.Context, licenseID string) (dtrack.License, error) {
+	ret := _m.Called(ctx, licenseID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.License
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (dtrack.License, error)); ok {
+		return rf(ctx, licenseID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) dtrack.License); ok {
+		r0 = rf(ctx, licenseID)
+	} else {
+		r0 = ret.Get(0).(dtrack.License)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, licenseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type LicenseAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - licenseID string
+func (_e *LicenseAPI_Expecter) Get(ctx interface{}, licenseID interface{}) *LicenseAPI_Get_Call {
+	return &LicenseAPI_Get_Call{Call: _e.mock.On("Get", ctx, licenseID)}
+}
+
+func (_c *LicenseAPI_Get_Call) Run(run func(ctx context.Context, licenseID string)) *LicenseAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LicenseAPI_Get_Call) Return(_a0 dtrack.License, _a1 error) *LicenseAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseAPI_Get_Call) RunAndReturn(run func(context.Context, string) (dtrack.License, error)) *LicenseAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, po
+func (_m *LicenseAPI) GetAll(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.License], error) {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.License]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.License], error)); ok {
+		return rf(ctx, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) dtrack.Page[dtrack.License]); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.License])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type LicenseAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+func (_e *LicenseAPI_Expecter) GetAll(ctx interface{}, po interface{}) *LicenseAPI_GetAll_Call {
+	return &LicenseAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, po)}
+}
+
+func (_c *LicenseAPI_GetAll_Call) Run(run func(ctx context.Context, po dtrack.PageOptions)) *LicenseAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *LicenseAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.License], _a1 error) *LicenseAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseAPI_GetAll_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.License], error)) *LicenseAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllConcise provides a mock function with given fields: ctx
+func (_m *LicenseAPI) GetAllConcise(ctx context.Context) ([]dtrack.License, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllConcise")
+	}
+
+	var r0 []dtrack.License
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]dtrack.License, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []dtrack.License); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.License)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseAPI_GetAllConcise_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllConcise'
+type LicenseAPI_GetAllConcise_Call struct {
+	*mock.Call
+}
+
+// GetAllConcise is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LicenseAPI_Expecter) GetAllConcise(ctx interface{}) *LicenseAPI_GetAllConcise_Call {
+	return &LicenseAPI_GetAllConcise_Call{Call: _e.mock.On("GetAllConcise", ctx)}
+}
+
+func (_c *LicenseAPI_GetAllConcise_Call) Run(run func(ctx context.Context)) *LicenseAPI_GetAllConcise_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LicenseAPI_GetAllConcise_Call) Return(_a0 []dtrack.License, _a1 error) *LicenseAPI_GetAllConcise_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseAPI_GetAllConcise_Call) RunAndReturn(run func(context.Context) ([]dtrack.License, error)) *LicenseAPI_GetAllConcise_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PortfolioComplianceReport provides a mock function with given fields: ctx, opts
+func (_m *LicenseAPI) PortfolioComplianceReport(ctx context.Context, opts dtrack.LicenseComplianceOptions) (dtrack.LicenseComplianceReport, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PortfolioComplianceReport")
+	}
+
+	var r0 dtrack.LicenseComplianceReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.LicenseComplianceOptions) (dtrack.LicenseComplianceReport, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.LicenseComplianceOptions) dtrack.LicenseComplianceReport); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		r0 = ret.Get(0).(dtrack.LicenseComplianceReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.LicenseComplianceOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseAPI_PortfolioComplianceReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PortfolioComplianceReport'
+type LicenseAPI_PortfolioComplianceReport_Call struct {
+	*mock.Call
+}
+
+// PortfolioComplianceReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts dtrack.LicenseComplianceOptions
+func (_e *LicenseAPI_Expecter) PortfolioComplianceReport(ctx interface{}, opts interface{}) *LicenseAPI_PortfolioComplianceReport_Call {
+	return &LicenseAPI_PortfolioComplianceReport_Call{Call: _e.mock.On("PortfolioComplianceReport", ctx, opts)}
+}
+
+func (_c *LicenseAPI_PortfolioComplianceReport_Call) Run(run func(ctx context.Context, opts dtrack.LicenseComplianceOptions)) *LicenseAPI_PortfolioComplianceReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.LicenseComplianceOptions))
+	})
+	return _c
+}
+
+func (_c *LicenseAPI_PortfolioComplianceReport_Call) Return(_a0 dtrack.LicenseComplianceReport, _a1 error) *LicenseAPI_PortfolioComplianceReport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseAPI_PortfolioComplianceReport_Call) RunAndReturn(run func(context.Context, dtrack.LicenseComplianceOptions) (dtrack.LicenseComplianceReport, error)) *LicenseAPI_PortfolioComplianceReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLicenseAPI creates a new instance of LicenseAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLicenseAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LicenseAPI {
+	mock := &LicenseAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,430 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// LicenseGroupAPI is an autogenerated mock type for the LicenseGroupAPI type
+type LicenseGroupAPI struct {
+	mock.Mock
+}
+
+type LicenseGroupAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LicenseGroupAPI) EXPECT() *LicenseGroupAPI_Expecter {
+	return &LicenseGroupAPI_Expecter{mock: &_m.Mock}
+}
+
+// AddLicense provides a mock function with given fields: ctx, licenseGroupUUID, licenseUUID
+func (_m *LicenseGroupAPI) AddLicense(ctx context.Context, licenseGroupUUID uuid.UUID, licenseUUID uuid.UUID) (dtrack.LicenseGroup, error) {
+	ret := _m.Called(ctx, licenseGroupUUID, licenseUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddLicense")
+	}
+
+	var r0 dtrack.LicenseGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dtrack.LicenseGroup, error)); ok {
+		return rf(ctx, licenseGroupUUID, licenseUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dtrack.LicenseGroup); ok {
+		r0 = rf(ctx, licenseGroupUUID, licenseUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.LicenseGroup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, licenseGroupUUID, licenseUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseGroupAPI_AddLicense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddLicense'
+type LicenseGroupAPI_AddLicense_Call struct {
+	*mock.Call
+}
+
+// AddLicense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - licenseGroupUUID uuid.UUID
+//   - licenseUUID uuid.UUID
+func (_e *LicenseGroupAPI_Expecter) AddLicense(ctx interface{}, licenseGroupUUID interface{}, licenseUUID interface{}) *LicenseGroupAPI_AddLicense_Call {
+	return &LicenseGroupAPI_AddLicense_Call{Call: _e.mock.On("AddLicense", ctx, licenseGroupUUID, licenseUUID)}
+}
+
+func (_c *LicenseGroupAPI_AddLicense_Call) Run(run func(ctx context.Context, licenseGroupUUID uuid.UUID, licenseUUID uuid.UUID)) *LicenseGroupAPI_AddLicense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *LicenseGroupAPI_AddLicense_Call) Return(_a0 dtrack.LicenseGroup, _a1 error) *LicenseGroupAPI_AddLicense_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseGroupAPI_AddLicense_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (dtrack.LicenseGroup, error)) *LicenseGroupAPI_AddLicense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, licenseGroup
+func (_m *LicenseGroupAPI) Create(ctx context.Context, licenseGroup dtrack.LicenseGroup) (dtrack.LicenseGroup, error) {
+	ret := _m.Called(ctx, licenseGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.LicenseGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.LicenseGroup) (dtrack.LicenseGroup, error)); ok {
+		return rf(ctx, licenseGroup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.LicenseGroup) dtrack.LicenseGroup); ok {
+		r0 = rf(ctx, licenseGroup)
+	} else {
+		r0 = ret.Get(0).(dtrack.LicenseGroup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.LicenseGroup) error); ok {
+		r1 = rf(ctx, licenseGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseGroupAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type LicenseGroupAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - licenseGroup dtrack.LicenseGroup
+func (_e *LicenseGroupAPI_Expecter) Create(ctx interface{}, licenseGroup interface{}) *LicenseGroupAPI_Create_Call {
+	return &LicenseGroupAPI_Create_Call{Call: _e.mock.On("Create", ctx, licenseGroup)}
+}
+
+func (_c *LicenseGroupAPI_Create_Call) Run(run func(ctx context.Context, licenseGroup dtrack.LicenseGroup)) *LicenseGroupAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.LicenseGroup))
+	})
+	return _c
+}
+
+func (_c *LicenseGroupAPI_Create_Call) Return(_a0 dtrack.LicenseGroup, _a1 error) *LicenseGroupAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseGroupAPI_Create_Call) RunAndReturn(run func(context.Context, dtrack.LicenseGroup) (dtrack.LicenseGroup, error)) *LicenseGroupAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, licenseGroupUUID
+func (_m *LicenseGroupAPI) Delete(ctx context.Context, licenseGroupUUID uuid.UUID) error {
+	ret := _m.Called(ctx, licenseGroupUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, licenseGroupUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LicenseGroupAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type LicenseGroupAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - licenseGroupUUID uuid.UUID
+func (_e *LicenseGroupAPI_Expecter) Delete(ctx interface{}, licenseGroupUUID interface{}) *LicenseGroupAPI_Delete_Call {
+	return &LicenseGroupAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, licenseGroupUUID)}
+}
+
+func (_c *LicenseGroupAPI_Delete_Call) Run(run func(ctx context.Context, licenseGroupUUID uuid.UUID)) *LicenseGroupAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *LicenseGroupAPI_Delete_Call) Return(_a0 error) *LicenseGroupAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LicenseGroupAPI_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *LicenseGroupAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, licenseGroupUUID
+func (_m *LicenseGroupAPI) Get(ctx context.Context, licenseGroupUUID uuid.UUID) (dtrack.LicenseGroup, error) {
+	ret := _m.Called(ctx, licenseGroupUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.LicenseGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.LicenseGroup, error)); ok {
+		return rf(ctx, licenseGroupUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.LicenseGroup); ok {
+		r0 = rf(ctx, licenseGroupUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.LicenseGroup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, licenseGroupUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseGroupAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type LicenseGroupAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - licenseGroupUUID uuid.UUID
+func (_e *LicenseGroupAPI_Expecter) Get(ctx interface{}, licenseGroupUUID interface{}) *LicenseGroupAPI_Get_Call {
+	return &LicenseGroupAPI_Get_Call{Call: _e.mock.On("Get", ctx, licenseGroupUUID)}
+}
+
+func (_c *LicenseGroupAPI_Get_Call) Run(run func(ctx context.Context, licenseGroupUUID uuid.UUID)) *LicenseGroupAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *LicenseGroupAPI_Get_Call) Return(_a0 dtrack.LicenseGroup, _a1 error) *LicenseGroupAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseGroupAPI_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.LicenseGroup, error)) *LicenseGroupAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, po
+func (_m *LicenseGroupAPI) GetAll(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.LicenseGroup], error) {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.LicenseGroup]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.LicenseGroup], error)); ok {
+		return rf(ctx, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) dtrack.Page[dtrack.LicenseGroup]); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.LicenseGroup])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseGroupAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type LicenseGroupAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+func (_e *LicenseGroupAPI_Expecter) GetAll(ctx interface{}, po interface{}) *LicenseGroupAPI_GetAll_Call {
+	return &LicenseGroupAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, po)}
+}
+
+func (_c *LicenseGroupAPI_GetAll_Call) Run(run func(ctx context.Context, po dtrack.PageOptions)) *LicenseGroupAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *LicenseGroupAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.LicenseGroup], _a1 error) *LicenseGroupAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseGroupAPI_GetAll_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.LicenseGroup], error)) *LicenseGroupAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveLicense provides a mock function with given fields: ctx, licenseGroupUUID, licenseUUID
+func (_m *LicenseGroupAPI) RemoveLicense(ctx context.Context, licenseGroupUUID uuid.UUID, licenseUUID uuid.UUID) (dtrack.LicenseGroup, error) {
+	ret := _m.Called(ctx, licenseGroupUUID, licenseUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveLicense")
+	}
+
+	var r0 dtrack.LicenseGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dtrack.LicenseGroup, error)); ok {
+		return rf(ctx, licenseGroupUUID, licenseUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dtrack.LicenseGroup); ok {
+		r0 = rf(ctx, licenseGroupUUID, licenseUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.LicenseGroup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, licenseGroupUUID, licenseUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseGroupAPI_RemoveLicense_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveLicense'
+type LicenseGroupAPI_RemoveLicense_Call struct {
+	*mock.Call
+}
+
+// RemoveLicense is a helper method to define mock.On call
+//   - ctx context.Context
+//   - licenseGroupUUID uuid.UUID
+//   - licenseUUID uuid.UUID
+func (_e *LicenseGroupAPI_Expecter) RemoveLicense(ctx interface{}, licenseGroupUUID interface{}, licenseUUID interface{}) *LicenseGroupAPI_RemoveLicense_Call {
+	return &LicenseGroupAPI_RemoveLicense_Call{Call: _e.mock.On("RemoveLicense", ctx, licenseGroupUUID, licenseUUID)}
+}
+
+func (_c *LicenseGroupAPI_RemoveLicense_Call) Run(run func(ctx context.Context, licenseGroupUUID uuid.UUID, licenseUUID uuid.UUID)) *LicenseGroupAPI_RemoveLicense_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *LicenseGroupAPI_RemoveLicense_Call) Return(_a0 dtrack.LicenseGroup, _a1 error) *LicenseGroupAPI_RemoveLicense_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseGroupAPI_RemoveLicense_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (dtrack.LicenseGroup, error)) *LicenseGroupAPI_RemoveLicense_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, licenseGroup
+func (_m *LicenseGroupAPI) Update(ctx context.Context, licenseGroup dtrack.LicenseGroup) (dtrack.LicenseGroup, error) {
+	ret := _m.Called(ctx, licenseGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.LicenseGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.LicenseGroup) (dtrack.LicenseGroup, error)); ok {
+		return rf(ctx, licenseGroup)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.LicenseGroup) dtrack.LicenseGroup); ok {
+		r0 = rf(ctx, licenseGroup)
+	} else {
+		r0 = ret.Get(0).(dtrack.LicenseGroup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.LicenseGroup) error); ok {
+		r1 = rf(ctx, licenseGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LicenseGroupAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type LicenseGroupAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - licenseGroup dtrack.LicenseGroup
+func (_e *LicenseGroupAPI_Expecter) Update(ctx interface{}, licenseGroup interface{}) *LicenseGroupAPI_Update_Call {
+	return &LicenseGroupAPI_Update_Call{Call: _e.mock.On("Update", ctx, licenseGroup)}
+}
+
+func (_c *LicenseGroupAPI_Update_Call) Run(run func(ctx context.Context, licenseGroup dtrack.LicenseGroup)) *LicenseGroupAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.LicenseGroup))
+	})
+	return _c
+}
+
+func (_c *LicenseGroupAPI_Update_Call) Return(_a0 dtrack.LicenseGroup, _a1 error) *LicenseGroupAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LicenseGroupAPI_Update_Call) RunAndReturn(run func(context.Context, dtrack.LicenseGroup) (dtrack.LicenseGroup, error)) *LicenseGroupAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLicenseGroupAPI creates a new instance of LicenseGroupAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLicenseGroupAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LicenseGroupAPI {
+	mock := &LicenseGroupAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,1022 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+	io "io"
+
+	dtrack "github.com/DependencyTrack/client-go"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// MetricsAPI is an autogenerated mock type for the MetricsAPI type
+type MetricsAPI struct {
+	mock.Mock
+}
+
+type MetricsAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MetricsAPI) EXPECT() *MetricsAPI_Expecter {
+	return &MetricsAPI_Expecter{mock: &_m.Mock}
+}
+
+// ComponentMetricsSince provides a mock function with given fields: ctx, componentUUID, date
+func (_m *MetricsAPI) ComponentMetricsSince(ctx context.Context, componentUUID uuid.UUID, date time.Time) ([]dtrack.ComponentMetrics, error) {
+	ret := _m.Called(ctx, componentUUID, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComponentMetricsSince")
+	}
+
+	var r0 []dtrack.ComponentMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) ([]dtrack.ComponentMetrics, error)); ok {
+		return rf(ctx, componentUUID, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) []dtrack.ComponentMetrics); ok {
+		r0 = rf(ctx, componentUUID, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.ComponentMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, componentUUID, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_ComponentMetricsSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ComponentMetricsSince'
+type MetricsAPI_ComponentMetricsSince_Call struct {
+	*mock.Call
+}
+
+// ComponentMetricsSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - date time.Time
+func (_e *MetricsAPI_Expecter) ComponentMetricsSince(ctx interface{}, componentUUID interface{}, date interface{}) *MetricsAPI_ComponentMetricsSince_Call {
+	return &MetricsAPI_ComponentMetricsSince_Call{Call: _e.mock.On("ComponentMetricsSince", ctx, componentUUID, date)}
+}
+
+func (_c *MetricsAPI_ComponentMetricsSince_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, date time.Time)) *MetricsAPI_ComponentMetricsSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_ComponentMetricsSince_Call) Return(_a0 []dtrack.ComponentMetrics, _a1 error) *MetricsAPI_ComponentMetricsSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_ComponentMetricsSince_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) ([]dtrack.ComponentMetrics, error)) *MetricsAPI_ComponentMetricsSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ComponentMetricsSinceDays provides a mock function with given fields: ctx, componentUUID, days
+func (_m *MetricsAPI) ComponentMetricsSinceDays(ctx context.Context, componentUUID uuid.UUID, days uint) ([]dtrack.ComponentMetrics, error) {
+	ret := _m.Called(ctx, componentUUID, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComponentMetricsSinceDays")
+	}
+
+	var r0 []dtrack.ComponentMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint) ([]dtrack.ComponentMetrics, error)); ok {
+		return rf(ctx, componentUUID, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint) []dtrack.ComponentMetrics); ok {
+		r0 = rf(ctx, componentUUID, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.ComponentMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uint) error); ok {
+		r1 = rf(ctx, componentUUID, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_ComponentMetricsSinceDays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ComponentMetricsSinceDays'
+type MetricsAPI_ComponentMetricsSinceDays_Call struct {
+	*mock.Call
+}
+
+// ComponentMetricsSinceDays is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - days uint
+func (_e *MetricsAPI_Expecter) ComponentMetricsSinceDays(ctx interface{}, componentUUID interface{}, days interface{}) *MetricsAPI_ComponentMetricsSinceDays_Call {
+	return &MetricsAPI_ComponentMetricsSinceDays_Call{Call: _e.mock.On("ComponentMetricsSinceDays", ctx, componentUUID, days)}
+}
+
+func (_c *MetricsAPI_ComponentMetricsSinceDays_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, days uint)) *MetricsAPI_ComponentMetricsSinceDays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_ComponentMetricsSinceDays_Call) Return(_a0 []dtrack.ComponentMetrics, _a1 error) *MetricsAPI_ComponentMetricsSinceDays_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_ComponentMetricsSinceDays_Call) RunAndReturn(run func(context.Context, uuid.UUID, uint) ([]dtrack.ComponentMetrics, error)) *MetricsAPI_ComponentMetricsSinceDays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportPortfolioReport provides a mock function with given fields: ctx, w, opts
+func (_m *MetricsAPI) ExportPortfolioReport(ctx context.Context, w io.Writer, opts dtrack.MetricsReportOptions) error {
+	ret := _m.Called(ctx, w, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportPortfolioReport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Writer, dtrack.MetricsReportOptions) error); ok {
+		r0 = rf(ctx, w, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetricsAPI_ExportPortfolioReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportPortfolioReport'
+type MetricsAPI_ExportPortfolioReport_Call struct {
+	*mock.Call
+}
+
+// ExportPortfolioReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - w io.Writer
+//   - opts dtrack.MetricsReportOptions
+func (_e *MetricsAPI_Expecter) ExportPortfolioReport(ctx interface{}, w interface{}, opts interface{}) *MetricsAPI_ExportPortfolioReport_Call {
+	return &MetricsAPI_ExportPortfolioReport_Call{Call: _e.mock.On("ExportPortfolioReport", ctx, w, opts)}
+}
+
+func (_c *MetricsAPI_ExportPortfolioReport_Call) Run(run func(ctx context.Context, w io.Writer, opts dtrack.MetricsReportOptions)) *MetricsAPI_ExportPortfolioReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Writer), args[2].(dtrack.MetricsReportOptions))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_ExportPortfolioReport_Call) Return(_a0 error) *MetricsAPI_ExportPortfolioReport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetricsAPI_ExportPortfolioReport_Call) RunAndReturn(run func(context.Context, io.Writer, dtrack.MetricsReportOptions) error) *MetricsAPI_ExportPortfolioReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LatestComponentMetrics provides a mock function with given fields: ctx, componentUUID
+func (_m *MetricsAPI) LatestComponentMetrics(ctx context.Context, componentUUID uuid.UUID) (dtrack.ComponentMetrics, error) {
+	ret := _m.Called(ctx, componentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestComponentMetrics")
+	}
+
+	var r0 dtrack.ComponentMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.ComponentMetrics, error)); ok {
+		return rf(ctx, componentUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.ComponentMetrics); ok {
+		r0 = rf(ctx, componentUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.ComponentMetrics)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, componentUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_LatestComponentMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestComponentMetrics'
+type MetricsAPI_LatestComponentMetrics_Call struct {
+	*mock.Call
+}
+
+// LatestComponentMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+func (_e *MetricsAPI_Expecter) LatestComponentMetrics(ctx interface{}, componentUUID interface{}) *MetricsAPI_LatestComponentMetrics_Call {
+	return &MetricsAPI_LatestComponentMetrics_Call{Call: _e.mock.On("LatestComponentMetrics", ctx, componentUUID)}
+}
+
+func (_c *MetricsAPI_LatestComponentMetrics_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID)) *MetricsAPI_LatestComponentMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_LatestComponentMetrics_Call) Return(_a0 dtrack.ComponentMetrics, _a1 error) *MetricsAPI_LatestComponentMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_LatestComponentMetrics_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.ComponentMetrics, error)) *MetricsAPI_LatestComponentMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LatestPortfolioMetrics provides a mock function with given fields: ctx
+func (_m *MetricsAPI) LatestPortfolioMetrics(ctx context.Context) (dtrack.PortfolioMetrics, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestPortfolioMetrics")
+	}
+
+	var r0 dtrack.PortfolioMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dtrack.PortfolioMetrics, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dtrack.PortfolioMetrics); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dtrack.PortfolioMetrics)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_LatestPortfolioMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestPortfolioMetrics'
+type MetricsAPI_LatestPortfolioMetrics_Call struct {
+	*mock.Call
+}
+
+// LatestPortfolioMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MetricsAPI_Expecter) LatestPortfolioMetrics(ctx interface{}) *MetricsAPI_LatestPortfolioMetrics_Call {
+	return &MetricsAPI_LatestPortfolioMetrics_Call{Call: _e.mock.On("LatestPortfolioMetrics", ctx)}
+}
+
+func (_c *MetricsAPI_LatestPortfolioMetrics_Call) Run(run func(ctx context.Context)) *MetricsAPI_LatestPortfolioMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_LatestPortfolioMetrics_Call) Return(_a0 dtrack.PortfolioMetrics, _a1 error) *MetricsAPI_LatestPortfolioMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_LatestPortfolioMetrics_Call) RunAndReturn(run func(context.Context) (dtrack.PortfolioMetrics, error)) *MetricsAPI_LatestPortfolioMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LatestProjectMetrics provides a mock function with given fields: ctx, projectUUID
+func (_m *MetricsAPI) LatestProjectMetrics(ctx context.Context, projectUUID uuid.UUID) (dtrack.ProjectMetrics, error) {
+	ret := _m.Called(ctx, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LatestProjectMetrics")
+	}
+
+	var r0 dtrack.ProjectMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.ProjectMetrics, error)); ok {
+		return rf(ctx, projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.ProjectMetrics); ok {
+		r0 = rf(ctx, projectUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.ProjectMetrics)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_LatestProjectMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LatestProjectMetrics'
+type MetricsAPI_LatestProjectMetrics_Call struct {
+	*mock.Call
+}
+
+// LatestProjectMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+func (_e *MetricsAPI_Expecter) LatestProjectMetrics(ctx interface{}, projectUUID interface{}) *MetricsAPI_LatestProjectMetrics_Call {
+	return &MetricsAPI_LatestProjectMetrics_Call{Call: _e.mock.On("LatestProjectMetrics", ctx, projectUUID)}
+}
+
+func (_c *MetricsAPI_LatestProjectMetrics_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID)) *MetricsAPI_LatestProjectMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_LatestProjectMetrics_Call) Return(_a0 dtrack.ProjectMetrics, _a1 error) *MetricsAPI_LatestProjectMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_LatestProjectMetrics_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.ProjectMetrics, error)) *MetricsAPI_LatestProjectMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PortfolioMetricsSince provides a mock function with given fields: ctx, date
+func (_m *MetricsAPI) PortfolioMetricsSince(ctx context.Context, date time.Time) ([]dtrack.PortfolioMetrics, error) {
+	ret := _m.Called(ctx, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PortfolioMetricsSince")
+	}
+
+	var r0 []dtrack.PortfolioMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]dtrack.PortfolioMetrics, error)); ok {
+		return rf(ctx, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []dtrack.PortfolioMetrics); ok {
+		r0 = rf(ctx, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.PortfolioMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_PortfolioMetricsSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PortfolioMetricsSince'
+type MetricsAPI_PortfolioMetricsSince_Call struct {
+	*mock.Call
+}
+
+// PortfolioMetricsSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - date time.Time
+func (_e *MetricsAPI_Expecter) PortfolioMetricsSince(ctx interface{}, date interface{}) *MetricsAPI_PortfolioMetricsSince_Call {
+	return &MetricsAPI_PortfolioMetricsSince_Call{Call: _e.mock.On("PortfolioMetricsSince", ctx, date)}
+}
+
+func (_c *MetricsAPI_PortfolioMetricsSince_Call) Run(run func(ctx context.Context, date time.Time)) *MetricsAPI_PortfolioMetricsSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_PortfolioMetricsSince_Call) Return(_a0 []dtrack.PortfolioMetrics, _a1 error) *MetricsAPI_PortfolioMetricsSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_PortfolioMetricsSince_Call) RunAndReturn(run func(context.Context, time.Time) ([]dtrack.PortfolioMetrics, error)) *MetricsAPI_PortfolioMetricsSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PortfolioMetricsSinceDays provides a mock function with given fields: ctx, days
+func (_m *MetricsAPI) PortfolioMetricsSinceDays(ctx context.Context, days uint) ([]dtrack.PortfolioMetrics, error) {
+	ret := _m.Called(ctx, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PortfolioMetricsSinceDays")
+	}
+
+	var r0 []dtrack.PortfolioMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) ([]dtrack.PortfolioMetrics, error)); ok {
+		return rf(ctx, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) []dtrack.PortfolioMetrics); ok {
+		r0 = rf(ctx, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.PortfolioMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_PortfolioMetricsSinceDays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PortfolioMetricsSinceDays'
+type MetricsAPI_PortfolioMetricsSinceDays_Call struct {
+	*mock.Call
+}
+
+// PortfolioMetricsSinceDays is a helper method to define mock.On call
+//   - ctx context.Context
+//   - days uint
+func (_e *MetricsAPI_Expecter) PortfolioMetricsSinceDays(ctx interface{}, days interface{}) *MetricsAPI_PortfolioMetricsSinceDays_Call {
+	return &MetricsAPI_PortfolioMetricsSinceDays_Call{Call: _e.mock.On("PortfolioMetricsSinceDays", ctx, days)}
+}
+
+func (_c *MetricsAPI_PortfolioMetricsSinceDays_Call) Run(run func(ctx context.Context, days uint)) *MetricsAPI_PortfolioMetricsSinceDays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_PortfolioMetricsSinceDays_Call) Return(_a0 []dtrack.PortfolioMetrics, _a1 error) *MetricsAPI_PortfolioMetricsSinceDays_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_PortfolioMetricsSinceDays_Call) RunAndReturn(run func(context.Context, uint) ([]dtrack.PortfolioMetrics, error)) *MetricsAPI_PortfolioMetricsSinceDays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProjectMetricsDelta provides a mock function with given fields: ctx, projectUUID, from, to
+func (_m *MetricsAPI) ProjectMetricsDelta(ctx context.Context, projectUUID uuid.UUID, from time.Time, to time.Time) (dtrack.ProjectMetricsDelta, error) {
+	ret := _m.Called(ctx, projectUUID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProjectMetricsDelta")
+	}
+
+	var r0 dtrack.ProjectMetricsDelta
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) (dtrack.ProjectMetricsDelta, error)); ok {
+		return rf(ctx, projectUUID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, time.Time) dtrack.ProjectMetricsDelta); ok {
+		r0 = rf(ctx, projectUUID, from, to)
+	} else {
+		r0 = ret.Get(0).(dtrack.ProjectMetricsDelta)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, projectUUID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_ProjectMetricsDelta_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProjectMetricsDelta'
+type MetricsAPI_ProjectMetricsDelta_Call struct {
+	*mock.Call
+}
+
+// ProjectMetricsDelta is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - from time.Time
+//   - to time.Time
+func (_e *MetricsAPI_Expecter) ProjectMetricsDelta(ctx interface{}, projectUUID interface{}, from interface{}, to interface{}) *MetricsAPI_ProjectMetricsDelta_Call {
+	return &MetricsAPI_ProjectMetricsDelta_Call{Call: _e.mock.On("ProjectMetricsDelta", ctx, projectUUID, from, to)}
+}
+
+func (_c *MetricsAPI_ProjectMetricsDelta_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, from time.Time, to time.Time)) *MetricsAPI_ProjectMetricsDelta_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_ProjectMetricsDelta_Call) Return(_a0 dtrack.ProjectMetricsDelta, _a1 error) *MetricsAPI_ProjectMetricsDelta_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_ProjectMetricsDelta_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, time.Time) (dtrack.ProjectMetricsDelta, error)) *MetricsAPI_ProjectMetricsDelta_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProjectMetricsSince provides a mock function with given fields: ctx, projectUUID, date
+func (_m *MetricsAPI) ProjectMetricsSince(ctx context.Context, projectUUID uuid.UUID, date time.Time) ([]dtrack.ProjectMetrics, error) {
+	ret := _m.Called(ctx, projectUUID, date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProjectMetricsSince")
+	}
+
+	var r0 []dtrack.ProjectMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) ([]dtrack.ProjectMetrics, error)); ok {
+		return rf(ctx, projectUUID, date)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) []dtrack.ProjectMetrics); ok {
+		r0 = rf(ctx, projectUUID, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.ProjectMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = rf(ctx, projectUUID, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_ProjectMetricsSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProjectMetricsSince'
+type MetricsAPI_ProjectMetricsSince_Call struct {
+	*mock.Call
+}
+
+// ProjectMetricsSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - date time.Time
+func (_e *MetricsAPI_Expecter) ProjectMetricsSince(ctx interface{}, projectUUID interface{}, date interface{}) *MetricsAPI_ProjectMetricsSince_Call {
+	return &MetricsAPI_ProjectMetricsSince_Call{Call: _e.mock.On("ProjectMetricsSince", ctx, projectUUID, date)}
+}
+
+func (_c *MetricsAPI_ProjectMetricsSince_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, date time.Time)) *MetricsAPI_ProjectMetricsSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_ProjectMetricsSince_Call) Return(_a0 []dtrack.ProjectMetrics, _a1 error) *MetricsAPI_ProjectMetricsSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_ProjectMetricsSince_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) ([]dtrack.ProjectMetrics, error)) *MetricsAPI_ProjectMetricsSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProjectMetricsSinceDays provides a mock function with given fields: ctx, projectUUID, days
+func (_m *MetricsAPI) ProjectMetricsSinceDays(ctx context.Context, projectUUID uuid.UUID, days uint) ([]dtrack.ProjectMetrics, error) {
+	ret := _m.Called(ctx, projectUUID, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProjectMetricsSinceDays")
+	}
+
+	var r0 []dtrack.ProjectMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint) ([]dtrack.ProjectMetrics, error)); ok {
+		return rf(ctx, projectUUID, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint) []dtrack.ProjectMetrics); ok {
+		r0 = rf(ctx, projectUUID, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.ProjectMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uint) error); ok {
+		r1 = rf(ctx, projectUUID, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_ProjectMetricsSinceDays_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProjectMetricsSinceDays'
+type MetricsAPI_ProjectMetricsSinceDays_Call struct {
+	*mock.Call
+}
+
+// ProjectMetricsSinceDays is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - days uint
+func (_e *MetricsAPI_Expecter) ProjectMetricsSinceDays(ctx interface{}, projectUUID interface{}, days interface{}) *MetricsAPI_ProjectMetricsSinceDays_Call {
+	return &MetricsAPI_ProjectMetricsSinceDays_Call{Call: _e.mock.On("ProjectMetricsSinceDays", ctx, projectUUID, days)}
+}
+
+func (_c *MetricsAPI_ProjectMetricsSinceDays_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, days uint)) *MetricsAPI_ProjectMetricsSinceDays_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uint))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_ProjectMetricsSinceDays_Call) Return(_a0 []dtrack.ProjectMetrics, _a1 error) *MetricsAPI_ProjectMetricsSinceDays_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_ProjectMetricsSinceDays_Call) RunAndReturn(run func(context.Context, uuid.UUID, uint) ([]dtrack.ProjectMetrics, error)) *MetricsAPI_ProjectMetricsSinceDays_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshComponentMetrics provides a mock function with given fields: ctx, componentUUID
+func (_m *MetricsAPI) RefreshComponentMetrics(ctx context.Context, componentUUID uuid.UUID) error {
+	ret := _m.Called(ctx, componentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshComponentMetrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, componentUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetricsAPI_RefreshComponentMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshComponentMetrics'
+type MetricsAPI_RefreshComponentMetrics_Call struct {
+	*mock.Call
+}
+
+// RefreshComponentMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+func (_e *MetricsAPI_Expecter) RefreshComponentMetrics(ctx interface{}, componentUUID interface{}) *MetricsAPI_RefreshComponentMetrics_Call {
+	return &MetricsAPI_RefreshComponentMetrics_Call{Call: _e.mock.On("RefreshComponentMetrics", ctx, componentUUID)}
+}
+
+func (_c *MetricsAPI_RefreshComponentMetrics_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID)) *MetricsAPI_RefreshComponentMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_RefreshComponentMetrics_Call) Return(_a0 error) *MetricsAPI_RefreshComponentMetrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetricsAPI_RefreshComponentMetrics_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MetricsAPI_RefreshComponentMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshPortfolioMetrics provides a mock function with given fields: ctx
+func (_m *MetricsAPI) RefreshPortfolioMetrics(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshPortfolioMetrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetricsAPI_RefreshPortfolioMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshPortfolioMetrics'
+type MetricsAPI_RefreshPortfolioMetrics_Call struct {
+	*mock.Call
+}
+
+// RefreshPortfolioMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MetricsAPI_Expecter) RefreshPortfolioMetrics(ctx interface{}) *MetricsAPI_RefreshPortfolioMetrics_Call {
+	return &MetricsAPI_RefreshPortfolioMetrics_Call{Call: _e.mock.On("RefreshPortfolioMetrics", ctx)}
+}
+
+func (_c *MetricsAPI_RefreshPortfolioMetrics_Call) Run(run func(ctx context.Context)) *MetricsAPI_RefreshPortfolioMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_RefreshPortfolioMetrics_Call) Return(_a0 error) *MetricsAPI_RefreshPortfolioMetrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetricsAPI_RefreshPortfolioMetrics_Call) RunAndReturn(run func(context.Context) error) *MetricsAPI_RefreshPortfolioMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshProjectMetrics provides a mock function with given fields: ctx, projectUUID
+func (_m *MetricsAPI) RefreshProjectMetrics(ctx context.Context, projectUUID uuid.UUID) error {
+	ret := _m.Called(ctx, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshProjectMetrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, projectUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetricsAPI_RefreshProjectMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshProjectMetrics'
+type MetricsAPI_RefreshProjectMetrics_Call struct {
+	*mock.Call
+}
+
+// RefreshProjectMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+func (_e *MetricsAPI_Expecter) RefreshProjectMetrics(ctx interface{}, projectUUID interface{}) *MetricsAPI_RefreshProjectMetrics_Call {
+	return &MetricsAPI_RefreshProjectMetrics_Call{Call: _e.mock.On("RefreshProjectMetrics", ctx, projectUUID)}
+}
+
+func (_c *MetricsAPI_RefreshProjectMetrics_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID)) *MetricsAPI_RefreshProjectMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_RefreshProjectMetrics_Call) Return(_a0 error) *MetricsAPI_RefreshProjectMetrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetricsAPI_RefreshProjectMetrics_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *MetricsAPI_RefreshProjectMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityMetrics provides a mock function with given fields: ctx
+func (_m *MetricsAPI) VulnerabilityMetrics(ctx context.Context) ([]dtrack.VulnerabilityMetrics, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VulnerabilityMetrics")
+	}
+
+	var r0 []dtrack.VulnerabilityMetrics
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]dtrack.VulnerabilityMetrics, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []dtrack.VulnerabilityMetrics); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.VulnerabilityMetrics)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MetricsAPI_VulnerabilityMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityMetrics'
+type MetricsAPI_VulnerabilityMetrics_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MetricsAPI_Expecter) VulnerabilityMetrics(ctx interface{}) *MetricsAPI_VulnerabilityMetrics_Call {
+	return &MetricsAPI_VulnerabilityMetrics_Call{Call: _e.mock.On("VulnerabilityMetrics", ctx)}
+}
+
+func (_c *MetricsAPI_VulnerabilityMetrics_Call) Run(run func(ctx context.Context)) *MetricsAPI_VulnerabilityMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_VulnerabilityMetrics_Call) Return(_a0 []dtrack.VulnerabilityMetrics, _a1 error) *MetricsAPI_VulnerabilityMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MetricsAPI_VulnerabilityMetrics_Call) RunAndReturn(run func(context.Context) ([]dtrack.VulnerabilityMetrics, error)) *MetricsAPI_VulnerabilityMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForComponentMetrics provides a mock function with given fields: ctx, componentUUID, since, opts
+func (_m *MetricsAPI) WaitForComponentMetrics(ctx context.Context, componentUUID uuid.UUID, since time.Time, opts dtrack.PollingOptions) error {
+	ret := _m.Called(ctx, componentUUID, since, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForComponentMetrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, dtrack.PollingOptions) error); ok {
+		r0 = rf(ctx, componentUUID, since, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetricsAPI_WaitForComponentMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForComponentMetrics'
+type MetricsAPI_WaitForComponentMetrics_Call struct {
+	*mock.Call
+}
+
+// WaitForComponentMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - since time.Time
+//   - opts dtrack.PollingOptions
+func (_e *MetricsAPI_Expecter) WaitForComponentMetrics(ctx interface{}, componentUUID interface{}, since interface{}, opts interface{}) *MetricsAPI_WaitForComponentMetrics_Call {
+	return &MetricsAPI_WaitForComponentMetrics_Call{Call: _e.mock.On("WaitForComponentMetrics", ctx, componentUUID, since, opts)}
+}
+
+func (_c *MetricsAPI_WaitForComponentMetrics_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, since time.Time, opts dtrack.PollingOptions)) *MetricsAPI_WaitForComponentMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(dtrack.PollingOptions))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_WaitForComponentMetrics_Call) Return(_a0 error) *MetricsAPI_WaitForComponentMetrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetricsAPI_WaitForComponentMetrics_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, dtrack.PollingOptions) error) *MetricsAPI_WaitForComponentMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForPortfolioMetrics provides a mock function with given fields: ctx, since, opts
+func (_m *MetricsAPI) WaitForPortfolioMetrics(ctx context.Context, since time.Time, opts dtrack.PollingOptions) error {
+	ret := _m.Called(ctx, since, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForPortfolioMetrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, dtrack.PollingOptions) error); ok {
+		r0 = rf(ctx, since, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetricsAPI_WaitForPortfolioMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForPortfolioMetrics'
+type MetricsAPI_WaitForPortfolioMetrics_Call struct {
+	*mock.Call
+}
+
+// WaitForPortfolioMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+//   - opts dtrack.PollingOptions
+func (_e *MetricsAPI_Expecter) WaitForPortfolioMetrics(ctx interface{}, since interface{}, opts interface{}) *MetricsAPI_WaitForPortfolioMetrics_Call {
+	return &MetricsAPI_WaitForPortfolioMetrics_Call{Call: _e.mock.On("WaitForPortfolioMetrics", ctx, since, opts)}
+}
+
+func (_c *MetricsAPI_WaitForPortfolioMetrics_Call) Run(run func(ctx context.Context, since time.Time, opts dtrack.PollingOptions)) *MetricsAPI_WaitForPortfolioMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(dtrack.PollingOptions))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_WaitForPortfolioMetrics_Call) Return(_a0 error) *MetricsAPI_WaitForPortfolioMetrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetricsAPI_WaitForPortfolioMetrics_Call) RunAndReturn(run func(context.Context, time.Time, dtrack.PollingOptions) error) *MetricsAPI_WaitForPortfolioMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForProjectMetrics provides a mock function with given fields: ctx, projectUUID, since, opts
+func (_m *MetricsAPI) WaitForProjectMetrics(ctx context.Context, projectUUID uuid.UUID, since time.Time, opts dtrack.PollingOptions) error {
+	ret := _m.Called(ctx, projectUUID, since, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForProjectMetrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, dtrack.PollingOptions) error); ok {
+		r0 = rf(ctx, projectUUID, since, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MetricsAPI_WaitForProjectMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForProjectMetrics'
+type MetricsAPI_WaitForProjectMetrics_Call struct {
+	*mock.Call
+}
+
+// WaitForProjectMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - since time.Time
+//   - opts dtrack.PollingOptions
+func (_e *MetricsAPI_Expecter) WaitForProjectMetrics(ctx interface{}, projectUUID interface{}, since interface{}, opts interface{}) *MetricsAPI_WaitForProjectMetrics_Call {
+	return &MetricsAPI_WaitForProjectMetrics_Call{Call: _e.mock.On("WaitForProjectMetrics", ctx, projectUUID, since, opts)}
+}
+
+func (_c *MetricsAPI_WaitForProjectMetrics_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, since time.Time, opts dtrack.PollingOptions)) *MetricsAPI_WaitForProjectMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time), args[3].(dtrack.PollingOptions))
+	})
+	return _c
+}
+
+func (_c *MetricsAPI_WaitForProjectMetrics_Call) Return(_a0 error) *MetricsAPI_WaitForProjectMetrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MetricsAPI_WaitForProjectMetrics_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time, dtrack.PollingOptions) error) *MetricsAPI_WaitForProjectMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMetricsAPI creates a new instance of MetricsAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetricsAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MetricsAPI {
+	mock := &MetricsAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,742 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// OIDCAPI is an autogenerated mock type for the OIDCAPI type
+type OIDCAPI struct {
+	mock.Mock
+}
+
+type OIDCAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OIDCAPI) EXPECT() *OIDCAPI_Expecter {
+	return &OIDCAPI_Expecter{mock: &_m.Mock}
+}
+
+// AddTeamMapping provides a mock function with given fields: ctx, mapping
+func (_m *OIDCAPI) AddTeamMapping(ctx context.Context, mapping dtrack.OIDCMappingRequest) (dtrack.OIDCMapping, error) {
+	ret := _m.Called(ctx, mapping)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTeamMapping")
+	}
+
+	var r0 dtrack.OIDCMapping
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCMappingRequest) (dtrack.OIDCMapping, error)); ok {
+		return rf(ctx, mapping)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCMappingRequest) dtrack.OIDCMapping); ok {
+		r0 = rf(ctx, mapping)
+	} else {
+		r0 = ret.Get(0).(dtrack.OIDCMapping)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.OIDCMappingRequest) error); ok {
+		r1 = rf(ctx, mapping)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAPI_AddTeamMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMapping'
+type OIDCAPI_AddTeamMapping_Call struct {
+	*mock.Call
+}
+
+// AddTeamMapping is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mapping dtrack.OIDCMappingRequest
+func (_e *OIDCAPI_Expecter) AddTeamMapping(ctx interface{}, mapping interface{}) *OIDCAPI_AddTeamMapping_Call {
+	return &OIDCAPI_AddTeamMapping_Call{Call: _e.mock.On("AddTeamMapping", ctx, mapping)}
+}
+
+func (_c *OIDCAPI_AddTeamMapping_Call) Run(run func(ctx context.Context, mapping dtrack.OIDCMappingRequest)) *OIDCAPI_AddTeamMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.OIDCMappingRequest))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_AddTeamMapping_Call) Return(_a0 dtrack.OIDCMapping, _a1 error) *OIDCAPI_AddTeamMapping_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAPI_AddTeamMapping_Call) RunAndReturn(run func(context.Context, dtrack.OIDCMappingRequest) (dtrack.OIDCMapping, error)) *OIDCAPI_AddTeamMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Available provides a mock function with given fields: ctx
+func (_m *OIDCAPI) Available(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Available")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAPI_Available_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Available'
+type OIDCAPI_Available_Call struct {
+	*mock.Call
+}
+
+// Available is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OIDCAPI_Expecter) Available(ctx interface{}) *OIDCAPI_Available_Call {
+	return &OIDCAPI_Available_Call{Call: _e.mock.On("Available", ctx)}
+}
+
+func (_c *OIDCAPI_Available_Call) Run(run func(ctx context.Context)) *OIDCAPI_Available_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_Available_Call) Return(_a0 bool, _a1 error) *OIDCAPI_Available_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAPI_Available_Call) RunAndReturn(run func(context.Context) (bool, error)) *OIDCAPI_Available_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateGroup provides a mock function with given fields: ctx, name
+func (_m *OIDCAPI) CreateGroup(ctx context.Context, name string) (dtrack.OIDCGroup, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGroup")
+	}
+
+	var r0 dtrack.OIDCGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (dtrack.OIDCGroup, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) dtrack.OIDCGroup); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(dtrack.OIDCGroup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAPI_CreateGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateGroup'
+type OIDCAPI_CreateGroup_Call struct {
+	*mock.Call
+}
+
+// CreateGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *OIDCAPI_Expecter) CreateGroup(ctx interface{}, name interface{}) *OIDCAPI_CreateGroup_Call {
+	return &OIDCAPI_CreateGroup_Call{Call: _e.mock.On("CreateGroup", ctx, name)}
+}
+
+func (_c *OIDCAPI_CreateGroup_Call) Run(run func(ctx context.Context, name string)) *OIDCAPI_CreateGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_CreateGroup_Call) Return(_a0 dtrack.OIDCGroup, _a1 error) *OIDCAPI_CreateGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAPI_CreateGroup_Call) RunAndReturn(run func(context.Context, string) (dtrack.OIDCGroup, error)) *OIDCAPI_CreateGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateUser provides a mock function with given fields: ctx, userReq
+func (_m *OIDCAPI) CreateUser(ctx context.Context, userReq dtrack.OIDCUser) (dtrack.OIDCUser, error) {
+	ret := _m.Called(ctx, userReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 dtrack.OIDCUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCUser) (dtrack.OIDCUser, error)); ok {
+		return rf(ctx, userReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCUser) dtrack.OIDCUser); ok {
+		r0 = rf(ctx, userReq)
+	} else {
+		r0 = ret.Get(0).(dtrack.OIDCUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.OIDCUser) error); ok {
+		r1 = rf(ctx, userReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAPI_CreateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUser'
+type OIDCAPI_CreateUser_Call struct {
+	*mock.Call
+}
+
+// CreateUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userReq dtrack.OIDCUser
+func (_e *OIDCAPI_Expecter) CreateUser(ctx interface{}, userReq interface{}) *OIDCAPI_CreateUser_Call {
+	return &OIDCAPI_CreateUser_Call{Call: _e.mock.On("CreateUser", ctx, userReq)}
+}
+
+func (_c *OIDCAPI_CreateUser_Call) Run(run func(ctx context.Context, userReq dtrack.OIDCUser)) *OIDCAPI_CreateUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.OIDCUser))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_CreateUser_Call) Return(_a0 dtrack.OIDCUser, _a1 error) *OIDCAPI_CreateUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAPI_CreateUser_Call) RunAndReturn(run func(context.Context, dtrack.OIDCUser) (dtrack.OIDCUser, error)) *OIDCAPI_CreateUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteGroup provides a mock function with given fields: ctx, groupUUID
+func (_m *OIDCAPI) DeleteGroup(ctx context.Context, groupUUID uuid.UUID) error {
+	ret := _m.Called(ctx, groupUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, groupUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OIDCAPI_DeleteGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteGroup'
+type OIDCAPI_DeleteGroup_Call struct {
+	*mock.Call
+}
+
+// DeleteGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupUUID uuid.UUID
+func (_e *OIDCAPI_Expecter) DeleteGroup(ctx interface{}, groupUUID interface{}) *OIDCAPI_DeleteGroup_Call {
+	return &OIDCAPI_DeleteGroup_Call{Call: _e.mock.On("DeleteGroup", ctx, groupUUID)}
+}
+
+func (_c *OIDCAPI_DeleteGroup_Call) Run(run func(ctx context.Context, groupUUID uuid.UUID)) *OIDCAPI_DeleteGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_DeleteGroup_Call) Return(_a0 error) *OIDCAPI_DeleteGroup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OIDCAPI_DeleteGroup_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *OIDCAPI_DeleteGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUser provides a mock function with given fields: ctx, user
+func (_m *OIDCAPI) DeleteUser(ctx context.Context, user dtrack.OIDCUser) error {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCUser) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OIDCAPI_DeleteUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUser'
+type OIDCAPI_DeleteUser_Call struct {
+	*mock.Call
+}
+
+// DeleteUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user dtrack.OIDCUser
+func (_e *OIDCAPI_Expecter) DeleteUser(ctx interface{}, user interface{}) *OIDCAPI_DeleteUser_Call {
+	return &OIDCAPI_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, user)}
+}
+
+func (_c *OIDCAPI_DeleteUser_Call) Run(run func(ctx context.Context, user dtrack.OIDCUser)) *OIDCAPI_DeleteUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.OIDCUser))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_DeleteUser_Call) Return(_a0 error) *OIDCAPI_DeleteUser_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OIDCAPI_DeleteUser_Call) RunAndReturn(run func(context.Context, dtrack.OIDCUser) error) *OIDCAPI_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllGroups provides a mock function with given fields: ctx
+func (_m *OIDCAPI) GetAllGroups(ctx context.Context) ([]dtrack.OIDCGroup, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllGroups")
+	}
+
+	var r0 []dtrack.OIDCGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]dtrack.OIDCGroup, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []dtrack.OIDCGroup); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.OIDCGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAPI_GetAllGroups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllGroups'
+type OIDCAPI_GetAllGroups_Call struct {
+	*mock.Call
+}
+
+// GetAllGroups is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OIDCAPI_Expecter) GetAllGroups(ctx interface{}) *OIDCAPI_GetAllGroups_Call {
+	return &OIDCAPI_GetAllGroups_Call{Call: _e.mock.On("GetAllGroups", ctx)}
+}
+
+func (_c *OIDCAPI_GetAllGroups_Call) Run(run func(ctx context.Context)) *OIDCAPI_GetAllGroups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_GetAllGroups_Call) Return(_a0 []dtrack.OIDCGroup, _a1 error) *OIDCAPI_GetAllGroups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAPI_GetAllGroups_Call) RunAndReturn(run func(context.Context) ([]dtrack.OIDCGroup, error)) *OIDCAPI_GetAllGroups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllTeamsOf provides a mock function with given fields: ctx, group
+func (_m *OIDCAPI) GetAllTeamsOf(ctx context.Context, group dtrack.OIDCGroup) ([]dtrack.Team, error) {
+	ret := _m.Called(ctx, group)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllTeamsOf")
+	}
+
+	var r0 []dtrack.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCGroup) ([]dtrack.Team, error)); ok {
+		return rf(ctx, group)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCGroup) []dtrack.Team); ok {
+		r0 = rf(ctx, group)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.OIDCGroup) error); ok {
+		r1 = rf(ctx, group)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAPI_GetAllTeamsOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllTeamsOf'
+type OIDCAPI_GetAllTeamsOf_Call struct {
+	*mock.Call
+}
+
+// GetAllTeamsOf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group dtrack.OIDCGroup
+func (_e *OIDCAPI_Expecter) GetAllTeamsOf(ctx interface{}, group interface{}) *OIDCAPI_GetAllTeamsOf_Call {
+	return &OIDCAPI_GetAllTeamsOf_Call{Call: _e.mock.On("GetAllTeamsOf", ctx, group)}
+}
+
+func (_c *OIDCAPI_GetAllTeamsOf_Call) Run(run func(ctx context.Context, group dtrack.OIDCGroup)) *OIDCAPI_GetAllTeamsOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.OIDCGroup))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_GetAllTeamsOf_Call) Return(_a0 []dtrack.Team, _a1 error) *OIDCAPI_GetAllTeamsOf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAPI_GetAllTeamsOf_Call) RunAndReturn(run func(context.Context, dtrack.OIDCGroup) ([]dtrack.Team, error)) *OIDCAPI_GetAllTeamsOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllUsers provides a mock function with given fields: ctx
+func (_m *OIDCAPI) GetAllUsers(ctx context.Context) (dtrack.Page[dtrack.OIDCUser], error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllUsers")
+	}
+
+	var r0 dtrack.Page[dtrack.OIDCUser]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dtrack.Page[dtrack.OIDCUser], error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dtrack.Page[dtrack.OIDCUser]); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.OIDCUser])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAPI_GetAllUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllUsers'
+type OIDCAPI_GetAllUsers_Call struct {
+	*mock.Call
+}
+
+// GetAllUsers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OIDCAPI_Expecter) GetAllUsers(ctx interface{}) *OIDCAPI_GetAllUsers_Call {
+	return &OIDCAPI_GetAllUsers_Call{Call: _e.mock.On("GetAllUsers", ctx)}
+}
+
+func (_c *OIDCAPI_GetAllUsers_Call) Run(run func(ctx context.Context)) *OIDCAPI_GetAllUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_GetAllUsers_Call) Return(_a0 dtrack.Page[dtrack.OIDCUser], _a1 error) *OIDCAPI_GetAllUsers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAPI_GetAllUsers_Call) RunAndReturn(run func(context.Context) (dtrack.Page[dtrack.OIDCUser], error)) *OIDCAPI_GetAllUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, tokens
+func (_m *OIDCAPI) Login(ctx context.Context, tokens dtrack.OIDCTokens) (string, error) {
+	ret := _m.Called(ctx, tokens)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCTokens) (string, error)); ok {
+		return rf(ctx, tokens)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCTokens) string); ok {
+		r0 = rf(ctx, tokens)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.OIDCTokens) error); ok {
+		r1 = rf(ctx, tokens)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAPI_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type OIDCAPI_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tokens dtrack.OIDCTokens
+func (_e *OIDCAPI_Expecter) Login(ctx interface{}, tokens interface{}) *OIDCAPI_Login_Call {
+	return &OIDCAPI_Login_Call{Call: _e.mock.On("Login", ctx, tokens)}
+}
+
+func (_c *OIDCAPI_Login_Call) Run(run func(ctx context.Context, tokens dtrack.OIDCTokens)) *OIDCAPI_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.OIDCTokens))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_Login_Call) Return(_a0 string, _a1 error) *OIDCAPI_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAPI_Login_Call) RunAndReturn(run func(context.Context, dtrack.OIDCTokens) (string, error)) *OIDCAPI_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamMapping provides a mock function with given fields: ctx, mappingID
+func (_m *OIDCAPI) RemoveTeamMapping(ctx context.Context, mappingID uuid.UUID) error {
+	ret := _m.Called(ctx, mappingID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamMapping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, mappingID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OIDCAPI_RemoveTeamMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMapping'
+type OIDCAPI_RemoveTeamMapping_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMapping is a helper method to define mock.On call
+//   - ctx context.Context
+//   - mappingID uuid.UUID
+func (_e *OIDCAPI_Expecter) RemoveTeamMapping(ctx interface{}, mappingID interface{}) *OIDCAPI_RemoveTeamMapping_Call {
+	return &OIDCAPI_RemoveTeamMapping_Call{Call: _e.mock.On("RemoveTeamMapping", ctx, mappingID)}
+}
+
+func (_c *OIDCAPI_RemoveTeamMapping_Call) Run(run func(ctx context.Context, mappingID uuid.UUID)) *OIDCAPI_RemoveTeamMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_RemoveTeamMapping_Call) Return(_a0 error) *OIDCAPI_RemoveTeamMapping_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OIDCAPI_RemoveTeamMapping_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *OIDCAPI_RemoveTeamMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamMapping2 provides a mock function with given fields: ctx, groupID, teamID
+func (_m *OIDCAPI) RemoveTeamMapping2(ctx context.Context, groupID uuid.UUID, teamID uuid.UUID) error {
+	ret := _m.Called(ctx, groupID, teamID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamMapping2")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, groupID, teamID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OIDCAPI_RemoveTeamMapping2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMapping2'
+type OIDCAPI_RemoveTeamMapping2_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMapping2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - groupID uuid.UUID
+//   - teamID uuid.UUID
+func (_e *OIDCAPI_Expecter) RemoveTeamMapping2(ctx interface{}, groupID interface{}, teamID interface{}) *OIDCAPI_RemoveTeamMapping2_Call {
+	return &OIDCAPI_RemoveTeamMapping2_Call{Call: _e.mock.On("RemoveTeamMapping2", ctx, groupID, teamID)}
+}
+
+func (_c *OIDCAPI_RemoveTeamMapping2_Call) Run(run func(ctx context.Context, groupID uuid.UUID, teamID uuid.UUID)) *OIDCAPI_RemoveTeamMapping2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_RemoveTeamMapping2_Call) Return(_a0 error) *OIDCAPI_RemoveTeamMapping2_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OIDCAPI_RemoveTeamMapping2_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *OIDCAPI_RemoveTeamMapping2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGroup provides a mock function with given fields: ctx, group
+func (_m *OIDCAPI) UpdateGroup(ctx context.Context, group dtrack.OIDCGroup) (dtrack.OIDCGroup, error) {
+	ret := _m.Called(ctx, group)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGroup")
+	}
+
+	var r0 dtrack.OIDCGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCGroup) (dtrack.OIDCGroup, error)); ok {
+		return rf(ctx, group)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.OIDCGroup) dtrack.OIDCGroup); ok {
+		r0 = rf(ctx, group)
+	} else {
+		r0 = ret.Get(0).(dtrack.OIDCGroup)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.OIDCGroup) error); ok {
+		r1 = rf(ctx, group)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCAPI_UpdateGroup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGroup'
+type OIDCAPI_UpdateGroup_Call struct {
+	*mock.Call
+}
+
+// UpdateGroup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group dtrack.OIDCGroup
+func (_e *OIDCAPI_Expecter) UpdateGroup(ctx interface{}, group interface{}) *OIDCAPI_UpdateGroup_Call {
+	return &OIDCAPI_UpdateGroup_Call{Call: _e.mock.On("UpdateGroup", ctx, group)}
+}
+
+func (_c *OIDCAPI_UpdateGroup_Call) Run(run func(ctx context.Context, group dtrack.OIDCGroup)) *OIDCAPI_UpdateGroup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.OIDCGroup))
+	})
+	return _c
+}
+
+func (_c *OIDCAPI_UpdateGroup_Call) Return(_a0 dtrack.OIDCGroup, _a1 error) *OIDCAPI_UpdateGroup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OIDCAPI_UpdateGroup_Call) RunAndReturn(run func(context.Context, dtrack.OIDCGroup) (dtrack.OIDCGroup, error)) *OIDCAPI_UpdateGroup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOIDCAPI creates a new instance of OIDCAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOIDCAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OIDCAPI {
+	mock := &OIDCAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,328 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PermissionAPI is an autogenerated mock type for the PermissionAPI type
+type PermissionAPI struct {
+	mock.Mock
+}
+
+type PermissionAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PermissionAPI) EXPECT() *PermissionAPI_Expecter {
+	return &PermissionAPI_Expecter{mock: &_m.Mock}
+}
+
+// AddPermissionToTeam provides a mock function with given fields: ctx, permission, team
+func (_m *PermissionAPI) AddPermissionToTeam(ctx context.Context, permission dtrack.Permission, team uuid.UUID) (dtrack.Team, error) {
+	ret := _m.Called(ctx, permission, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPermissionToTeam")
+	}
+
+	var r0 dtrack.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Permission, uuid.UUID) (dtrack.Team, error)); ok {
+		return rf(ctx, permission, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Permission, uuid.UUID) dtrack.Team); ok {
+		r0 = rf(ctx, permission, team)
+	} else {
+		r0 = ret.Get(0).(dtrack.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Permission, uuid.UUID) error); ok {
+		r1 = rf(ctx, permission, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermissionAPI_AddPermissionToTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPermissionToTeam'
+type PermissionAPI_AddPermissionToTeam_Call struct {
+	*mock.Call
+}
+
+// AddPermissionToTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - permission dtrack.Permission
+//   - team uuid.UUID
+func (_e *PermissionAPI_Expecter) AddPermissionToTeam(ctx interface{}, permission interface{}, team interface{}) *PermissionAPI_AddPermissionToTeam_Call {
+	return &PermissionAPI_AddPermissionToTeam_Call{Call: _e.mock.On("AddPermissionToTeam", ctx, permission, team)}
+}
+
+func (_c *PermissionAPI_AddPermissionToTeam_Call) Run(run func(ctx context.Context, permission dtrack.Permission, team uuid.UUID)) *PermissionAPI_AddPermissionToTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Permission), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PermissionAPI_AddPermissionToTeam_Call) Return(_a0 dtrack.Team, _a1 error) *PermissionAPI_AddPermissionToTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PermissionAPI_AddPermissionToTeam_Call) RunAndReturn(run func(context.Context, dtrack.Permission, uuid.UUID) (dtrack.Team, error)) *PermissionAPI_AddPermissionToTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddPermissionToUser provides a mock function with given fields: ctx, permission, username
+func (_m *PermissionAPI) AddPermissionToUser(ctx context.Context, permission dtrack.Permission, username string) (dtrack.UserPrincipal, error) {
+	ret := _m.Called(ctx, permission, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPermissionToUser")
+	}
+
+	var r0 dtrack.UserPrincipal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Permission, string) (dtrack.UserPrincipal, error)); ok {
+		return rf(ctx, permission, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Permission, string) dtrack.UserPrincipal); ok {
+		r0 = rf(ctx, permission, username)
+	} else {
+		r0 = ret.Get(0).(dtrack.UserPrincipal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Permission, string) error); ok {
+		r1 = rf(ctx, permission, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermissionAPI_AddPermissionToUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPermissionToUser'
+type PermissionAPI_AddPermissionToUser_Call struct {
+	*mock.Call
+}
+
+// AddPermissionToUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - permission dtrack.Permission
+//   - username string
+func (_e *PermissionAPI_Expecter) AddPermissionToUser(ctx interface{}, permission interface{}, username interface{}) *PermissionAPI_AddPermissionToUser_Call {
+	return &PermissionAPI_AddPermissionToUser_Call{Call: _e.mock.On("AddPermissionToUser", ctx, permission, username)}
+}
+
+func (_c *PermissionAPI_AddPermissionToUser_Call) Run(run func(ctx context.Context, permission dtrack.Permission, username string)) *PermissionAPI_AddPermissionToUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Permission), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PermissionAPI_AddPermissionToUser_Call) Return(_a0 dtrack.UserPrincipal, _a1 error) *PermissionAPI_AddPermissionToUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PermissionAPI_AddPermissionToUser_Call) RunAndReturn(run func(context.Context, dtrack.Permission, string) (dtrack.UserPrincipal, error)) *PermissionAPI_AddPermissionToUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, po
+func (_m *PermissionAPI) GetAll(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Permission], error) {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.Permission]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.Permission], error)); ok {
+		return rf(ctx, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) dtrack.Page[dtrack.Permission]); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Permission])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermissionAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type PermissionAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+func (_e *PermissionAPI_Expecter) GetAll(ctx interface{}, po interface{}) *PermissionAPI_GetAll_Call {
+	return &PermissionAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, po)}
+}
+
+func (_c *PermissionAPI_GetAll_Call) Run(run func(ctx context.Context, po dtrack.PageOptions)) *PermissionAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *PermissionAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.Permission], _a1 error) *PermissionAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PermissionAPI_GetAll_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.Permission], error)) *PermissionAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemovePermissionFromTeam provides a mock function with given fields: ctx, permission, team
+func (_m *PermissionAPI) RemovePermissionFromTeam(ctx context.Context, permission dtrack.Permission, team uuid.UUID) (dtrack.Team, error) {
+	ret := _m.Called(ctx, permission, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePermissionFromTeam")
+	}
+
+	var r0 dtrack.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Permission, uuid.UUID) (dtrack.Team, error)); ok {
+		return rf(ctx, permission, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Permission, uuid.UUID) dtrack.Team); ok {
+		r0 = rf(ctx, permission, team)
+	} else {
+		r0 = ret.Get(0).(dtrack.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Permission, uuid.UUID) error); ok {
+		r1 = rf(ctx, permission, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermissionAPI_RemovePermissionFromTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePermissionFromTeam'
+type PermissionAPI_RemovePermissionFromTeam_Call struct {
+	*mock.Call
+}
+
+// RemovePermissionFromTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - permission dtrack.Permission
+//   - team uuid.UUID
+func (_e *PermissionAPI_Expecter) RemovePermissionFromTeam(ctx interface{}, permission interface{}, team interface{}) *PermissionAPI_RemovePermissionFromTeam_Call {
+	return &PermissionAPI_RemovePermissionFromTeam_Call{Call: _e.mock.On("RemovePermissionFromTeam", ctx, permission, team)}
+}
+
+func (_c *PermissionAPI_RemovePermissionFromTeam_Call) Run(run func(ctx context.Context, permission dtrack.Permission, team uuid.UUID)) *PermissionAPI_RemovePermissionFromTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Permission), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PermissionAPI_RemovePermissionFromTeam_Call) Return(_a0 dtrack.Team, _a1 error) *PermissionAPI_RemovePermissionFromTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PermissionAPI_RemovePermissionFromTeam_Call) RunAndReturn(run func(context.Context, dtrack.Permission, uuid.UUID) (dtrack.Team, error)) *PermissionAPI_RemovePermissionFromTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemovePermissionFromUser provides a mock function with given fields: ctx, permission, username
+func (_m *PermissionAPI) RemovePermissionFromUser(ctx context.Context, permission dtrack.Permission, username string) (dtrack.UserPrincipal, error) {
+	ret := _m.Called(ctx, permission, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemovePermissionFromUser")
+	}
+
+	var r0 dtrack.UserPrincipal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Permission, string) (dtrack.UserPrincipal, error)); ok {
+		return rf(ctx, permission, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Permission, string) dtrack.UserPrincipal); ok {
+		r0 = rf(ctx, permission, username)
+	} else {
+		r0 = ret.Get(0).(dtrack.UserPrincipal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Permission, string) error); ok {
+		r1 = rf(ctx, permission, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PermissionAPI_RemovePermissionFromUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemovePermissionFromUser'
+type PermissionAPI_RemovePermissionFromUser_Call struct {
+	*mock.Call
+}
+
+// RemovePermissionFromUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - permission dtrack.Permission
+//   - username string
+func (_e *PermissionAPI_Expecter) RemovePermissionFromUser(ctx interface{}, permission interface{}, username interface{}) *PermissionAPI_RemovePermissionFromUser_Call {
+	return &PermissionAPI_RemovePermissionFromUser_Call{Call: _e.mock.On("RemovePermissionFromUser", ctx, permission, username)}
+}
+
+func (_c *PermissionAPI_RemovePermissionFromUser_Call) Run(run func(ctx context.Context, permission dtrack.Permission, username string)) *PermissionAPI_RemovePermissionFromUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Permission), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PermissionAPI_RemovePermissionFromUser_Call) Return(_a0 dtrack.UserPrincipal, _a1 error) *PermissionAPI_RemovePermissionFromUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PermissionAPI_RemovePermissionFromUser_Call) RunAndReturn(run func(context.Context, dtrack.Permission, string) (dtrack.UserPrincipal, error)) *PermissionAPI_RemovePermissionFromUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPermissionAPI creates a new instance of PermissionAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPermissionAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PermissionAPI {
+	mock := &PermissionAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,546 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PolicyAPI is an autogenerated mock type for the PolicyAPI type
+type PolicyAPI struct {
+	mock.Mock
+}
+
+type PolicyAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PolicyAPI) EXPECT() *PolicyAPI_Expecter {
+	return &PolicyAPI_Expecter{mock: &_m.Mock}
+}
+
+// AddProject provides a mock function with given fields: ctx, policyUUID, projectUUID
+func (_m *PolicyAPI) AddProject(ctx context.Context, policyUUID uuid.UUID, projectUUID uuid.UUID) (dtrack.Policy, error) {
+	ret := _m.Called(ctx, policyUUID, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProject")
+	}
+
+	var r0 dtrack.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dtrack.Policy, error)); ok {
+		return rf(ctx, policyUUID, projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dtrack.Policy); ok {
+		r0 = rf(ctx, policyUUID, projectUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, policyUUID, projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyAPI_AddProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddProject'
+type PolicyAPI_AddProject_Call struct {
+	*mock.Call
+}
+
+// AddProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyUUID uuid.UUID
+//   - projectUUID uuid.UUID
+func (_e *PolicyAPI_Expecter) AddProject(ctx interface{}, policyUUID interface{}, projectUUID interface{}) *PolicyAPI_AddProject_Call {
+	return &PolicyAPI_AddProject_Call{Call: _e.mock.On("AddProject", ctx, policyUUID, projectUUID)}
+}
+
+func (_c *PolicyAPI_AddProject_Call) Run(run func(ctx context.Context, policyUUID uuid.UUID, projectUUID uuid.UUID)) *PolicyAPI_AddProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PolicyAPI_AddProject_Call) Return(_a0 dtrack.Policy, _a1 error) *PolicyAPI_AddProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyAPI_AddProject_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (dtrack.Policy, error)) *PolicyAPI_AddProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTag provides a mock function with given fields: ctx, policyUUID, tagName
+func (_m *PolicyAPI) AddTag(ctx context.Context, policyUUID uuid.UUID, tagName string) (dtrack.Policy, error) {
+	ret := _m.Called(ctx, policyUUID, tagName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTag")
+	}
+
+	var r0 dtrack.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (dtrack.Policy, error)); ok {
+		return rf(ctx, policyUUID, tagName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) dtrack.Policy); ok {
+		r0 = rf(ctx, policyUUID, tagName)
+	} else {
+		r0 = ret.Get(0).(dtrack.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, policyUUID, tagName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyAPI_AddTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTag'
+type PolicyAPI_AddTag_Call struct {
+	*mock.Call
+}
+
+// AddTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyUUID uuid.UUID
+//   - tagName string
+func (_e *PolicyAPI_Expecter) AddTag(ctx interface{}, policyUUID interface{}, tagName interface{}) *PolicyAPI_AddTag_Call {
+	return &PolicyAPI_AddTag_Call{Call: _e.mock.On("AddTag", ctx, policyUUID, tagName)}
+}
+
+func (_c *PolicyAPI_AddTag_Call) Run(run func(ctx context.Context, policyUUID uuid.UUID, tagName string)) *PolicyAPI_AddTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PolicyAPI_AddTag_Call) Return(_a0 dtrack.Policy, _a1 error) *PolicyAPI_AddTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyAPI_AddTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (dtrack.Policy, error)) *PolicyAPI_AddTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, policy
+func (_m *PolicyAPI) Create(ctx context.Context, policy dtrack.Policy) (dtrack.Policy, error) {
+	ret := _m.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Policy) (dtrack.Policy, error)); ok {
+		return rf(ctx, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Policy) dtrack.Policy); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Get(0).(dtrack.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Policy) error); ok {
+		r1 = rf(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type PolicyAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy dtrack.Policy
+func (_e *PolicyAPI_Expecter) Create(ctx interface{}, policy interface{}) *PolicyAPI_Create_Call {
+	return &PolicyAPI_Create_Call{Call: _e.mock.On("Create", ctx, policy)}
+}
+
+func (_c *PolicyAPI_Create_Call) Run(run func(ctx context.Context, policy dtrack.Policy)) *PolicyAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Policy))
+	})
+	return _c
+}
+
+func (_c *PolicyAPI_Create_Call) Return(_a0 dtrack.Policy, _a1 error) *PolicyAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyAPI_Create_Call) RunAndReturn(run func(context.Context, dtrack.Policy) (dtrack.Policy, error)) *PolicyAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, policyUUID
+func (_m *PolicyAPI) Delete(ctx context.Context, policyUUID uuid.UUID) error {
+	ret := _m.Called(ctx, policyUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, policyUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PolicyAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type PolicyAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyUUID uuid.UUID
+func (_e *PolicyAPI_Expecter) Delete(ctx interface{}, policyUUID interface{}) *PolicyAPI_Delete_Call {
+	return &PolicyAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, policyUUID)}
+}
+
+func (_c *PolicyAPI_Delete_Call) Run(run func(ctx context.Context, policyUUID uuid.UUID)) *PolicyAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PolicyAPI_Delete_Call) Return(_a0 error) *PolicyAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PolicyAPI_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *PolicyAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProject provides a mock function with given fields: ctx, policyUUID, projectUUID
+func (_m *PolicyAPI) DeleteProject(ctx context.Context, policyUUID uuid.UUID, projectUUID uuid.UUID) (dtrack.Policy, error) {
+	ret := _m.Called(ctx, policyUUID, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProject")
+	}
+
+	var r0 dtrack.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dtrack.Policy, error)); ok {
+		return rf(ctx, policyUUID, projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dtrack.Policy); ok {
+		r0 = rf(ctx, policyUUID, projectUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, policyUUID, projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyAPI_DeleteProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProject'
+type PolicyAPI_DeleteProject_Call struct {
+	*mock.Call
+}
+
+// DeleteProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyUUID uuid.UUID
+//   - projectUUID uuid.UUID
+func (_e *PolicyAPI_Expecter) DeleteProject(ctx interface{}, policyUUID interface{}, projectUUID interface{}) *PolicyAPI_DeleteProject_Call {
+	return &PolicyAPI_DeleteProject_Call{Call: _e.mock.On("DeleteProject", ctx, policyUUID, projectUUID)}
+}
+
+func (_c *PolicyAPI_DeleteProject_Call) Run(run func(ctx context.Context, policyUUID uuid.UUID, projectUUID uuid.UUID)) *PolicyAPI_DeleteProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PolicyAPI_DeleteProject_Call) Return(_a0 dtrack.Policy, _a1 error) *PolicyAPI_DeleteProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyAPI_DeleteProject_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (dtrack.Policy, error)) *PolicyAPI_DeleteProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: ctx, policyUUID, tagName
+func (_m *PolicyAPI) DeleteTag(ctx context.Context, policyUUID uuid.UUID, tagName string) (dtrack.Policy, error) {
+	ret := _m.Called(ctx, policyUUID, tagName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 dtrack.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (dtrack.Policy, error)); ok {
+		return rf(ctx, policyUUID, tagName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) dtrack.Policy); ok {
+		r0 = rf(ctx, policyUUID, tagName)
+	} else {
+		r0 = ret.Get(0).(dtrack.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, policyUUID, tagName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyAPI_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type PolicyAPI_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyUUID uuid.UUID
+//   - tagName string
+func (_e *PolicyAPI_Expecter) DeleteTag(ctx interface{}, policyUUID interface{}, tagName interface{}) *PolicyAPI_DeleteTag_Call {
+	return &PolicyAPI_DeleteTag_Call{Call: _e.mock.On("DeleteTag", ctx, policyUUID, tagName)}
+}
+
+func (_c *PolicyAPI_DeleteTag_Call) Run(run func(ctx context.Context, policyUUID uuid.UUID, tagName string)) *PolicyAPI_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PolicyAPI_DeleteTag_Call) Return(_a0 dtrack.Policy, _a1 error) *PolicyAPI_DeleteTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyAPI_DeleteTag_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (dtrack.Policy, error)) *PolicyAPI_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, policyUUID
+func (_m *PolicyAPI) Get(ctx context.Context, policyUUID uuid.UUID) (dtrack.Policy, error) {
+	ret := _m.Called(ctx, policyUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.Policy, error)); ok {
+		return rf(ctx, policyUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.Policy); ok {
+		r0 = rf(ctx, policyUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, policyUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type PolicyAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyUUID uuid.UUID
+func (_e *PolicyAPI_Expecter) Get(ctx interface{}, policyUUID interface{}) *PolicyAPI_Get_Call {
+	return &PolicyAPI_Get_Call{Call: _e.mock.On("Get", ctx, policyUUID)}
+}
+
+func (_c *PolicyAPI_Get_Call) Run(run func(ctx context.Context, policyUUID uuid.UUID)) *PolicyAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PolicyAPI_Get_Call) Return(_a0 dtrack.Policy, _a1 error) *PolicyAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyAPI_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.Policy, error)) *PolicyAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, po
+func (_m *PolicyAPI) GetAll(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Policy], error) {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.Policy]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.Policy], error)); ok {
+		return rf(ctx, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) dtrack.Page[dtrack.Policy]); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Policy])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type PolicyAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+func (_e *PolicyAPI_Expecter) GetAll(ctx interface{}, po interface{}) *PolicyAPI_GetAll_Call {
+	return &PolicyAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, po)}
+}
+
+func (_c *PolicyAPI_GetAll_Call) Run(run func(ctx context.Context, po dtrack.PageOptions)) *PolicyAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *PolicyAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.Policy], _a1 error) *PolicyAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyAPI_GetAll_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.Policy], error)) *PolicyAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, policy
+func (_m *PolicyAPI) Update(ctx context.Context, policy dtrack.Policy) (dtrack.Policy, error) {
+	ret := _m.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Policy) (dtrack.Policy, error)); ok {
+		return rf(ctx, policy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Policy) dtrack.Policy); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Get(0).(dtrack.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Policy) error); ok {
+		r1 = rf(ctx, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type PolicyAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy dtrack.Policy
+func (_e *PolicyAPI_Expecter) Update(ctx interface{}, policy interface{}) *PolicyAPI_Update_Call {
+	return &PolicyAPI_Update_Call{Call: _e.mock.On("Update", ctx, policy)}
+}
+
+func (_c *PolicyAPI_Update_Call) Run(run func(ctx context.Context, policy dtrack.Policy)) *PolicyAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Policy))
+	})
+	return _c
+}
+
+func (_c *PolicyAPI_Update_Call) Return(_a0 dtrack.Policy, _a1 error) *PolicyAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyAPI_Update_Call) RunAndReturn(run func(context.Context, dtrack.Policy) (dtrack.Policy, error)) *PolicyAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPolicyAPI creates a new instance of PolicyAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPolicyAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PolicyAPI {
+	mock := &PolicyAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,201 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PolicyConditionAPI is an autogenerated mock type for the PolicyConditionAPI type
+type PolicyConditionAPI struct {
+	mock.Mock
+}
+
+type PolicyConditionAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PolicyConditionAPI) EXPECT() *PolicyConditionAPI_Expecter {
+	return &PolicyConditionAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, policyUUID, policyCondition
+func (_m *PolicyConditionAPI) Create(ctx context.Context, policyUUID uuid.UUID, policyCondition dtrack.PolicyCondition) (dtrack.PolicyCondition, error) {
+	ret := _m.Called(ctx, policyUUID, policyCondition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.PolicyCondition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PolicyCondition) (dtrack.PolicyCondition, error)); ok {
+		return rf(ctx, policyUUID, policyCondition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PolicyCondition) dtrack.PolicyCondition); ok {
+		r0 = rf(ctx, policyUUID, policyCondition)
+	} else {
+		r0 = ret.Get(0).(dtrack.PolicyCondition)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.PolicyCondition) error); ok {
+		r1 = rf(ctx, policyUUID, policyCondition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyConditionAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type PolicyConditionAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyUUID uuid.UUID
+//   - policyCondition dtrack.PolicyCondition
+func (_e *PolicyConditionAPI_Expecter) Create(ctx interface{}, policyUUID interface{}, policyCondition interface{}) *PolicyConditionAPI_Create_Call {
+	return &PolicyConditionAPI_Create_Call{Call: _e.mock.On("Create", ctx, policyUUID, policyCondition)}
+}
+
+func (_c *PolicyConditionAPI_Create_Call) Run(run func(ctx context.Context, policyUUID uuid.UUID, policyCondition dtrack.PolicyCondition)) *PolicyConditionAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.PolicyCondition))
+	})
+	return _c
+}
+
+func (_c *PolicyConditionAPI_Create_Call) Return(_a0 dtrack.PolicyCondition, _a1 error) *PolicyConditionAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyConditionAPI_Create_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.PolicyCondition) (dtrack.PolicyCondition, error)) *PolicyConditionAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, policyConditionUUID
+func (_m *PolicyConditionAPI) Delete(ctx context.Context, policyConditionUUID uuid.UUID) error {
+	ret := _m.Called(ctx, policyConditionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, policyConditionUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PolicyConditionAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type PolicyConditionAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyConditionUUID uuid.UUID
+func (_e *PolicyConditionAPI_Expecter) Delete(ctx interface{}, policyConditionUUID interface{}) *PolicyConditionAPI_Delete_Call {
+	return &PolicyConditionAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, policyConditionUUID)}
+}
+
+func (_c *PolicyConditionAPI_Delete_Call) Run(run func(ctx context.Context, policyConditionUUID uuid.UUID)) *PolicyConditionAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PolicyConditionAPI_Delete_Call) Return(_a0 error) *PolicyConditionAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PolicyConditionAPI_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *PolicyConditionAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, policyCondition
+func (_m *PolicyConditionAPI) Update(ctx context.Context, policyCondition dtrack.PolicyCondition) (dtrack.PolicyCondition, error) {
+	ret := _m.Called(ctx, policyCondition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.PolicyCondition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PolicyCondition) (dtrack.PolicyCondition, error)); ok {
+		return rf(ctx, policyCondition)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PolicyCondition) dtrack.PolicyCondition); ok {
+		r0 = rf(ctx, policyCondition)
+	} else {
+		r0 = ret.Get(0).(dtrack.PolicyCondition)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PolicyCondition) error); ok {
+		r1 = rf(ctx, policyCondition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyConditionAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type PolicyConditionAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policyCondition dtrack.PolicyCondition
+func (_e *PolicyConditionAPI_Expecter) Update(ctx interface{}, policyCondition interface{}) *PolicyConditionAPI_Update_Call {
+	return &PolicyConditionAPI_Update_Call{Call: _e.mock.On("Update", ctx, policyCondition)}
+}
+
+func (_c *PolicyConditionAPI_Update_Call) Run(run func(ctx context.Context, policyCondition dtrack.PolicyCondition)) *PolicyConditionAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PolicyCondition))
+	})
+	return _c
+}
+
+func (_c *PolicyConditionAPI_Update_Call) Return(_a0 dtrack.PolicyCondition, _a1 error) *PolicyConditionAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyConditionAPI_Update_Call) RunAndReturn(run func(context.Context, dtrack.PolicyCondition) (dtrack.PolicyCondition, error)) *PolicyConditionAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPolicyConditionAPI creates a new instance of PolicyConditionAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPolicyConditionAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PolicyConditionAPI {
+	mock := &PolicyConditionAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
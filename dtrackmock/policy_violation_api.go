@@ -0,0 +1,215 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PolicyViolationAPI is an autogenerated mock type for the PolicyViolationAPI type
+type PolicyViolationAPI struct {
+	mock.Mock
+}
+
+type PolicyViolationAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PolicyViolationAPI) EXPECT() *PolicyViolationAPI_Expecter {
+	return &PolicyViolationAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetAll provides a mock function with given fields: ctx, suppressed, po
+func (_m *PolicyViolationAPI) GetAll(ctx context.Context, suppressed bool, po dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error) {
+	ret := _m.Called(ctx, suppressed, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.PolicyViolation]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool, dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error)); ok {
+		return rf(ctx, suppressed, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool, dtrack.PageOptions) dtrack.Page[dtrack.PolicyViolation]); ok {
+		r0 = rf(ctx, suppressed, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.PolicyViolation])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, suppressed, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyViolationAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type PolicyViolationAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - suppressed bool
+//   - po dtrack.PageOptions
+func (_e *PolicyViolationAPI_Expecter) GetAll(ctx interface{}, suppressed interface{}, po interface{}) *PolicyViolationAPI_GetAll_Call {
+	return &PolicyViolationAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, suppressed, po)}
+}
+
+func (_c *PolicyViolationAPI_GetAll_Call) Run(run func(ctx context.Context, suppressed bool, po dtrack.PageOptions)) *PolicyViolationAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool), args[2].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *PolicyViolationAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.PolicyViolation], _a1 error) *PolicyViolationAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyViolationAPI_GetAll_Call) RunAndReturn(run func(context.Context, bool, dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error)) *PolicyViolationAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllForComponent provides a mock function with given fields: ctx, componentUUID, suppressed, po
+func (_m *PolicyViolationAPI) GetAllForComponent(ctx context.Context, componentUUID uuid.UUID, suppressed bool, po dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error) {
+	ret := _m.Called(ctx, componentUUID, suppressed, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllForComponent")
+	}
+
+	var r0 dtrack.Page[dtrack.PolicyViolation]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error)); ok {
+		return rf(ctx, componentUUID, suppressed, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) dtrack.Page[dtrack.PolicyViolation]); ok {
+		r0 = rf(ctx, componentUUID, suppressed, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.PolicyViolation])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, componentUUID, suppressed, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyViolationAPI_GetAllForComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllForComponent'
+type PolicyViolationAPI_GetAllForComponent_Call struct {
+	*mock.Call
+}
+
+// GetAllForComponent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - suppressed bool
+//   - po dtrack.PageOptions
+func (_e *PolicyViolationAPI_Expecter) GetAllForComponent(ctx interface{}, componentUUID interface{}, suppressed interface{}, po interface{}) *PolicyViolationAPI_GetAllForComponent_Call {
+	return &PolicyViolationAPI_GetAllForComponent_Call{Call: _e.mock.On("GetAllForComponent", ctx, componentUUID, suppressed, po)}
+}
+
+func (_c *PolicyViolationAPI_GetAllForComponent_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, suppressed bool, po dtrack.PageOptions)) *PolicyViolationAPI_GetAllForComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *PolicyViolationAPI_GetAllForComponent_Call) Return(_a0 dtrack.Page[dtrack.PolicyViolation], _a1 error) *PolicyViolationAPI_GetAllForComponent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyViolationAPI_GetAllForComponent_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error)) *PolicyViolationAPI_GetAllForComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllForProject provides a mock function with given fields: ctx, projectUUID, suppressed, po
+func (_m *PolicyViolationAPI) GetAllForProject(ctx context.Context, projectUUID uuid.UUID, suppressed bool, po dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error) {
+	ret := _m.Called(ctx, projectUUID, suppressed, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllForProject")
+	}
+
+	var r0 dtrack.Page[dtrack.PolicyViolation]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error)); ok {
+		return rf(ctx, projectUUID, suppressed, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) dtrack.Page[dtrack.PolicyViolation]); ok {
+		r0 = rf(ctx, projectUUID, suppressed, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.PolicyViolation])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, projectUUID, suppressed, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PolicyViolationAPI_GetAllForProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllForProject'
+type PolicyViolationAPI_GetAllForProject_Call struct {
+	*mock.Call
+}
+
+// GetAllForProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - suppressed bool
+//   - po dtrack.PageOptions
+func (_e *PolicyViolationAPI_Expecter) GetAllForProject(ctx interface{}, projectUUID interface{}, suppressed interface{}, po interface{}) *PolicyViolationAPI_GetAllForProject_Call {
+	return &PolicyViolationAPI_GetAllForProject_Call{Call: _e.mock.On("GetAllForProject", ctx, projectUUID, suppressed, po)}
+}
+
+func (_c *PolicyViolationAPI_GetAllForProject_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, suppressed bool, po dtrack.PageOptions)) *PolicyViolationAPI_GetAllForProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *PolicyViolationAPI_GetAllForProject_Call) Return(_a0 dtrack.Page[dtrack.PolicyViolation], _a1 error) *PolicyViolationAPI_GetAllForProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PolicyViolationAPI_GetAllForProject_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error)) *PolicyViolationAPI_GetAllForProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPolicyViolationAPI creates a new instance of PolicyViolationAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPolicyViolationAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PolicyViolationAPI {
+	mock := &PolicyViolationAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
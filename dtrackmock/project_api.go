@@ -0,0 +1,1017 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ProjectAPI is an autogenerated mock type for the ProjectAPI type
+type ProjectAPI struct {
+	mock.Mock
+}
+
+type ProjectAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ProjectAPI) EXPECT() *ProjectAPI_Expecter {
+	return &ProjectAPI_Expecter{mock: &_m.Mock}
+}
+
+// BulkDelete provides a mock function with given fields: ctx, selector, opts
+func (_m *ProjectAPI) BulkDelete(ctx context.Context, selector dtrack.ProjectSelector, opts dtrack.BulkDeleteOptions) ([]dtrack.Project, error) {
+	ret := _m.Called(ctx, selector, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkDelete")
+	}
+
+	var r0 []dtrack.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ProjectSelector, dtrack.BulkDeleteOptions) ([]dtrack.Project, error)); ok {
+		return rf(ctx, selector, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ProjectSelector, dtrack.BulkDeleteOptions) []dtrack.Project); ok {
+		r0 = rf(ctx, selector, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.ProjectSelector, dtrack.BulkDeleteOptions) error); ok {
+		r1 = rf(ctx, selector, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_BulkDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkDelete'
+type ProjectAPI_BulkDelete_Call struct {
+	*mock.Call
+}
+
+// BulkDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - selector dtrack.ProjectSelector
+//   - opts dtrack.BulkDeleteOptions
+func (_e *ProjectAPI_Expecter) BulkDelete(ctx interface{}, selector interface{}, opts interface{}) *ProjectAPI_BulkDelete_Call {
+	return &ProjectAPI_BulkDelete_Call{Call: _e.mock.On("BulkDelete", ctx, selector, opts)}
+}
+
+func (_c *ProjectAPI_BulkDelete_Call) Run(run func(ctx context.Context, selector dtrack.ProjectSelector, opts dtrack.BulkDeleteOptions)) *ProjectAPI_BulkDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.ProjectSelector), args[2].(dtrack.BulkDeleteOptions))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_BulkDelete_Call) Return(_a0 []dtrack.Project, _a1 error) *ProjectAPI_BulkDelete_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_BulkDelete_Call) RunAndReturn(run func(context.Context, dtrack.ProjectSelector, dtrack.BulkDeleteOptions) ([]dtrack.Project, error)) *ProjectAPI_BulkDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Clone provides a mock function with given fields: ctx, cloneReq
+func (_m *ProjectAPI) Clone(ctx context.Context, cloneReq dtrack.ProjectCloneRequest) (dtrack.EventToken, error) {
+	ret := _m.Called(ctx, cloneReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Clone")
+	}
+
+	var r0 dtrack.EventToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ProjectCloneRequest) (dtrack.EventToken, error)); ok {
+		return rf(ctx, cloneReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ProjectCloneRequest) dtrack.EventToken); ok {
+		r0 = rf(ctx, cloneReq)
+	} else {
+		r0 = ret.Get(0).(dtrack.EventToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.ProjectCloneRequest) error); ok {
+		r1 = rf(ctx, cloneReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_Clone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clone'
+type ProjectAPI_Clone_Call struct {
+	*mock.Call
+}
+
+// Clone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cloneReq dtrack.ProjectCloneRequest
+func (_e *ProjectAPI_Expecter) Clone(ctx interface{}, cloneReq interface{}) *ProjectAPI_Clone_Call {
+	return &ProjectAPI_Clone_Call{Call: _e.mock.On("Clone", ctx, cloneReq)}
+}
+
+func (_c *ProjectAPI_Clone_Call) Run(run func(ctx context.Context, cloneReq dtrack.ProjectCloneRequest)) *ProjectAPI_Clone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.ProjectCloneRequest))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_Clone_Call) Return(_a0 dtrack.EventToken, _a1 error) *ProjectAPI_Clone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_Clone_Call) RunAndReturn(run func(context.Context, dtrack.ProjectCloneRequest) (dtrack.EventToken, error)) *ProjectAPI_Clone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, project
+func (_m *ProjectAPI) Create(ctx context.Context, project dtrack.Project) (dtrack.Project, error) {
+	ret := _m.Called(ctx, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Project) (dtrack.Project, error)); ok {
+		return rf(ctx, project)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Project) dtrack.Project); ok {
+		r0 = rf(ctx, project)
+	} else {
+		r0 = ret.Get(0).(dtrack.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Project) error); ok {
+		r1 = rf(ctx, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ProjectAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - project dtrack.Project
+func (_e *ProjectAPI_Expecter) Create(ctx interface{}, project interface{}) *ProjectAPI_Create_Call {
+	return &ProjectAPI_Create_Call{Call: _e.mock.On("Create", ctx, project)}
+}
+
+func (_c *ProjectAPI_Create_Call) Run(run func(ctx context.Context, project dtrack.Project)) *ProjectAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Project))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_Create_Call) Return(_a0 dtrack.Project, _a1 error) *ProjectAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_Create_Call) RunAndReturn(run func(context.Context, dtrack.Project) (dtrack.Project, error)) *ProjectAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, projectUUID
+func (_m *ProjectAPI) Delete(ctx context.Context, projectUUID uuid.UUID) error {
+	ret := _m.Called(ctx, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, projectUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProjectAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ProjectAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+func (_e *ProjectAPI_Expecter) Delete(ctx interface{}, projectUUID interface{}) *ProjectAPI_Delete_Call {
+	return &ProjectAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, projectUUID)}
+}
+
+func (_c *ProjectAPI_Delete_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID)) *ProjectAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_Delete_Call) Return(_a0 error) *ProjectAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProjectAPI_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *ProjectAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, projectUUID
+func (_m *ProjectAPI) Get(ctx context.Context, projectUUID uuid.UUID) (dtrack.Project, error) {
+	ret := _m.Called(ctx, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.Project, error)); ok {
+		return rf(ctx, projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.Project); ok {
+		r0 = rf(ctx, projectUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ProjectAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+func (_e *ProjectAPI_Expecter) Get(ctx interface{}, projectUUID interface{}) *ProjectAPI_Get_Call {
+	return &ProjectAPI_Get_Call{Call: _e.mock.On("Get", ctx, projectUUID)}
+}
+
+func (_c *ProjectAPI_Get_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID)) *ProjectAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_Get_Call) Return(_a0 dtrack.Project, _a1 error) *ProjectAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.Project, error)) *ProjectAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, po
+func (_m *ProjectAPI) GetAll(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.Project]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.Project], error)); ok {
+		return rf(ctx, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) dtrack.Page[dtrack.Project]); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Project])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ProjectAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+func (_e *ProjectAPI_Expecter) GetAll(ctx interface{}, po interface{}) *ProjectAPI_GetAll_Call {
+	return &ProjectAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, po)}
+}
+
+func (_c *ProjectAPI_GetAll_Call) Run(run func(ctx context.Context, po dtrack.PageOptions)) *ProjectAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.Project], _a1 error) *ProjectAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_GetAll_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.Project], error)) *ProjectAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllByClassifier provides a mock function with given fields: ctx, classifier, excludeInactive, onlyRoot, po
+func (_m *ProjectAPI) GetAllByClassifier(ctx context.Context, classifier string, excludeInactive bool, onlyRoot bool, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+	ret := _m.Called(ctx, classifier, excludeInactive, onlyRoot, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllByClassifier")
+	}
+
+	var r0 dtrack.Page[dtrack.Project]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, bool, dtrack.PageOptions) (dtrack.Page[dtrack.Project], error)); ok {
+		return rf(ctx, classifier, excludeInactive, onlyRoot, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, bool, dtrack.PageOptions) dtrack.Page[dtrack.Project]); ok {
+		r0 = rf(ctx, classifier, excludeInactive, onlyRoot, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Project])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, bool, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, classifier, excludeInactive, onlyRoot, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_GetAllByClassifier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllByClassifier'
+type ProjectAPI_GetAllByClassifier_Call struct {
+	*mock.Call
+}
+
+// GetAllByClassifier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - classifier string
+//   - excludeInactive bool
+//   - onlyRoot bool
+//   - po dtrack.PageOptions
+func (_e *ProjectAPI_Expecter) GetAllByClassifier(ctx interface{}, classifier interface{}, excludeInactive interface{}, onlyRoot interface{}, po interface{}) *ProjectAPI_GetAllByClassifier_Call {
+	return &ProjectAPI_GetAllByClassifier_Call{Call: _e.mock.On("GetAllByClassifier", ctx, classifier, excludeInactive, onlyRoot, po)}
+}
+
+func (_c *ProjectAPI_GetAllByClassifier_Call) Run(run func(ctx context.Context, classifier string, excludeInactive bool, onlyRoot bool, po dtrack.PageOptions)) *ProjectAPI_GetAllByClassifier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(bool), args[4].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_GetAllByClassifier_Call) Return(_a0 dtrack.Page[dtrack.Project], _a1 error) *ProjectAPI_GetAllByClassifier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_GetAllByClassifier_Call) RunAndReturn(run func(context.Context, string, bool, bool, dtrack.PageOptions) (dtrack.Page[dtrack.Project], error)) *ProjectAPI_GetAllByClassifier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllByTag provides a mock function with given fields: ctx, tag, excludeInactive, onlyRoot, po
+func (_m *ProjectAPI) GetAllByTag(ctx context.Context, tag string, excludeInactive bool, onlyRoot bool, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+	ret := _m.Called(ctx, tag, excludeInactive, onlyRoot, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllByTag")
+	}
+
+	var r0 dtrack.Page[dtrack.Project]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, bool, dtrack.PageOptions) (dtrack.Page[dtrack.Project], error)); ok {
+		return rf(ctx, tag, excludeInactive, onlyRoot, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, bool, dtrack.PageOptions) dtrack.Page[dtrack.Project]); ok {
+		r0 = rf(ctx, tag, excludeInactive, onlyRoot, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Project])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, bool, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, tag, excludeInactive, onlyRoot, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_GetAllByTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllByTag'
+type ProjectAPI_GetAllByTag_Call struct {
+	*mock.Call
+}
+
+// GetAllByTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - excludeInactive bool
+//   - onlyRoot bool
+//   - po dtrack.PageOptions
+func (_e *ProjectAPI_Expecter) GetAllByTag(ctx interface{}, tag interface{}, excludeInactive interface{}, onlyRoot interface{}, po interface{}) *ProjectAPI_GetAllByTag_Call {
+	return &ProjectAPI_GetAllByTag_Call{Call: _e.mock.On("GetAllByTag", ctx, tag, excludeInactive, onlyRoot, po)}
+}
+
+func (_c *ProjectAPI_GetAllByTag_Call) Run(run func(ctx context.Context, tag string, excludeInactive bool, onlyRoot bool, po dtrack.PageOptions)) *ProjectAPI_GetAllByTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(bool), args[4].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_GetAllByTag_Call) Return(_a0 dtrack.Page[dtrack.Project], _a1 error) *ProjectAPI_GetAllByTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_GetAllByTag_Call) RunAndReturn(run func(context.Context, string, bool, bool, dtrack.PageOptions) (dtrack.Page[dtrack.Project], error)) *ProjectAPI_GetAllByTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllFiltered provides a mock function with given fields: ctx, po, filterOptions
+func (_m *ProjectAPI) GetAllFiltered(ctx context.Context, po dtrack.PageOptions, filterOptions dtrack.ProjectFilterOptions) (dtrack.Page[dtrack.Project], error) {
+	ret := _m.Called(ctx, po, filterOptions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllFiltered")
+	}
+
+	var r0 dtrack.Page[dtrack.Project]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions, dtrack.ProjectFilterOptions) (dtrack.Page[dtrack.Project], error)); ok {
+		return rf(ctx, po, filterOptions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions, dtrack.ProjectFilterOptions) dtrack.Page[dtrack.Project]); ok {
+		r0 = rf(ctx, po, filterOptions)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Project])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions, dtrack.ProjectFilterOptions) error); ok {
+		r1 = rf(ctx, po, filterOptions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_GetAllFiltered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllFiltered'
+type ProjectAPI_GetAllFiltered_Call struct {
+	*mock.Call
+}
+
+// GetAllFiltered is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+//   - filterOptions dtrack.ProjectFilterOptions
+func (_e *ProjectAPI_Expecter) GetAllFiltered(ctx interface{}, po interface{}, filterOptions interface{}) *ProjectAPI_GetAllFiltered_Call {
+	return &ProjectAPI_GetAllFiltered_Call{Call: _e.mock.On("GetAllFiltered", ctx, po, filterOptions)}
+}
+
+func (_c *ProjectAPI_GetAllFiltered_Call) Run(run func(ctx context.Context, po dtrack.PageOptions, filterOptions dtrack.ProjectFilterOptions)) *ProjectAPI_GetAllFiltered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions), args[2].(dtrack.ProjectFilterOptions))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_GetAllFiltered_Call) Return(_a0 dtrack.Page[dtrack.Project], _a1 error) *ProjectAPI_GetAllFiltered_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_GetAllFiltered_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions, dtrack.ProjectFilterOptions) (dtrack.Page[dtrack.Project], error)) *ProjectAPI_GetAllFiltered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChildren provides a mock function with given fields: ctx, projectUUID, po
+func (_m *ProjectAPI) GetChildren(ctx context.Context, projectUUID uuid.UUID, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+	ret := _m.Called(ctx, projectUUID, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChildren")
+	}
+
+	var r0 dtrack.Page[dtrack.Project]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions) (dtrack.Page[dtrack.Project], error)); ok {
+		return rf(ctx, projectUUID, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions) dtrack.Page[dtrack.Project]); ok {
+		r0 = rf(ctx, projectUUID, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Project])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, projectUUID, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_GetChildren_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChildren'
+type ProjectAPI_GetChildren_Call struct {
+	*mock.Call
+}
+
+// GetChildren is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - po dtrack.PageOptions
+func (_e *ProjectAPI_Expecter) GetChildren(ctx interface{}, projectUUID interface{}, po interface{}) *ProjectAPI_GetChildren_Call {
+	return &ProjectAPI_GetChildren_Call{Call: _e.mock.On("GetChildren", ctx, projectUUID, po)}
+}
+
+func (_c *ProjectAPI_GetChildren_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, po dtrack.PageOptions)) *ProjectAPI_GetChildren_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_GetChildren_Call) Return(_a0 dtrack.Page[dtrack.Project], _a1 error) *ProjectAPI_GetChildren_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_GetChildren_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.PageOptions) (dtrack.Page[dtrack.Project], error)) *ProjectAPI_GetChildren_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectsForName provides a mock function with given fields: ctx, name, excludeInactive, onlyRoot
+func (_m *ProjectAPI) GetProjectsForName(ctx context.Context, name string, excludeInactive bool, onlyRoot bool) ([]dtrack.Project, error) {
+	ret := _m.Called(ctx, name, excludeInactive, onlyRoot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectsForName")
+	}
+
+	var r0 []dtrack.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, bool) ([]dtrack.Project, error)); ok {
+		return rf(ctx, name, excludeInactive, onlyRoot)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, bool) []dtrack.Project); ok {
+		r0 = rf(ctx, name, excludeInactive, onlyRoot)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, bool) error); ok {
+		r1 = rf(ctx, name, excludeInactive, onlyRoot)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_GetProjectsForName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectsForName'
+type ProjectAPI_GetProjectsForName_Call struct {
+	*mock.Call
+}
+
+// GetProjectsForName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - excludeInactive bool
+//   - onlyRoot bool
+func (_e *ProjectAPI_Expecter) GetProjectsForName(ctx interface{}, name interface{}, excludeInactive interface{}, onlyRoot interface{}) *ProjectAPI_GetProjectsForName_Call {
+	return &ProjectAPI_GetProjectsForName_Call{Call: _e.mock.On("GetProjectsForName", ctx, name, excludeInactive, onlyRoot)}
+}
+
+func (_c *ProjectAPI_GetProjectsForName_Call) Run(run func(ctx context.Context, name string, excludeInactive bool, onlyRoot bool)) *ProjectAPI_GetProjectsForName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_GetProjectsForName_Call) Return(_a0 []dtrack.Project, _a1 error) *ProjectAPI_GetProjectsForName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_GetProjectsForName_Call) RunAndReturn(run func(context.Context, string, bool, bool) ([]dtrack.Project, error)) *ProjectAPI_GetProjectsForName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTree provides a mock function with given fields: ctx, projectUUID
+func (_m *ProjectAPI) GetTree(ctx context.Context, projectUUID uuid.UUID) (dtrack.ProjectTree, error) {
+	ret := _m.Called(ctx, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTree")
+	}
+
+	var r0 dtrack.ProjectTree
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.ProjectTree, error)); ok {
+		return rf(ctx, projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.ProjectTree); ok {
+		r0 = rf(ctx, projectUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.ProjectTree)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_GetTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTree'
+type ProjectAPI_GetTree_Call struct {
+	*mock.Call
+}
+
+// GetTree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+func (_e *ProjectAPI_Expecter) GetTree(ctx interface{}, projectUUID interface{}) *ProjectAPI_GetTree_Call {
+	return &ProjectAPI_GetTree_Call{Call: _e.mock.On("GetTree", ctx, projectUUID)}
+}
+
+func (_c *ProjectAPI_GetTree_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID)) *ProjectAPI_GetTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_GetTree_Call) Return(_a0 dtrack.ProjectTree, _a1 error) *ProjectAPI_GetTree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_GetTree_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.ProjectTree, error)) *ProjectAPI_GetTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Latest provides a mock function with given fields: ctx, name
+func (_m *ProjectAPI) Latest(ctx context.Context, name string) (dtrack.Project, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Latest")
+	}
+
+	var r0 dtrack.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (dtrack.Project, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) dtrack.Project); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(dtrack.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_Latest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Latest'
+type ProjectAPI_Latest_Call struct {
+	*mock.Call
+}
+
+// Latest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ProjectAPI_Expecter) Latest(ctx interface{}, name interface{}) *ProjectAPI_Latest_Call {
+	return &ProjectAPI_Latest_Call{Call: _e.mock.On("Latest", ctx, name)}
+}
+
+func (_c *ProjectAPI_Latest_Call) Run(run func(ctx context.Context, name string)) *ProjectAPI_Latest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_Latest_Call) Return(_a0 dtrack.Project, _a1 error) *ProjectAPI_Latest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_Latest_Call) RunAndReturn(run func(context.Context, string) (dtrack.Project, error)) *ProjectAPI_Latest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lookup provides a mock function with given fields: ctx, name, version
+func (_m *ProjectAPI) Lookup(ctx context.Context, name string, version string) (dtrack.Project, error) {
+	ret := _m.Called(ctx, name, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lookup")
+	}
+
+	var r0 dtrack.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (dtrack.Project, error)); ok {
+		return rf(ctx, name, version)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) dtrack.Project); ok {
+		r0 = rf(ctx, name, version)
+	} else {
+		r0 = ret.Get(0).(dtrack.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_Lookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lookup'
+type ProjectAPI_Lookup_Call struct {
+	*mock.Call
+}
+
+// Lookup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - version string
+func (_e *ProjectAPI_Expecter) Lookup(ctx interface{}, name interface{}, version interface{}) *ProjectAPI_Lookup_Call {
+	return &ProjectAPI_Lookup_Call{Call: _e.mock.On("Lookup", ctx, name, version)}
+}
+
+func (_c *ProjectAPI_Lookup_Call) Run(run func(ctx context.Context, name string, version string)) *ProjectAPI_Lookup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_Lookup_Call) Return(_a0 dtrack.Project, _a1 error) *ProjectAPI_Lookup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_Lookup_Call) RunAndReturn(run func(context.Context, string, string) (dtrack.Project, error)) *ProjectAPI_Lookup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function with given fields: ctx, projectUUID, project
+func (_m *ProjectAPI) Patch(ctx context.Context, projectUUID uuid.UUID, project dtrack.Project) (dtrack.Project, error) {
+	ret := _m.Called(ctx, projectUUID, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 dtrack.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.Project) (dtrack.Project, error)); ok {
+		return rf(ctx, projectUUID, project)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.Project) dtrack.Project); ok {
+		r0 = rf(ctx, projectUUID, project)
+	} else {
+		r0 = ret.Get(0).(dtrack.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.Project) error); ok {
+		r1 = rf(ctx, projectUUID, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type ProjectAPI_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - project dtrack.Project
+func (_e *ProjectAPI_Expecter) Patch(ctx interface{}, projectUUID interface{}, project interface{}) *ProjectAPI_Patch_Call {
+	return &ProjectAPI_Patch_Call{Call: _e.mock.On("Patch", ctx, projectUUID, project)}
+}
+
+func (_c *ProjectAPI_Patch_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, project dtrack.Project)) *ProjectAPI_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.Project))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_Patch_Call) Return(_a0 dtrack.Project, _a1 error) *ProjectAPI_Patch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_Patch_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.Project) (dtrack.Project, error)) *ProjectAPI_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Select provides a mock function with given fields: ctx, selector
+func (_m *ProjectAPI) Select(ctx context.Context, selector dtrack.ProjectSelector) ([]dtrack.Project, error) {
+	ret := _m.Called(ctx, selector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Select")
+	}
+
+	var r0 []dtrack.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ProjectSelector) ([]dtrack.Project, error)); ok {
+		return rf(ctx, selector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ProjectSelector) []dtrack.Project); ok {
+		r0 = rf(ctx, selector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.Project)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.ProjectSelector) error); ok {
+		r1 = rf(ctx, selector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_Select_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Select'
+type ProjectAPI_Select_Call struct {
+	*mock.Call
+}
+
+// Select is a helper method to define mock.On call
+//   - ctx context.Context
+//   - selector dtrack.ProjectSelector
+func (_e *ProjectAPI_Expecter) Select(ctx interface{}, selector interface{}) *ProjectAPI_Select_Call {
+	return &ProjectAPI_Select_Call{Call: _e.mock.On("Select", ctx, selector)}
+}
+
+func (_c *ProjectAPI_Select_Call) Run(run func(ctx context.Context, selector dtrack.ProjectSelector)) *ProjectAPI_Select_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.ProjectSelector))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_Select_Call) Return(_a0 []dtrack.Project, _a1 error) *ProjectAPI_Select_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_Select_Call) RunAndReturn(run func(context.Context, dtrack.ProjectSelector) ([]dtrack.Project, error)) *ProjectAPI_Select_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, project
+func (_m *ProjectAPI) Update(ctx context.Context, project dtrack.Project) (dtrack.Project, error) {
+	ret := _m.Called(ctx, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.Project
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Project) (dtrack.Project, error)); ok {
+		return rf(ctx, project)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Project) dtrack.Project); ok {
+		r0 = rf(ctx, project)
+	} else {
+		r0 = ret.Get(0).(dtrack.Project)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Project) error); ok {
+		r1 = rf(ctx, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ProjectAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - project dtrack.Project
+func (_e *ProjectAPI_Expecter) Update(ctx interface{}, project interface{}) *ProjectAPI_Update_Call {
+	return &ProjectAPI_Update_Call{Call: _e.mock.On("Update", ctx, project)}
+}
+
+func (_c *ProjectAPI_Update_Call) Run(run func(ctx context.Context, project dtrack.Project)) *ProjectAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Project))
+	})
+	return _c
+}
+
+func (_c *ProjectAPI_Update_Call) Return(_a0 dtrack.Project, _a1 error) *ProjectAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectAPI_Update_Call) RunAndReturn(run func(context.Context, dtrack.Project) (dtrack.Project, error)) *ProjectAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProjectAPI creates a new instance of ProjectAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProjectAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProjectAPI {
+	mock := &ProjectAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,262 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ProjectPropertyAPI is an autogenerated mock type for the ProjectPropertyAPI type
+type ProjectPropertyAPI struct {
+	mock.Mock
+}
+
+type ProjectPropertyAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ProjectPropertyAPI) EXPECT() *ProjectPropertyAPI_Expecter {
+	return &ProjectPropertyAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, projectUUID, property
+func (_m *ProjectPropertyAPI) Create(ctx context.Context, projectUUID uuid.UUID, property dtrack.ProjectProperty) (dtrack.ProjectProperty, error) {
+	ret := _m.Called(ctx, projectUUID, property)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.ProjectProperty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.ProjectProperty) (dtrack.ProjectProperty, error)); ok {
+		return rf(ctx, projectUUID, property)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.ProjectProperty) dtrack.ProjectProperty); ok {
+		r0 = rf(ctx, projectUUID, property)
+	} else {
+		r0 = ret.Get(0).(dtrack.ProjectProperty)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.ProjectProperty) error); ok {
+		r1 = rf(ctx, projectUUID, property)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectPropertyAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ProjectPropertyAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - property dtrack.ProjectProperty
+func (_e *ProjectPropertyAPI_Expecter) Create(ctx interface{}, projectUUID interface{}, property interface{}) *ProjectPropertyAPI_Create_Call {
+	return &ProjectPropertyAPI_Create_Call{Call: _e.mock.On("Create", ctx, projectUUID, property)}
+}
+
+func (_c *ProjectPropertyAPI_Create_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, property dtrack.ProjectProperty)) *ProjectPropertyAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.ProjectProperty))
+	})
+	return _c
+}
+
+func (_c *ProjectPropertyAPI_Create_Call) Return(_a0 dtrack.ProjectProperty, _a1 error) *ProjectPropertyAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectPropertyAPI_Create_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.ProjectProperty) (dtrack.ProjectProperty, error)) *ProjectPropertyAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, projectUUID, groupName, propertyName
+func (_m *ProjectPropertyAPI) Delete(ctx context.Context, projectUUID uuid.UUID, groupName string, propertyName string) error {
+	ret := _m.Called(ctx, projectUUID, groupName, propertyName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, string) error); ok {
+		r0 = rf(ctx, projectUUID, groupName, propertyName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProjectPropertyAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ProjectPropertyAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - groupName string
+//   - propertyName string
+func (_e *ProjectPropertyAPI_Expecter) Delete(ctx interface{}, projectUUID interface{}, groupName interface{}, propertyName interface{}) *ProjectPropertyAPI_Delete_Call {
+	return &ProjectPropertyAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, projectUUID, groupName, propertyName)}
+}
+
+func (_c *ProjectPropertyAPI_Delete_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, groupName string, propertyName string)) *ProjectPropertyAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ProjectPropertyAPI_Delete_Call) Return(_a0 error) *ProjectPropertyAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProjectPropertyAPI_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, string) error) *ProjectPropertyAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, projectUUID, po
+func (_m *ProjectPropertyAPI) GetAll(ctx context.Context, projectUUID uuid.UUID, po dtrack.PageOptions) (dtrack.Page[dtrack.ProjectProperty], error) {
+	ret := _m.Called(ctx, projectUUID, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.ProjectProperty]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions) (dtrack.Page[dtrack.ProjectProperty], error)); ok {
+		return rf(ctx, projectUUID, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions) dtrack.Page[dtrack.ProjectProperty]); ok {
+		r0 = rf(ctx, projectUUID, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.ProjectProperty])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, projectUUID, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectPropertyAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type ProjectPropertyAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - po dtrack.PageOptions
+func (_e *ProjectPropertyAPI_Expecter) GetAll(ctx interface{}, projectUUID interface{}, po interface{}) *ProjectPropertyAPI_GetAll_Call {
+	return &ProjectPropertyAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, projectUUID, po)}
+}
+
+func (_c *ProjectPropertyAPI_GetAll_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, po dtrack.PageOptions)) *ProjectPropertyAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *ProjectPropertyAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.ProjectProperty], _a1 error) *ProjectPropertyAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectPropertyAPI_GetAll_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.PageOptions) (dtrack.Page[dtrack.ProjectProperty], error)) *ProjectPropertyAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, projectUUID, property
+func (_m *ProjectPropertyAPI) Update(ctx context.Context, projectUUID uuid.UUID, property dtrack.ProjectProperty) (dtrack.ProjectProperty, error) {
+	ret := _m.Called(ctx, projectUUID, property)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.ProjectProperty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.ProjectProperty) (dtrack.ProjectProperty, error)); ok {
+		return rf(ctx, projectUUID, property)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.ProjectProperty) dtrack.ProjectProperty); ok {
+		r0 = rf(ctx, projectUUID, property)
+	} else {
+		r0 = ret.Get(0).(dtrack.ProjectProperty)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.ProjectProperty) error); ok {
+		r1 = rf(ctx, projectUUID, property)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProjectPropertyAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ProjectPropertyAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - property dtrack.ProjectProperty
+func (_e *ProjectPropertyAPI_Expecter) Update(ctx interface{}, projectUUID interface{}, property interface{}) *ProjectPropertyAPI_Update_Call {
+	return &ProjectPropertyAPI_Update_Call{Call: _e.mock.On("Update", ctx, projectUUID, property)}
+}
+
+func (_c *ProjectPropertyAPI_Update_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, property dtrack.ProjectProperty)) *ProjectPropertyAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.ProjectProperty))
+	})
+	return _c
+}
+
+func (_c *ProjectPropertyAPI_Update_Call) Return(_a0 dtrack.ProjectProperty, _a1 error) *ProjectPropertyAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ProjectPropertyAPI_Update_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.ProjectProperty) (dtrack.ProjectProperty, error)) *ProjectPropertyAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProjectPropertyAPI creates a new instance of ProjectPropertyAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProjectPropertyAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProjectPropertyAPI {
+	mock := &ProjectPropertyAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
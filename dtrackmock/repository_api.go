@@ -0,0 +1,372 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RepositoryAPI is an autogenerated mock type for the RepositoryAPI type
+type RepositoryAPI struct {
+	mock.Mock
+}
+
+type RepositoryAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepositoryAPI) EXPECT() *RepositoryAPI_Expecter {
+	return &RepositoryAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, repo
+func (_m *RepositoryAPI) Create(ctx context.Context, repo dtrack.Repository) (dtrack.Repository, error) {
+	ret := _m.Called(ctx, repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Repository) (dtrack.Repository, error)); ok {
+		return rf(ctx, repo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Repository) dtrack.Repository); ok {
+		r0 = rf(ctx, repo)
+	} else {
+		r0 = ret.Get(0).(dtrack.Repository)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Repository) error); ok {
+		r1 = rf(ctx, repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type RepositoryAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repo dtrack.Repository
+func (_e *RepositoryAPI_Expecter) Create(ctx interface{}, repo interface{}) *RepositoryAPI_Create_Call {
+	return &RepositoryAPI_Create_Call{Call: _e.mock.On("Create", ctx, repo)}
+}
+
+func (_c *RepositoryAPI_Create_Call) Run(run func(ctx context.Context, repo dtrack.Repository)) *RepositoryAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Repository))
+	})
+	return _c
+}
+
+func (_c *RepositoryAPI_Create_Call) Return(_a0 dtrack.Repository, _a1 error) *RepositoryAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryAPI_Create_Call) RunAndReturn(run func(context.Context, dtrack.Repository) (dtrack.Repository, error)) *RepositoryAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, reposUUID
+func (_m *RepositoryAPI) Delete(ctx context.Context, reposUUID uuid.UUID) error {
+	ret := _m.Called(ctx, reposUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, reposUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RepositoryAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type RepositoryAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reposUUID uuid.UUID
+func (_e *RepositoryAPI_Expecter) Delete(ctx interface{}, reposUUID interface{}) *RepositoryAPI_Delete_Call {
+	return &RepositoryAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, reposUUID)}
+}
+
+func (_c *RepositoryAPI_Delete_Call) Run(run func(ctx context.Context, reposUUID uuid.UUID)) *RepositoryAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RepositoryAPI_Delete_Call) Return(_a0 error) *RepositoryAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RepositoryAPI_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *RepositoryAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, po
+func (_m *RepositoryAPI) GetAll(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Repository], error) {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.Repository]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.Repository], error)); ok {
+		return rf(ctx, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) dtrack.Page[dtrack.Repository]); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Repository])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type RepositoryAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+func (_e *RepositoryAPI_Expecter) GetAll(ctx interface{}, po interface{}) *RepositoryAPI_GetAll_Call {
+	return &RepositoryAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, po)}
+}
+
+func (_c *RepositoryAPI_GetAll_Call) Run(run func(ctx context.Context, po dtrack.PageOptions)) *RepositoryAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *RepositoryAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.Repository], _a1 error) *RepositoryAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryAPI_GetAll_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.Repository], error)) *RepositoryAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByType provides a mock function with given fields: ctx, repoType, po
+func (_m *RepositoryAPI) GetByType(ctx context.Context, repoType dtrack.RepositoryType, po dtrack.PageOptions) (dtrack.Page[dtrack.Repository], error) {
+	ret := _m.Called(ctx, repoType, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByType")
+	}
+
+	var r0 dtrack.Page[dtrack.Repository]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.RepositoryType, dtrack.PageOptions) (dtrack.Page[dtrack.Repository], error)); ok {
+		return rf(ctx, repoType, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.RepositoryType, dtrack.PageOptions) dtrack.Page[dtrack.Repository]); ok {
+		r0 = rf(ctx, repoType, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Repository])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.RepositoryType, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, repoType, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryAPI_GetByType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByType'
+type RepositoryAPI_GetByType_Call struct {
+	*mock.Call
+}
+
+// GetByType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoType dtrack.RepositoryType
+//   - po dtrack.PageOptions
+func (_e *RepositoryAPI_Expecter) GetByType(ctx interface{}, repoType interface{}, po interface{}) *RepositoryAPI_GetByType_Call {
+	return &RepositoryAPI_GetByType_Call{Call: _e.mock.On("GetByType", ctx, repoType, po)}
+}
+
+func (_c *RepositoryAPI_GetByType_Call) Run(run func(ctx context.Context, repoType dtrack.RepositoryType, po dtrack.PageOptions)) *RepositoryAPI_GetByType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.RepositoryType), args[2].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *RepositoryAPI_GetByType_Call) Return(_a0 dtrack.Page[dtrack.Repository], _a1 error) *RepositoryAPI_GetByType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryAPI_GetByType_Call) RunAndReturn(run func(context.Context, dtrack.RepositoryType, dtrack.PageOptions) (dtrack.Page[dtrack.Repository], error)) *RepositoryAPI_GetByType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetaComponent provides a mock function with given fields: ctx, purl
+func (_m *RepositoryAPI) GetMetaComponent(ctx context.Context, purl string) (dtrack.RepositoryMetaComponent, error) {
+	ret := _m.Called(ctx, purl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetaComponent")
+	}
+
+	var r0 dtrack.RepositoryMetaComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (dtrack.RepositoryMetaComponent, error)); ok {
+		return rf(ctx, purl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) dtrack.RepositoryMetaComponent); ok {
+		r0 = rf(ctx, purl)
+	} else {
+		r0 = ret.Get(0).(dtrack.RepositoryMetaComponent)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, purl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryAPI_GetMetaComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetaComponent'
+type RepositoryAPI_GetMetaComponent_Call struct {
+	*mock.Call
+}
+
+// GetMetaComponent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - purl string
+func (_e *RepositoryAPI_Expecter) GetMetaComponent(ctx interface{}, purl interface{}) *RepositoryAPI_GetMetaComponent_Call {
+	return &RepositoryAPI_GetMetaComponent_Call{Call: _e.mock.On("GetMetaComponent", ctx, purl)}
+}
+
+func (_c *RepositoryAPI_GetMetaComponent_Call) Run(run func(ctx context.Context, purl string)) *RepositoryAPI_GetMetaComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RepositoryAPI_GetMetaComponent_Call) Return(_a0 dtrack.RepositoryMetaComponent, _a1 error) *RepositoryAPI_GetMetaComponent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryAPI_GetMetaComponent_Call) RunAndReturn(run func(context.Context, string) (dtrack.RepositoryMetaComponent, error)) *RepositoryAPI_GetMetaComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, repo
+func (_m *RepositoryAPI) Update(ctx context.Context, repo dtrack.Repository) (dtrack.Repository, error) {
+	ret := _m.Called(ctx, repo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Repository) (dtrack.Repository, error)); ok {
+		return rf(ctx, repo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Repository) dtrack.Repository); ok {
+		r0 = rf(ctx, repo)
+	} else {
+		r0 = ret.Get(0).(dtrack.Repository)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Repository) error); ok {
+		r1 = rf(ctx, repo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type RepositoryAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repo dtrack.Repository
+func (_e *RepositoryAPI_Expecter) Update(ctx interface{}, repo interface{}) *RepositoryAPI_Update_Call {
+	return &RepositoryAPI_Update_Call{Call: _e.mock.On("Update", ctx, repo)}
+}
+
+func (_c *RepositoryAPI_Update_Call) Run(run func(ctx context.Context, repo dtrack.Repository)) *RepositoryAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Repository))
+	})
+	return _c
+}
+
+func (_c *RepositoryAPI_Update_Call) Return(_a0 dtrack.Repository, _a1 error) *RepositoryAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RepositoryAPI_Update_Call) RunAndReturn(run func(context.Context, dtrack.Repository) (dtrack.Repository, error)) *RepositoryAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRepositoryAPI creates a new instance of RepositoryAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepositoryAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepositoryAPI {
+	mock := &RepositoryAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
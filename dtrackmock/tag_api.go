@@ -0,0 +1,715 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// TagAPI is an autogenerated mock type for the TagAPI type
+type TagAPI struct {
+	mock.Mock
+}
+
+type TagAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TagAPI) EXPECT() *TagAPI_Expecter {
+	return &TagAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, names
+func (_m *TagAPI) Create(ctx context.Context, names []string) error {
+	ret := _m.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TagAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *TagAPI_Expecter) Create(ctx interface{}, names interface{}) *TagAPI_Create_Call {
+	return &TagAPI_Create_Call{Call: _e.mock.On("Create", ctx, names)}
+}
+
+func (_c *TagAPI_Create_Call) Run(run func(ctx context.Context, names []string)) *TagAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *TagAPI_Create_Call) Return(_a0 error) *TagAPI_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_Create_Call) RunAndReturn(run func(context.Context, []string) error) *TagAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, names
+func (_m *TagAPI) Delete(ctx context.Context, names []string) error {
+	ret := _m.Called(ctx, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TagAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+func (_e *TagAPI_Expecter) Delete(ctx interface{}, names interface{}) *TagAPI_Delete_Call {
+	return &TagAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, names)}
+}
+
+func (_c *TagAPI_Delete_Call) Run(run func(ctx context.Context, names []string)) *TagAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *TagAPI_Delete_Call) Return(_a0 error) *TagAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_Delete_Call) RunAndReturn(run func(context.Context, []string) error) *TagAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, po, so
+func (_m *TagAPI) GetAll(ctx context.Context, po dtrack.PageOptions, so dtrack.SortOptions) (dtrack.Page[dtrack.TagListResponseItem], error) {
+	ret := _m.Called(ctx, po, so)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.TagListResponseItem]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.TagListResponseItem], error)); ok {
+		return rf(ctx, po, so)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions, dtrack.SortOptions) dtrack.Page[dtrack.TagListResponseItem]); ok {
+		r0 = rf(ctx, po, so)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.TagListResponseItem])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions, dtrack.SortOptions) error); ok {
+		r1 = rf(ctx, po, so)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type TagAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+//   - so dtrack.SortOptions
+func (_e *TagAPI_Expecter) GetAll(ctx interface{}, po interface{}, so interface{}) *TagAPI_GetAll_Call {
+	return &TagAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, po, so)}
+}
+
+func (_c *TagAPI_GetAll_Call) Run(run func(ctx context.Context, po dtrack.PageOptions, so dtrack.SortOptions)) *TagAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions), args[2].(dtrack.SortOptions))
+	})
+	return _c
+}
+
+func (_c *TagAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.TagListResponseItem], _a1 error) *TagAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagAPI_GetAll_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.TagListResponseItem], error)) *TagAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationRules provides a mock function with given fields: ctx, tag, po, so
+func (_m *TagAPI) GetNotificationRules(ctx context.Context, tag string, po dtrack.PageOptions, so dtrack.SortOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error) {
+	ret := _m.Called(ctx, tag, po, so)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationRules")
+	}
+
+	var r0 dtrack.Page[dtrack.TaggedPolicyListResponseItem]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error)); ok {
+		return rf(ctx, tag, po, so)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) dtrack.Page[dtrack.TaggedPolicyListResponseItem]); ok {
+		r0 = rf(ctx, tag, po, so)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.TaggedPolicyListResponseItem])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) error); ok {
+		r1 = rf(ctx, tag, po, so)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagAPI_GetNotificationRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationRules'
+type TagAPI_GetNotificationRules_Call struct {
+	*mock.Call
+}
+
+// GetNotificationRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - po dtrack.PageOptions
+//   - so dtrack.SortOptions
+func (_e *TagAPI_Expecter) GetNotificationRules(ctx interface{}, tag interface{}, po interface{}, so interface{}) *TagAPI_GetNotificationRules_Call {
+	return &TagAPI_GetNotificationRules_Call{Call: _e.mock.On("GetNotificationRules", ctx, tag, po, so)}
+}
+
+func (_c *TagAPI_GetNotificationRules_Call) Run(run func(ctx context.Context, tag string, po dtrack.PageOptions, so dtrack.SortOptions)) *TagAPI_GetNotificationRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(dtrack.PageOptions), args[3].(dtrack.SortOptions))
+	})
+	return _c
+}
+
+func (_c *TagAPI_GetNotificationRules_Call) Return(_a0 dtrack.Page[dtrack.TaggedPolicyListResponseItem], _a1 error) *TagAPI_GetNotificationRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagAPI_GetNotificationRules_Call) RunAndReturn(run func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error)) *TagAPI_GetNotificationRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicies provides a mock function with given fields: ctx, tag, po, so
+func (_m *TagAPI) GetPolicies(ctx context.Context, tag string, po dtrack.PageOptions, so dtrack.SortOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error) {
+	ret := _m.Called(ctx, tag, po, so)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicies")
+	}
+
+	var r0 dtrack.Page[dtrack.TaggedPolicyListResponseItem]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error)); ok {
+		return rf(ctx, tag, po, so)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) dtrack.Page[dtrack.TaggedPolicyListResponseItem]); ok {
+		r0 = rf(ctx, tag, po, so)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.TaggedPolicyListResponseItem])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) error); ok {
+		r1 = rf(ctx, tag, po, so)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagAPI_GetPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicies'
+type TagAPI_GetPolicies_Call struct {
+	*mock.Call
+}
+
+// GetPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - po dtrack.PageOptions
+//   - so dtrack.SortOptions
+func (_e *TagAPI_Expecter) GetPolicies(ctx interface{}, tag interface{}, po interface{}, so interface{}) *TagAPI_GetPolicies_Call {
+	return &TagAPI_GetPolicies_Call{Call: _e.mock.On("GetPolicies", ctx, tag, po, so)}
+}
+
+func (_c *TagAPI_GetPolicies_Call) Run(run func(ctx context.Context, tag string, po dtrack.PageOptions, so dtrack.SortOptions)) *TagAPI_GetPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(dtrack.PageOptions), args[3].(dtrack.SortOptions))
+	})
+	return _c
+}
+
+func (_c *TagAPI_GetPolicies_Call) Return(_a0 dtrack.Page[dtrack.TaggedPolicyListResponseItem], _a1 error) *TagAPI_GetPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagAPI_GetPolicies_Call) RunAndReturn(run func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error)) *TagAPI_GetPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjects provides a mock function with given fields: ctx, tag, po, so
+func (_m *TagAPI) GetProjects(ctx context.Context, tag string, po dtrack.PageOptions, so dtrack.SortOptions) (dtrack.Page[dtrack.TaggedProjectListResponseItem], error) {
+	ret := _m.Called(ctx, tag, po, so)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjects")
+	}
+
+	var r0 dtrack.Page[dtrack.TaggedProjectListResponseItem]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.TaggedProjectListResponseItem], error)); ok {
+		return rf(ctx, tag, po, so)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) dtrack.Page[dtrack.TaggedProjectListResponseItem]); ok {
+		r0 = rf(ctx, tag, po, so)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.TaggedProjectListResponseItem])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) error); ok {
+		r1 = rf(ctx, tag, po, so)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagAPI_GetProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjects'
+type TagAPI_GetProjects_Call struct {
+	*mock.Call
+}
+
+// GetProjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - po dtrack.PageOptions
+//   - so dtrack.SortOptions
+func (_e *TagAPI_Expecter) GetProjects(ctx interface{}, tag interface{}, po interface{}, so interface{}) *TagAPI_GetProjects_Call {
+	return &TagAPI_GetProjects_Call{Call: _e.mock.On("GetProjects", ctx, tag, po, so)}
+}
+
+func (_c *TagAPI_GetProjects_Call) Run(run func(ctx context.Context, tag string, po dtrack.PageOptions, so dtrack.SortOptions)) *TagAPI_GetProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(dtrack.PageOptions), args[3].(dtrack.SortOptions))
+	})
+	return _c
+}
+
+func (_c *TagAPI_GetProjects_Call) Return(_a0 dtrack.Page[dtrack.TaggedProjectListResponseItem], _a1 error) *TagAPI_GetProjects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagAPI_GetProjects_Call) RunAndReturn(run func(context.Context, string, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.TaggedProjectListResponseItem], error)) *TagAPI_GetProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTagsForPolicy provides a mock function with given fields: ctx, policy, po, so
+func (_m *TagAPI) GetTagsForPolicy(ctx context.Context, policy uuid.UUID, po dtrack.PageOptions, so dtrack.SortOptions) (dtrack.Page[dtrack.Tag], error) {
+	ret := _m.Called(ctx, policy, po, so)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTagsForPolicy")
+	}
+
+	var r0 dtrack.Page[dtrack.Tag]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.Tag], error)); ok {
+		return rf(ctx, policy, po, so)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, dtrack.PageOptions, dtrack.SortOptions) dtrack.Page[dtrack.Tag]); ok {
+		r0 = rf(ctx, policy, po, so)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Tag])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, dtrack.PageOptions, dtrack.SortOptions) error); ok {
+		r1 = rf(ctx, policy, po, so)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TagAPI_GetTagsForPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTagsForPolicy'
+type TagAPI_GetTagsForPolicy_Call struct {
+	*mock.Call
+}
+
+// GetTagsForPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy uuid.UUID
+//   - po dtrack.PageOptions
+//   - so dtrack.SortOptions
+func (_e *TagAPI_Expecter) GetTagsForPolicy(ctx interface{}, policy interface{}, po interface{}, so interface{}) *TagAPI_GetTagsForPolicy_Call {
+	return &TagAPI_GetTagsForPolicy_Call{Call: _e.mock.On("GetTagsForPolicy", ctx, policy, po, so)}
+}
+
+func (_c *TagAPI_GetTagsForPolicy_Call) Run(run func(ctx context.Context, policy uuid.UUID, po dtrack.PageOptions, so dtrack.SortOptions)) *TagAPI_GetTagsForPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(dtrack.PageOptions), args[3].(dtrack.SortOptions))
+	})
+	return _c
+}
+
+func (_c *TagAPI_GetTagsForPolicy_Call) Return(_a0 dtrack.Page[dtrack.Tag], _a1 error) *TagAPI_GetTagsForPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TagAPI_GetTagsForPolicy_Call) RunAndReturn(run func(context.Context, uuid.UUID, dtrack.PageOptions, dtrack.SortOptions) (dtrack.Page[dtrack.Tag], error)) *TagAPI_GetTagsForPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagNotificationRules provides a mock function with given fields: ctx, tag, rules
+func (_m *TagAPI) TagNotificationRules(ctx context.Context, tag string, rules []uuid.UUID) error {
+	ret := _m.Called(ctx, tag, rules)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagNotificationRules")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) error); ok {
+		r0 = rf(ctx, tag, rules)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_TagNotificationRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagNotificationRules'
+type TagAPI_TagNotificationRules_Call struct {
+	*mock.Call
+}
+
+// TagNotificationRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - rules []uuid.UUID
+func (_e *TagAPI_Expecter) TagNotificationRules(ctx interface{}, tag interface{}, rules interface{}) *TagAPI_TagNotificationRules_Call {
+	return &TagAPI_TagNotificationRules_Call{Call: _e.mock.On("TagNotificationRules", ctx, tag, rules)}
+}
+
+func (_c *TagAPI_TagNotificationRules_Call) Run(run func(ctx context.Context, tag string, rules []uuid.UUID)) *TagAPI_TagNotificationRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TagAPI_TagNotificationRules_Call) Return(_a0 error) *TagAPI_TagNotificationRules_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_TagNotificationRules_Call) RunAndReturn(run func(context.Context, string, []uuid.UUID) error) *TagAPI_TagNotificationRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagPolicies provides a mock function with given fields: ctx, tag, policies
+func (_m *TagAPI) TagPolicies(ctx context.Context, tag string, policies []uuid.UUID) error {
+	ret := _m.Called(ctx, tag, policies)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagPolicies")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) error); ok {
+		r0 = rf(ctx, tag, policies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_TagPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagPolicies'
+type TagAPI_TagPolicies_Call struct {
+	*mock.Call
+}
+
+// TagPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - policies []uuid.UUID
+func (_e *TagAPI_Expecter) TagPolicies(ctx interface{}, tag interface{}, policies interface{}) *TagAPI_TagPolicies_Call {
+	return &TagAPI_TagPolicies_Call{Call: _e.mock.On("TagPolicies", ctx, tag, policies)}
+}
+
+func (_c *TagAPI_TagPolicies_Call) Run(run func(ctx context.Context, tag string, policies []uuid.UUID)) *TagAPI_TagPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TagAPI_TagPolicies_Call) Return(_a0 error) *TagAPI_TagPolicies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_TagPolicies_Call) RunAndReturn(run func(context.Context, string, []uuid.UUID) error) *TagAPI_TagPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TagProjects provides a mock function with given fields: ctx, tag, projects
+func (_m *TagAPI) TagProjects(ctx context.Context, tag string, projects []uuid.UUID) error {
+	ret := _m.Called(ctx, tag, projects)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagProjects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) error); ok {
+		r0 = rf(ctx, tag, projects)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_TagProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagProjects'
+type TagAPI_TagProjects_Call struct {
+	*mock.Call
+}
+
+// TagProjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - projects []uuid.UUID
+func (_e *TagAPI_Expecter) TagProjects(ctx interface{}, tag interface{}, projects interface{}) *TagAPI_TagProjects_Call {
+	return &TagAPI_TagProjects_Call{Call: _e.mock.On("TagProjects", ctx, tag, projects)}
+}
+
+func (_c *TagAPI_TagProjects_Call) Run(run func(ctx context.Context, tag string, projects []uuid.UUID)) *TagAPI_TagProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TagAPI_TagProjects_Call) Return(_a0 error) *TagAPI_TagProjects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_TagProjects_Call) RunAndReturn(run func(context.Context, string, []uuid.UUID) error) *TagAPI_TagProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UntagNotificationRules provides a mock function with given fields: ctx, tag, rules
+func (_m *TagAPI) UntagNotificationRules(ctx context.Context, tag string, rules []uuid.UUID) error {
+	ret := _m.Called(ctx, tag, rules)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UntagNotificationRules")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) error); ok {
+		r0 = rf(ctx, tag, rules)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_UntagNotificationRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UntagNotificationRules'
+type TagAPI_UntagNotificationRules_Call struct {
+	*mock.Call
+}
+
+// UntagNotificationRules is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - rules []uuid.UUID
+func (_e *TagAPI_Expecter) UntagNotificationRules(ctx interface{}, tag interface{}, rules interface{}) *TagAPI_UntagNotificationRules_Call {
+	return &TagAPI_UntagNotificationRules_Call{Call: _e.mock.On("UntagNotificationRules", ctx, tag, rules)}
+}
+
+func (_c *TagAPI_UntagNotificationRules_Call) Run(run func(ctx context.Context, tag string, rules []uuid.UUID)) *TagAPI_UntagNotificationRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TagAPI_UntagNotificationRules_Call) Return(_a0 error) *TagAPI_UntagNotificationRules_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_UntagNotificationRules_Call) RunAndReturn(run func(context.Context, string, []uuid.UUID) error) *TagAPI_UntagNotificationRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UntagPolicies provides a mock function with given fields: ctx, tag, policies
+func (_m *TagAPI) UntagPolicies(ctx context.Context, tag string, policies []uuid.UUID) error {
+	ret := _m.Called(ctx, tag, policies)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UntagPolicies")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) error); ok {
+		r0 = rf(ctx, tag, policies)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_UntagPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UntagPolicies'
+type TagAPI_UntagPolicies_Call struct {
+	*mock.Call
+}
+
+// UntagPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - policies []uuid.UUID
+func (_e *TagAPI_Expecter) UntagPolicies(ctx interface{}, tag interface{}, policies interface{}) *TagAPI_UntagPolicies_Call {
+	return &TagAPI_UntagPolicies_Call{Call: _e.mock.On("UntagPolicies", ctx, tag, policies)}
+}
+
+func (_c *TagAPI_UntagPolicies_Call) Run(run func(ctx context.Context, tag string, policies []uuid.UUID)) *TagAPI_UntagPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TagAPI_UntagPolicies_Call) Return(_a0 error) *TagAPI_UntagPolicies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_UntagPolicies_Call) RunAndReturn(run func(context.Context, string, []uuid.UUID) error) *TagAPI_UntagPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UntagProjects provides a mock function with given fields: ctx, tag, projects
+func (_m *TagAPI) UntagProjects(ctx context.Context, tag string, projects []uuid.UUID) error {
+	ret := _m.Called(ctx, tag, projects)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UntagProjects")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []uuid.UUID) error); ok {
+		r0 = rf(ctx, tag, projects)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TagAPI_UntagProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UntagProjects'
+type TagAPI_UntagProjects_Call struct {
+	*mock.Call
+}
+
+// UntagProjects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tag string
+//   - projects []uuid.UUID
+func (_e *TagAPI_Expecter) UntagProjects(ctx interface{}, tag interface{}, projects interface{}) *TagAPI_UntagProjects_Call {
+	return &TagAPI_UntagProjects_Call{Call: _e.mock.On("UntagProjects", ctx, tag, projects)}
+}
+
+func (_c *TagAPI_UntagProjects_Call) Run(run func(ctx context.Context, tag string, projects []uuid.UUID)) *TagAPI_UntagProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TagAPI_UntagProjects_Call) Return(_a0 error) *TagAPI_UntagProjects_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TagAPI_UntagProjects_Call) RunAndReturn(run func(context.Context, string, []uuid.UUID) error) *TagAPI_UntagProjects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTagAPI creates a new instance of TagAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagAPI {
+	mock := &TagAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
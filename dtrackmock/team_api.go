@@ -0,0 +1,648 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// TeamAPI is an autogenerated mock type for the TeamAPI type
+type TeamAPI struct {
+	mock.Mock
+}
+
+type TeamAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TeamAPI) EXPECT() *TeamAPI_Expecter {
+	return &TeamAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, team
+func (_m *TeamAPI) Create(ctx context.Context, team dtrack.Team) (dtrack.Team, error) {
+	ret := _m.Called(ctx, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Team) (dtrack.Team, error)); ok {
+		return rf(ctx, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Team) dtrack.Team); ok {
+		r0 = rf(ctx, team)
+	} else {
+		r0 = ret.Get(0).(dtrack.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Team) error); ok {
+		r1 = rf(ctx, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TeamAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team dtrack.Team
+func (_e *TeamAPI_Expecter) Create(ctx interface{}, team interface{}) *TeamAPI_Create_Call {
+	return &TeamAPI_Create_Call{Call: _e.mock.On("Create", ctx, team)}
+}
+
+func (_c *TeamAPI_Create_Call) Run(run func(ctx context.Context, team dtrack.Team)) *TeamAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Team))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_Create_Call) Return(_a0 dtrack.Team, _a1 error) *TeamAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamAPI_Create_Call) RunAndReturn(run func(context.Context, dtrack.Team) (dtrack.Team, error)) *TeamAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, team
+func (_m *TeamAPI) Delete(ctx context.Context, team dtrack.Team) error {
+	ret := _m.Called(ctx, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Team) error); ok {
+		r0 = rf(ctx, team)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type TeamAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team dtrack.Team
+func (_e *TeamAPI_Expecter) Delete(ctx interface{}, team interface{}) *TeamAPI_Delete_Call {
+	return &TeamAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, team)}
+}
+
+func (_c *TeamAPI_Delete_Call) Run(run func(ctx context.Context, team dtrack.Team)) *TeamAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Team))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_Delete_Call) Return(_a0 error) *TeamAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamAPI_Delete_Call) RunAndReturn(run func(context.Context, dtrack.Team) error) *TeamAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAPIKey provides a mock function with given fields: ctx, publicIdOrKey
+func (_m *TeamAPI) DeleteAPIKey(ctx context.Context, publicIdOrKey string) error {
+	ret := _m.Called(ctx, publicIdOrKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, publicIdOrKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TeamAPI_DeleteAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAPIKey'
+type TeamAPI_DeleteAPIKey_Call struct {
+	*mock.Call
+}
+
+// DeleteAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - publicIdOrKey string
+func (_e *TeamAPI_Expecter) DeleteAPIKey(ctx interface{}, publicIdOrKey interface{}) *TeamAPI_DeleteAPIKey_Call {
+	return &TeamAPI_DeleteAPIKey_Call{Call: _e.mock.On("DeleteAPIKey", ctx, publicIdOrKey)}
+}
+
+func (_c *TeamAPI_DeleteAPIKey_Call) Run(run func(ctx context.Context, publicIdOrKey string)) *TeamAPI_DeleteAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_DeleteAPIKey_Call) Return(_a0 error) *TeamAPI_DeleteAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TeamAPI_DeleteAPIKey_Call) RunAndReturn(run func(context.Context, string) error) *TeamAPI_DeleteAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateAPIKey provides a mock function with given fields: ctx, teamUUID
+func (_m *TeamAPI) GenerateAPIKey(ctx context.Context, teamUUID uuid.UUID) (dtrack.APIKey, error) {
+	ret := _m.Called(ctx, teamUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateAPIKey")
+	}
+
+	var r0 dtrack.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.APIKey, error)); ok {
+		return rf(ctx, teamUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.APIKey); ok {
+		r0 = rf(ctx, teamUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.APIKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamAPI_GenerateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateAPIKey'
+type TeamAPI_GenerateAPIKey_Call struct {
+	*mock.Call
+}
+
+// GenerateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamUUID uuid.UUID
+func (_e *TeamAPI_Expecter) GenerateAPIKey(ctx interface{}, teamUUID interface{}) *TeamAPI_GenerateAPIKey_Call {
+	return &TeamAPI_GenerateAPIKey_Call{Call: _e.mock.On("GenerateAPIKey", ctx, teamUUID)}
+}
+
+func (_c *TeamAPI_GenerateAPIKey_Call) Run(run func(ctx context.Context, teamUUID uuid.UUID)) *TeamAPI_GenerateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_GenerateAPIKey_Call) Return(_a0 dtrack.APIKey, _a1 error) *TeamAPI_GenerateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamAPI_GenerateAPIKey_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.APIKey, error)) *TeamAPI_GenerateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, teamUUID
+func (_m *TeamAPI) Get(ctx context.Context, teamUUID uuid.UUID) (dtrack.Team, error) {
+	ret := _m.Called(ctx, teamUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.Team, error)); ok {
+		return rf(ctx, teamUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.Team); ok {
+		r0 = rf(ctx, teamUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TeamAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamUUID uuid.UUID
+func (_e *TeamAPI_Expecter) Get(ctx interface{}, teamUUID interface{}) *TeamAPI_Get_Call {
+	return &TeamAPI_Get_Call{Call: _e.mock.On("Get", ctx, teamUUID)}
+}
+
+func (_c *TeamAPI_Get_Call) Run(run func(ctx context.Context, teamUUID uuid.UUID)) *TeamAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_Get_Call) Return(_a0 dtrack.Team, _a1 error) *TeamAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamAPI_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.Team, error)) *TeamAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKeys provides a mock function with given fields: ctx, teamUUID
+func (_m *TeamAPI) GetAPIKeys(ctx context.Context, teamUUID uuid.UUID) ([]dtrack.APIKey, error) {
+	ret := _m.Called(ctx, teamUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeys")
+	}
+
+	var r0 []dtrack.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]dtrack.APIKey, error)); ok {
+		return rf(ctx, teamUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []dtrack.APIKey); ok {
+		r0 = rf(ctx, teamUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamAPI_GetAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKeys'
+type TeamAPI_GetAPIKeys_Call struct {
+	*mock.Call
+}
+
+// GetAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamUUID uuid.UUID
+func (_e *TeamAPI_Expecter) GetAPIKeys(ctx interface{}, teamUUID interface{}) *TeamAPI_GetAPIKeys_Call {
+	return &TeamAPI_GetAPIKeys_Call{Call: _e.mock.On("GetAPIKeys", ctx, teamUUID)}
+}
+
+func (_c *TeamAPI_GetAPIKeys_Call) Run(run func(ctx context.Context, teamUUID uuid.UUID)) *TeamAPI_GetAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_GetAPIKeys_Call) Return(_a0 []dtrack.APIKey, _a1 error) *TeamAPI_GetAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamAPI_GetAPIKeys_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]dtrack.APIKey, error)) *TeamAPI_GetAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function with given fields: ctx, po
+func (_m *TeamAPI) GetAll(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Team], error) {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 dtrack.Page[dtrack.Team]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.Team], error)); ok {
+		return rf(ctx, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) dtrack.Page[dtrack.Team]); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Team])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamAPI_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type TeamAPI_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+func (_e *TeamAPI_Expecter) GetAll(ctx interface{}, po interface{}) *TeamAPI_GetAll_Call {
+	return &TeamAPI_GetAll_Call{Call: _e.mock.On("GetAll", ctx, po)}
+}
+
+func (_c *TeamAPI_GetAll_Call) Run(run func(ctx context.Context, po dtrack.PageOptions)) *TeamAPI_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_GetAll_Call) Return(_a0 dtrack.Page[dtrack.Team], _a1 error) *TeamAPI_GetAll_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamAPI_GetAll_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.Team], error)) *TeamAPI_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSelf provides a mock function with given fields: ctx
+func (_m *TeamAPI) GetSelf(ctx context.Context) (dtrack.Team, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSelf")
+	}
+
+	var r0 dtrack.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dtrack.Team, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dtrack.Team); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dtrack.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamAPI_GetSelf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSelf'
+type TeamAPI_GetSelf_Call struct {
+	*mock.Call
+}
+
+// GetSelf is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TeamAPI_Expecter) GetSelf(ctx interface{}) *TeamAPI_GetSelf_Call {
+	return &TeamAPI_GetSelf_Call{Call: _e.mock.On("GetSelf", ctx)}
+}
+
+func (_c *TeamAPI_GetSelf_Call) Run(run func(ctx context.Context)) *TeamAPI_GetSelf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_GetSelf_Call) Return(_a0 dtrack.Team, _a1 error) *TeamAPI_GetSelf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamAPI_GetSelf_Call) RunAndReturn(run func(context.Context) (dtrack.Team, error)) *TeamAPI_GetSelf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegenerateAPIKey provides a mock function with given fields: ctx, publicIdOrKey
+func (_m *TeamAPI) RegenerateAPIKey(ctx context.Context, publicIdOrKey string) (dtrack.APIKey, error) {
+	ret := _m.Called(ctx, publicIdOrKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateAPIKey")
+	}
+
+	var r0 dtrack.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (dtrack.APIKey, error)); ok {
+		return rf(ctx, publicIdOrKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) dtrack.APIKey); ok {
+		r0 = rf(ctx, publicIdOrKey)
+	} else {
+		r0 = ret.Get(0).(dtrack.APIKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, publicIdOrKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamAPI_RegenerateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateAPIKey'
+type TeamAPI_RegenerateAPIKey_Call struct {
+	*mock.Call
+}
+
+// RegenerateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - publicIdOrKey string
+func (_e *TeamAPI_Expecter) RegenerateAPIKey(ctx interface{}, publicIdOrKey interface{}) *TeamAPI_RegenerateAPIKey_Call {
+	return &TeamAPI_RegenerateAPIKey_Call{Call: _e.mock.On("RegenerateAPIKey", ctx, publicIdOrKey)}
+}
+
+func (_c *TeamAPI_RegenerateAPIKey_Call) Run(run func(ctx context.Context, publicIdOrKey string)) *TeamAPI_RegenerateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_RegenerateAPIKey_Call) Return(_a0 dtrack.APIKey, _a1 error) *TeamAPI_RegenerateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamAPI_RegenerateAPIKey_Call) RunAndReturn(run func(context.Context, string) (dtrack.APIKey, error)) *TeamAPI_RegenerateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, team
+func (_m *TeamAPI) Update(ctx context.Context, team dtrack.Team) (dtrack.Team, error) {
+	ret := _m.Called(ctx, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Team) (dtrack.Team, error)); ok {
+		return rf(ctx, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Team) dtrack.Team); ok {
+		r0 = rf(ctx, team)
+	} else {
+		r0 = ret.Get(0).(dtrack.Team)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Team) error); ok {
+		r1 = rf(ctx, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type TeamAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team dtrack.Team
+func (_e *TeamAPI_Expecter) Update(ctx interface{}, team interface{}) *TeamAPI_Update_Call {
+	return &TeamAPI_Update_Call{Call: _e.mock.On("Update", ctx, team)}
+}
+
+func (_c *TeamAPI_Update_Call) Run(run func(ctx context.Context, team dtrack.Team)) *TeamAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Team))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_Update_Call) Return(_a0 dtrack.Team, _a1 error) *TeamAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamAPI_Update_Call) RunAndReturn(run func(context.Context, dtrack.Team) (dtrack.Team, error)) *TeamAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAPIKeyComment provides a mock function with given fields: ctx, publicIdOrKey, comment
+func (_m *TeamAPI) UpdateAPIKeyComment(ctx context.Context, publicIdOrKey string, comment string) (string, error) {
+	ret := _m.Called(ctx, publicIdOrKey, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAPIKeyComment")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, publicIdOrKey, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, publicIdOrKey, comment)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, publicIdOrKey, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TeamAPI_UpdateAPIKeyComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAPIKeyComment'
+type TeamAPI_UpdateAPIKeyComment_Call struct {
+	*mock.Call
+}
+
+// UpdateAPIKeyComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - publicIdOrKey string
+//   - comment string
+func (_e *TeamAPI_Expecter) UpdateAPIKeyComment(ctx interface{}, publicIdOrKey interface{}, comment interface{}) *TeamAPI_UpdateAPIKeyComment_Call {
+	return &TeamAPI_UpdateAPIKeyComment_Call{Call: _e.mock.On("UpdateAPIKeyComment", ctx, publicIdOrKey, comment)}
+}
+
+func (_c *TeamAPI_UpdateAPIKeyComment_Call) Run(run func(ctx context.Context, publicIdOrKey string, comment string)) *TeamAPI_UpdateAPIKeyComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TeamAPI_UpdateAPIKeyComment_Call) Return(_a0 string, _a1 error) *TeamAPI_UpdateAPIKeyComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TeamAPI_UpdateAPIKeyComment_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *TeamAPI_UpdateAPIKeyComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTeamAPI creates a new instance of TeamAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTeamAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TeamAPI {
+	mock := &TeamAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
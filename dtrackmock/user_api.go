@@ -0,0 +1,593 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// UserAPI is an autogenerated mock type for the UserAPI type
+type UserAPI struct {
+	mock.Mock
+}
+
+type UserAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserAPI) EXPECT() *UserAPI_Expecter {
+	return &UserAPI_Expecter{mock: &_m.Mock}
+}
+
+// AddTeamToUser provides a mock function with given fields: ctx, username, team
+func (_m *UserAPI) AddTeamToUser(ctx context.Context, username string, team uuid.UUID) (dtrack.UserPrincipal, error) {
+	ret := _m.Called(ctx, username, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTeamToUser")
+	}
+
+	var r0 dtrack.UserPrincipal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (dtrack.UserPrincipal, error)); ok {
+		return rf(ctx, username, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) dtrack.UserPrincipal); ok {
+		r0 = rf(ctx, username, team)
+	} else {
+		r0 = ret.Get(0).(dtrack.UserPrincipal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, username, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_AddTeamToUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamToUser'
+type UserAPI_AddTeamToUser_Call struct {
+	*mock.Call
+}
+
+// AddTeamToUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - team uuid.UUID
+func (_e *UserAPI_Expecter) AddTeamToUser(ctx interface{}, username interface{}, team interface{}) *UserAPI_AddTeamToUser_Call {
+	return &UserAPI_AddTeamToUser_Call{Call: _e.mock.On("AddTeamToUser", ctx, username, team)}
+}
+
+func (_c *UserAPI_AddTeamToUser_Call) Run(run func(ctx context.Context, username string, team uuid.UUID)) *UserAPI_AddTeamToUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserAPI_AddTeamToUser_Call) Return(_a0 dtrack.UserPrincipal, _a1 error) *UserAPI_AddTeamToUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_AddTeamToUser_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (dtrack.UserPrincipal, error)) *UserAPI_AddTeamToUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateManaged provides a mock function with given fields: ctx, usr
+func (_m *UserAPI) CreateManaged(ctx context.Context, usr dtrack.ManagedUser) (dtrack.ManagedUser, error) {
+	ret := _m.Called(ctx, usr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateManaged")
+	}
+
+	var r0 dtrack.ManagedUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ManagedUser) (dtrack.ManagedUser, error)); ok {
+		return rf(ctx, usr)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ManagedUser) dtrack.ManagedUser); ok {
+		r0 = rf(ctx, usr)
+	} else {
+		r0 = ret.Get(0).(dtrack.ManagedUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.ManagedUser) error); ok {
+		r1 = rf(ctx, usr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_CreateManaged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateManaged'
+type UserAPI_CreateManaged_Call struct {
+	*mock.Call
+}
+
+// CreateManaged is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usr dtrack.ManagedUser
+func (_e *UserAPI_Expecter) CreateManaged(ctx interface{}, usr interface{}) *UserAPI_CreateManaged_Call {
+	return &UserAPI_CreateManaged_Call{Call: _e.mock.On("CreateManaged", ctx, usr)}
+}
+
+func (_c *UserAPI_CreateManaged_Call) Run(run func(ctx context.Context, usr dtrack.ManagedUser)) *UserAPI_CreateManaged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.ManagedUser))
+	})
+	return _c
+}
+
+func (_c *UserAPI_CreateManaged_Call) Return(_a0 dtrack.ManagedUser, _a1 error) *UserAPI_CreateManaged_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_CreateManaged_Call) RunAndReturn(run func(context.Context, dtrack.ManagedUser) (dtrack.ManagedUser, error)) *UserAPI_CreateManaged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteManaged provides a mock function with given fields: ctx, user
+func (_m *UserAPI) DeleteManaged(ctx context.Context, user dtrack.ManagedUser) error {
+	ret := _m.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteManaged")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ManagedUser) error); ok {
+		r0 = rf(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserAPI_DeleteManaged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteManaged'
+type UserAPI_DeleteManaged_Call struct {
+	*mock.Call
+}
+
+// DeleteManaged is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user dtrack.ManagedUser
+func (_e *UserAPI_Expecter) DeleteManaged(ctx interface{}, user interface{}) *UserAPI_DeleteManaged_Call {
+	return &UserAPI_DeleteManaged_Call{Call: _e.mock.On("DeleteManaged", ctx, user)}
+}
+
+func (_c *UserAPI_DeleteManaged_Call) Run(run func(ctx context.Context, user dtrack.ManagedUser)) *UserAPI_DeleteManaged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.ManagedUser))
+	})
+	return _c
+}
+
+func (_c *UserAPI_DeleteManaged_Call) Return(_a0 error) *UserAPI_DeleteManaged_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserAPI_DeleteManaged_Call) RunAndReturn(run func(context.Context, dtrack.ManagedUser) error) *UserAPI_DeleteManaged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ForceChangePassword provides a mock function with given fields: ctx, username, password, newPassword
+func (_m *UserAPI) ForceChangePassword(ctx context.Context, username string, password string, newPassword string) error {
+	ret := _m.Called(ctx, username, password, newPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForceChangePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, username, password, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserAPI_ForceChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForceChangePassword'
+type UserAPI_ForceChangePassword_Call struct {
+	*mock.Call
+}
+
+// ForceChangePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - password string
+//   - newPassword string
+func (_e *UserAPI_Expecter) ForceChangePassword(ctx interface{}, username interface{}, password interface{}, newPassword interface{}) *UserAPI_ForceChangePassword_Call {
+	return &UserAPI_ForceChangePassword_Call{Call: _e.mock.On("ForceChangePassword", ctx, username, password, newPassword)}
+}
+
+func (_c *UserAPI_ForceChangePassword_Call) Run(run func(ctx context.Context, username string, password string, newPassword string)) *UserAPI_ForceChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *UserAPI_ForceChangePassword_Call) Return(_a0 error) *UserAPI_ForceChangePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserAPI_ForceChangePassword_Call) RunAndReturn(run func(context.Context, string, string, string) error) *UserAPI_ForceChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllManaged provides a mock function with given fields: ctx, po
+func (_m *UserAPI) GetAllManaged(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.ManagedUser], error) {
+	ret := _m.Called(ctx, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllManaged")
+	}
+
+	var r0 dtrack.Page[dtrack.ManagedUser]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.ManagedUser], error)); ok {
+		return rf(ctx, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.PageOptions) dtrack.Page[dtrack.ManagedUser]); ok {
+		r0 = rf(ctx, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.ManagedUser])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_GetAllManaged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllManaged'
+type UserAPI_GetAllManaged_Call struct {
+	*mock.Call
+}
+
+// GetAllManaged is a helper method to define mock.On call
+//   - ctx context.Context
+//   - po dtrack.PageOptions
+func (_e *UserAPI_Expecter) GetAllManaged(ctx interface{}, po interface{}) *UserAPI_GetAllManaged_Call {
+	return &UserAPI_GetAllManaged_Call{Call: _e.mock.On("GetAllManaged", ctx, po)}
+}
+
+func (_c *UserAPI_GetAllManaged_Call) Run(run func(ctx context.Context, po dtrack.PageOptions)) *UserAPI_GetAllManaged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *UserAPI_GetAllManaged_Call) Return(_a0 dtrack.Page[dtrack.ManagedUser], _a1 error) *UserAPI_GetAllManaged_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_GetAllManaged_Call) RunAndReturn(run func(context.Context, dtrack.PageOptions) (dtrack.Page[dtrack.ManagedUser], error)) *UserAPI_GetAllManaged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSelf provides a mock function with given fields: ctx
+func (_m *UserAPI) GetSelf(ctx context.Context) (dtrack.UserPrincipal, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSelf")
+	}
+
+	var r0 dtrack.UserPrincipal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dtrack.UserPrincipal, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dtrack.UserPrincipal); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dtrack.UserPrincipal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_GetSelf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSelf'
+type UserAPI_GetSelf_Call struct {
+	*mock.Call
+}
+
+// GetSelf is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserAPI_Expecter) GetSelf(ctx interface{}) *UserAPI_GetSelf_Call {
+	return &UserAPI_GetSelf_Call{Call: _e.mock.On("GetSelf", ctx)}
+}
+
+func (_c *UserAPI_GetSelf_Call) Run(run func(ctx context.Context)) *UserAPI_GetSelf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserAPI_GetSelf_Call) Return(_a0 dtrack.UserPrincipal, _a1 error) *UserAPI_GetSelf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_GetSelf_Call) RunAndReturn(run func(context.Context) (dtrack.UserPrincipal, error)) *UserAPI_GetSelf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, username, password
+func (_m *UserAPI) Login(ctx context.Context, username string, password string) (string, error) {
+	ret := _m.Called(ctx, username, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, username, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, username, password)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, username, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type UserAPI_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - password string
+func (_e *UserAPI_Expecter) Login(ctx interface{}, username interface{}, password interface{}) *UserAPI_Login_Call {
+	return &UserAPI_Login_Call{Call: _e.mock.On("Login", ctx, username, password)}
+}
+
+func (_c *UserAPI_Login_Call) Run(run func(ctx context.Context, username string, password string)) *UserAPI_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserAPI_Login_Call) Return(_a0 string, _a1 error) *UserAPI_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_Login_Call) RunAndReturn(run func(context.Context, string, string) (string, error)) *UserAPI_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamFromUser provides a mock function with given fields: ctx, username, team
+func (_m *UserAPI) RemoveTeamFromUser(ctx context.Context, username string, team uuid.UUID) (dtrack.UserPrincipal, error) {
+	ret := _m.Called(ctx, username, team)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamFromUser")
+	}
+
+	var r0 dtrack.UserPrincipal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (dtrack.UserPrincipal, error)); ok {
+		return rf(ctx, username, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) dtrack.UserPrincipal); ok {
+		r0 = rf(ctx, username, team)
+	} else {
+		r0 = ret.Get(0).(dtrack.UserPrincipal)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, username, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_RemoveTeamFromUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamFromUser'
+type UserAPI_RemoveTeamFromUser_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamFromUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - team uuid.UUID
+func (_e *UserAPI_Expecter) RemoveTeamFromUser(ctx interface{}, username interface{}, team interface{}) *UserAPI_RemoveTeamFromUser_Call {
+	return &UserAPI_RemoveTeamFromUser_Call{Call: _e.mock.On("RemoveTeamFromUser", ctx, username, team)}
+}
+
+func (_c *UserAPI_RemoveTeamFromUser_Call) Run(run func(ctx context.Context, username string, team uuid.UUID)) *UserAPI_RemoveTeamFromUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserAPI_RemoveTeamFromUser_Call) Return(_a0 dtrack.UserPrincipal, _a1 error) *UserAPI_RemoveTeamFromUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_RemoveTeamFromUser_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (dtrack.UserPrincipal, error)) *UserAPI_RemoveTeamFromUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateManaged provides a mock function with given fields: ctx, usr
+func (_m *UserAPI) UpdateManaged(ctx context.Context, usr dtrack.ManagedUser) (dtrack.ManagedUser, error) {
+	ret := _m.Called(ctx, usr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManaged")
+	}
+
+	var r0 dtrack.ManagedUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ManagedUser) (dtrack.ManagedUser, error)); ok {
+		return rf(ctx, usr)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ManagedUser) dtrack.ManagedUser); ok {
+		r0 = rf(ctx, usr)
+	} else {
+		r0 = ret.Get(0).(dtrack.ManagedUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.ManagedUser) error); ok {
+		r1 = rf(ctx, usr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_UpdateManaged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateManaged'
+type UserAPI_UpdateManaged_Call struct {
+	*mock.Call
+}
+
+// UpdateManaged is a helper method to define mock.On call
+//   - ctx context.Context
+//   - usr dtrack.ManagedUser
+func (_e *UserAPI_Expecter) UpdateManaged(ctx interface{}, usr interface{}) *UserAPI_UpdateManaged_Call {
+	return &UserAPI_UpdateManaged_Call{Call: _e.mock.On("UpdateManaged", ctx, usr)}
+}
+
+func (_c *UserAPI_UpdateManaged_Call) Run(run func(ctx context.Context, usr dtrack.ManagedUser)) *UserAPI_UpdateManaged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.ManagedUser))
+	})
+	return _c
+}
+
+func (_c *UserAPI_UpdateManaged_Call) Return(_a0 dtrack.ManagedUser, _a1 error) *UserAPI_UpdateManaged_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_UpdateManaged_Call) RunAndReturn(run func(context.Context, dtrack.ManagedUser) (dtrack.ManagedUser, error)) *UserAPI_UpdateManaged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateSelf provides a mock function with given fields: ctx, userReq
+func (_m *UserAPI) UpdateSelf(ctx context.Context, userReq dtrack.ManagedUser) (dtrack.ManagedUser, error) {
+	ret := _m.Called(ctx, userReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSelf")
+	}
+
+	var r0 dtrack.ManagedUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ManagedUser) (dtrack.ManagedUser, error)); ok {
+		return rf(ctx, userReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ManagedUser) dtrack.ManagedUser); ok {
+		r0 = rf(ctx, userReq)
+	} else {
+		r0 = ret.Get(0).(dtrack.ManagedUser)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.ManagedUser) error); ok {
+		r1 = rf(ctx, userReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_UpdateSelf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSelf'
+type UserAPI_UpdateSelf_Call struct {
+	*mock.Call
+}
+
+// UpdateSelf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userReq dtrack.ManagedUser
+func (_e *UserAPI_Expecter) UpdateSelf(ctx interface{}, userReq interface{}) *UserAPI_UpdateSelf_Call {
+	return &UserAPI_UpdateSelf_Call{Call: _e.mock.On("UpdateSelf", ctx, userReq)}
+}
+
+func (_c *UserAPI_UpdateSelf_Call) Run(run func(ctx context.Context, userReq dtrack.ManagedUser)) *UserAPI_UpdateSelf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.ManagedUser))
+	})
+	return _c
+}
+
+func (_c *UserAPI_UpdateSelf_Call) Return(_a0 dtrack.ManagedUser, _a1 error) *UserAPI_UpdateSelf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_UpdateSelf_Call) RunAndReturn(run func(context.Context, dtrack.ManagedUser) (dtrack.ManagedUser, error)) *UserAPI_UpdateSelf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserAPI creates a new instance of UserAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserAPI {
+	mock := &UserAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
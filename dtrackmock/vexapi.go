@@ -0,0 +1,210 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// VEXAPI is an autogenerated mock type for the VEXAPI type
+type VEXAPI struct {
+	mock.Mock
+}
+
+type VEXAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VEXAPI) EXPECT() *VEXAPI_Expecter {
+	return &VEXAPI_Expecter{mock: &_m.Mock}
+}
+
+// ExportCycloneDX provides a mock function with given fields: ctx, projectUUID
+func (_m *VEXAPI) ExportCycloneDX(ctx context.Context, projectUUID uuid.UUID) (string, error) {
+	ret := _m.Called(ctx, projectUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportCycloneDX")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (string, error)); ok {
+		return rf(ctx, projectUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) string); ok {
+		r0 = rf(ctx, projectUUID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, projectUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VEXAPI_ExportCycloneDX_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportCycloneDX'
+type VEXAPI_ExportCycloneDX_Call struct {
+	*mock.Call
+}
+
+// ExportCycloneDX is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+func (_e *VEXAPI_Expecter) ExportCycloneDX(ctx interface{}, projectUUID interface{}) *VEXAPI_ExportCycloneDX_Call {
+	return &VEXAPI_ExportCycloneDX_Call{Call: _e.mock.On("ExportCycloneDX", ctx, projectUUID)}
+}
+
+func (_c *VEXAPI_ExportCycloneDX_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID)) *VEXAPI_ExportCycloneDX_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *VEXAPI_ExportCycloneDX_Call) Return(_a0 string, _a1 error) *VEXAPI_ExportCycloneDX_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VEXAPI_ExportCycloneDX_Call) RunAndReturn(run func(context.Context, uuid.UUID) (string, error)) *VEXAPI_ExportCycloneDX_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PostVex provides a mock function with given fields: ctx, uploadReq
+func (_m *VEXAPI) PostVex(ctx context.Context, uploadReq dtrack.VEXUploadRequest) (dtrack.VEXUploadToken, error) {
+	ret := _m.Called(ctx, uploadReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostVex")
+	}
+
+	var r0 dtrack.VEXUploadToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.VEXUploadRequest) (dtrack.VEXUploadToken, error)); ok {
+		return rf(ctx, uploadReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.VEXUploadRequest) dtrack.VEXUploadToken); ok {
+		r0 = rf(ctx, uploadReq)
+	} else {
+		r0 = ret.Get(0).(dtrack.VEXUploadToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.VEXUploadRequest) error); ok {
+		r1 = rf(ctx, uploadReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VEXAPI_PostVex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PostVex'
+type VEXAPI_PostVex_Call struct {
+	*mock.Call
+}
+
+// PostVex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadReq dtrack.VEXUploadRequest
+func (_e *VEXAPI_Expecter) PostVex(ctx interface{}, uploadReq interface{}) *VEXAPI_PostVex_Call {
+	return &VEXAPI_PostVex_Call{Call: _e.mock.On("PostVex", ctx, uploadReq)}
+}
+
+func (_c *VEXAPI_PostVex_Call) Run(run func(ctx context.Context, uploadReq dtrack.VEXUploadRequest)) *VEXAPI_PostVex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.VEXUploadRequest))
+	})
+	return _c
+}
+
+func (_c *VEXAPI_PostVex_Call) Return(_a0 dtrack.VEXUploadToken, _a1 error) *VEXAPI_PostVex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VEXAPI_PostVex_Call) RunAndReturn(run func(context.Context, dtrack.VEXUploadRequest) (dtrack.VEXUploadToken, error)) *VEXAPI_PostVex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upload provides a mock function with given fields: ctx, uploadReq
+func (_m *VEXAPI) Upload(ctx context.Context, uploadReq dtrack.VEXUploadRequest) (dtrack.VEXUploadToken, error) {
+	ret := _m.Called(ctx, uploadReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upload")
+	}
+
+	var r0 dtrack.VEXUploadToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.VEXUploadRequest) (dtrack.VEXUploadToken, error)); ok {
+		return rf(ctx, uploadReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.VEXUploadRequest) dtrack.VEXUploadToken); ok {
+		r0 = rf(ctx, uploadReq)
+	} else {
+		r0 = ret.Get(0).(dtrack.VEXUploadToken)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.VEXUploadRequest) error); ok {
+		r1 = rf(ctx, uploadReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VEXAPI_Upload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upload'
+type VEXAPI_Upload_Call struct {
+	*mock.Call
+}
+
+// Upload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uploadReq dtrack.VEXUploadRequest
+func (_e *VEXAPI_Expecter) Upload(ctx interface{}, uploadReq interface{}) *VEXAPI_Upload_Call {
+	return &VEXAPI_Upload_Call{Call: _e.mock.On("Upload", ctx, uploadReq)}
+}
+
+func (_c *VEXAPI_Upload_Call) Run(run func(ctx context.Context, uploadReq dtrack.VEXUploadRequest)) *VEXAPI_Upload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.VEXUploadRequest))
+	})
+	return _c
+}
+
+func (_c *VEXAPI_Upload_Call) Return(_a0 dtrack.VEXUploadToken, _a1 error) *VEXAPI_Upload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VEXAPI_Upload_Call) RunAndReturn(run func(context.Context, dtrack.VEXUploadRequest) (dtrack.VEXUploadToken, error)) *VEXAPI_Upload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewVEXAPI creates a new instance of VEXAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVEXAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VEXAPI {
+	mock := &VEXAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,262 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ViolationAnalysisAPI is an autogenerated mock type for the ViolationAnalysisAPI type
+type ViolationAnalysisAPI struct {
+	mock.Mock
+}
+
+type ViolationAnalysisAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ViolationAnalysisAPI) EXPECT() *ViolationAnalysisAPI_Expecter {
+	return &ViolationAnalysisAPI_Expecter{mock: &_m.Mock}
+}
+
+// AddComment provides a mock function with given fields: ctx, componentUUID, policyViolationUUID, comment
+func (_m *ViolationAnalysisAPI) AddComment(ctx context.Context, componentUUID uuid.UUID, policyViolationUUID uuid.UUID, comment string) (dtrack.ViolationAnalysis, error) {
+	ret := _m.Called(ctx, componentUUID, policyViolationUUID, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddComment")
+	}
+
+	var r0 dtrack.ViolationAnalysis
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (dtrack.ViolationAnalysis, error)); ok {
+		return rf(ctx, componentUUID, policyViolationUUID, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) dtrack.ViolationAnalysis); ok {
+		r0 = rf(ctx, componentUUID, policyViolationUUID, comment)
+	} else {
+		r0 = ret.Get(0).(dtrack.ViolationAnalysis)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, componentUUID, policyViolationUUID, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViolationAnalysisAPI_AddComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddComment'
+type ViolationAnalysisAPI_AddComment_Call struct {
+	*mock.Call
+}
+
+// AddComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - policyViolationUUID uuid.UUID
+//   - comment string
+func (_e *ViolationAnalysisAPI_Expecter) AddComment(ctx interface{}, componentUUID interface{}, policyViolationUUID interface{}, comment interface{}) *ViolationAnalysisAPI_AddComment_Call {
+	return &ViolationAnalysisAPI_AddComment_Call{Call: _e.mock.On("AddComment", ctx, componentUUID, policyViolationUUID, comment)}
+}
+
+func (_c *ViolationAnalysisAPI_AddComment_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, policyViolationUUID uuid.UUID, comment string)) *ViolationAnalysisAPI_AddComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ViolationAnalysisAPI_AddComment_Call) Return(_a0 dtrack.ViolationAnalysis, _a1 error) *ViolationAnalysisAPI_AddComment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ViolationAnalysisAPI_AddComment_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) (dtrack.ViolationAnalysis, error)) *ViolationAnalysisAPI_AddComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpdate provides a mock function with given fields: ctx, violations, decision, concurrency
+func (_m *ViolationAnalysisAPI) BulkUpdate(ctx context.Context, violations []dtrack.PolicyViolation, decision dtrack.ViolationAnalysisDecision, concurrency int) error {
+	ret := _m.Called(ctx, violations, decision, concurrency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []dtrack.PolicyViolation, dtrack.ViolationAnalysisDecision, int) error); ok {
+		r0 = rf(ctx, violations, decision, concurrency)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ViolationAnalysisAPI_BulkUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdate'
+type ViolationAnalysisAPI_BulkUpdate_Call struct {
+	*mock.Call
+}
+
+// BulkUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - violations []dtrack.PolicyViolation
+//   - decision dtrack.ViolationAnalysisDecision
+//   - concurrency int
+func (_e *ViolationAnalysisAPI_Expecter) BulkUpdate(ctx interface{}, violations interface{}, decision interface{}, concurrency interface{}) *ViolationAnalysisAPI_BulkUpdate_Call {
+	return &ViolationAnalysisAPI_BulkUpdate_Call{Call: _e.mock.On("BulkUpdate", ctx, violations, decision, concurrency)}
+}
+
+func (_c *ViolationAnalysisAPI_BulkUpdate_Call) Run(run func(ctx context.Context, violations []dtrack.PolicyViolation, decision dtrack.ViolationAnalysisDecision, concurrency int)) *ViolationAnalysisAPI_BulkUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]dtrack.PolicyViolation), args[2].(dtrack.ViolationAnalysisDecision), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ViolationAnalysisAPI_BulkUpdate_Call) Return(_a0 error) *ViolationAnalysisAPI_BulkUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ViolationAnalysisAPI_BulkUpdate_Call) RunAndReturn(run func(context.Context, []dtrack.PolicyViolation, dtrack.ViolationAnalysisDecision, int) error) *ViolationAnalysisAPI_BulkUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, componentUUID, policyViolationUUID
+func (_m *ViolationAnalysisAPI) Get(ctx context.Context, componentUUID uuid.UUID, policyViolationUUID uuid.UUID) (dtrack.ViolationAnalysis, error) {
+	ret := _m.Called(ctx, componentUUID, policyViolationUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.ViolationAnalysis
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (dtrack.ViolationAnalysis, error)); ok {
+		return rf(ctx, componentUUID, policyViolationUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) dtrack.ViolationAnalysis); ok {
+		r0 = rf(ctx, componentUUID, policyViolationUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.ViolationAnalysis)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, componentUUID, policyViolationUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViolationAnalysisAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ViolationAnalysisAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - policyViolationUUID uuid.UUID
+func (_e *ViolationAnalysisAPI_Expecter) Get(ctx interface{}, componentUUID interface{}, policyViolationUUID interface{}) *ViolationAnalysisAPI_Get_Call {
+	return &ViolationAnalysisAPI_Get_Call{Call: _e.mock.On("Get", ctx, componentUUID, policyViolationUUID)}
+}
+
+func (_c *ViolationAnalysisAPI_Get_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, policyViolationUUID uuid.UUID)) *ViolationAnalysisAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ViolationAnalysisAPI_Get_Call) Return(_a0 dtrack.ViolationAnalysis, _a1 error) *ViolationAnalysisAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ViolationAnalysisAPI_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (dtrack.ViolationAnalysis, error)) *ViolationAnalysisAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, analysisReq
+func (_m *ViolationAnalysisAPI) Update(ctx context.Context, analysisReq dtrack.ViolationAnalysisRequest) (dtrack.ViolationAnalysis, error) {
+	ret := _m.Called(ctx, analysisReq)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.ViolationAnalysis
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ViolationAnalysisRequest) (dtrack.ViolationAnalysis, error)); ok {
+		return rf(ctx, analysisReq)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.ViolationAnalysisRequest) dtrack.ViolationAnalysis); ok {
+		r0 = rf(ctx, analysisReq)
+	} else {
+		r0 = ret.Get(0).(dtrack.ViolationAnalysis)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.ViolationAnalysisRequest) error); ok {
+		r1 = rf(ctx, analysisReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViolationAnalysisAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ViolationAnalysisAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - analysisReq dtrack.ViolationAnalysisRequest
+func (_e *ViolationAnalysisAPI_Expecter) Update(ctx interface{}, analysisReq interface{}) *ViolationAnalysisAPI_Update_Call {
+	return &ViolationAnalysisAPI_Update_Call{Call: _e.mock.On("Update", ctx, analysisReq)}
+}
+
+func (_c *ViolationAnalysisAPI_Update_Call) Run(run func(ctx context.Context, analysisReq dtrack.ViolationAnalysisRequest)) *ViolationAnalysisAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.ViolationAnalysisRequest))
+	})
+	return _c
+}
+
+func (_c *ViolationAnalysisAPI_Update_Call) Return(_a0 dtrack.ViolationAnalysis, _a1 error) *ViolationAnalysisAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ViolationAnalysisAPI_Update_Call) RunAndReturn(run func(context.Context, dtrack.ViolationAnalysisRequest) (dtrack.ViolationAnalysis, error)) *ViolationAnalysisAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewViolationAnalysisAPI creates a new instance of ViolationAnalysisAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewViolationAnalysisAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ViolationAnalysisAPI {
+	mock := &ViolationAnalysisAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
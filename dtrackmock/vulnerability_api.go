@@ -0,0 +1,803 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package dtrackmock
+
+import (
+	context "context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// VulnerabilityAPI is an autogenerated mock type for the VulnerabilityAPI type
+type VulnerabilityAPI struct {
+	mock.Mock
+}
+
+type VulnerabilityAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VulnerabilityAPI) EXPECT() *VulnerabilityAPI_Expecter {
+	return &VulnerabilityAPI_Expecter{mock: &_m.Mock}
+}
+
+// Assign provides a mock function with given fields: ctx, vulnUUID, componentUUID
+func (_m *VulnerabilityAPI) Assign(ctx context.Context, vulnUUID uuid.UUID, componentUUID uuid.UUID) error {
+	ret := _m.Called(ctx, vulnUUID, componentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Assign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, vulnUUID, componentUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VulnerabilityAPI_Assign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Assign'
+type VulnerabilityAPI_Assign_Call struct {
+	*mock.Call
+}
+
+// Assign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - vulnUUID uuid.UUID
+//   - componentUUID uuid.UUID
+func (_e *VulnerabilityAPI_Expecter) Assign(ctx interface{}, vulnUUID interface{}, componentUUID interface{}) *VulnerabilityAPI_Assign_Call {
+	return &VulnerabilityAPI_Assign_Call{Call: _e.mock.On("Assign", ctx, vulnUUID, componentUUID)}
+}
+
+func (_c *VulnerabilityAPI_Assign_Call) Run(run func(ctx context.Context, vulnUUID uuid.UUID, componentUUID uuid.UUID)) *VulnerabilityAPI_Assign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Assign_Call) Return(_a0 error) *VulnerabilityAPI_Assign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Assign_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *VulnerabilityAPI_Assign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignBySourceAndVulnID provides a mock function with given fields: ctx, source, vulnID, componentUUID
+func (_m *VulnerabilityAPI) AssignBySourceAndVulnID(ctx context.Context, source string, vulnID string, componentUUID uuid.UUID) error {
+	ret := _m.Called(ctx, source, vulnID, componentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignBySourceAndVulnID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, source, vulnID, componentUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VulnerabilityAPI_AssignBySourceAndVulnID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignBySourceAndVulnID'
+type VulnerabilityAPI_AssignBySourceAndVulnID_Call struct {
+	*mock.Call
+}
+
+// AssignBySourceAndVulnID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source string
+//   - vulnID string
+//   - componentUUID uuid.UUID
+func (_e *VulnerabilityAPI_Expecter) AssignBySourceAndVulnID(ctx interface{}, source interface{}, vulnID interface{}, componentUUID interface{}) *VulnerabilityAPI_AssignBySourceAndVulnID_Call {
+	return &VulnerabilityAPI_AssignBySourceAndVulnID_Call{Call: _e.mock.On("AssignBySourceAndVulnID", ctx, source, vulnID, componentUUID)}
+}
+
+func (_c *VulnerabilityAPI_AssignBySourceAndVulnID_Call) Run(run func(ctx context.Context, source string, vulnID string, componentUUID uuid.UUID)) *VulnerabilityAPI_AssignBySourceAndVulnID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_AssignBySourceAndVulnID_Call) Return(_a0 error) *VulnerabilityAPI_AssignBySourceAndVulnID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_AssignBySourceAndVulnID_Call) RunAndReturn(run func(context.Context, string, string, uuid.UUID) error) *VulnerabilityAPI_AssignBySourceAndVulnID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, vulnerability
+func (_m *VulnerabilityAPI) Create(ctx context.Context, vulnerability dtrack.Vulnerability) (dtrack.Vulnerability, error) {
+	ret := _m.Called(ctx, vulnerability)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 dtrack.Vulnerability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Vulnerability) (dtrack.Vulnerability, error)); ok {
+		return rf(ctx, vulnerability)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Vulnerability) dtrack.Vulnerability); ok {
+		r0 = rf(ctx, vulnerability)
+	} else {
+		r0 = ret.Get(0).(dtrack.Vulnerability)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Vulnerability) error); ok {
+		r1 = rf(ctx, vulnerability)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VulnerabilityAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type VulnerabilityAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - vulnerability dtrack.Vulnerability
+func (_e *VulnerabilityAPI_Expecter) Create(ctx interface{}, vulnerability interface{}) *VulnerabilityAPI_Create_Call {
+	return &VulnerabilityAPI_Create_Call{Call: _e.mock.On("Create", ctx, vulnerability)}
+}
+
+func (_c *VulnerabilityAPI_Create_Call) Run(run func(ctx context.Context, vulnerability dtrack.Vulnerability)) *VulnerabilityAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Vulnerability))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Create_Call) Return(_a0 dtrack.Vulnerability, _a1 error) *VulnerabilityAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Create_Call) RunAndReturn(run func(context.Context, dtrack.Vulnerability) (dtrack.Vulnerability, error)) *VulnerabilityAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, vulnUUID
+func (_m *VulnerabilityAPI) Delete(ctx context.Context, vulnUUID uuid.UUID) error {
+	ret := _m.Called(ctx, vulnUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, vulnUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VulnerabilityAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type VulnerabilityAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - vulnUUID uuid.UUID
+func (_e *VulnerabilityAPI_Expecter) Delete(ctx interface{}, vulnUUID interface{}) *VulnerabilityAPI_Delete_Call {
+	return &VulnerabilityAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, vulnUUID)}
+}
+
+func (_c *VulnerabilityAPI_Delete_Call) Run(run func(ctx context.Context, vulnUUID uuid.UUID)) *VulnerabilityAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Delete_Call) Return(_a0 error) *VulnerabilityAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Delete_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *VulnerabilityAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateInternalID provides a mock function with given fields: ctx
+func (_m *VulnerabilityAPI) GenerateInternalID(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateInternalID")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VulnerabilityAPI_GenerateInternalID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateInternalID'
+type VulnerabilityAPI_GenerateInternalID_Call struct {
+	*mock.Call
+}
+
+// GenerateInternalID is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VulnerabilityAPI_Expecter) GenerateInternalID(ctx interface{}) *VulnerabilityAPI_GenerateInternalID_Call {
+	return &VulnerabilityAPI_GenerateInternalID_Call{Call: _e.mock.On("GenerateInternalID", ctx)}
+}
+
+func (_c *VulnerabilityAPI_GenerateInternalID_Call) Run(run func(ctx context.Context)) *VulnerabilityAPI_GenerateInternalID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GenerateInternalID_Call) Return(_a0 string, _a1 error) *VulnerabilityAPI_GenerateInternalID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GenerateInternalID_Call) RunAndReturn(run func(context.Context) (string, error)) *VulnerabilityAPI_GenerateInternalID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, vulnUUID
+func (_m *VulnerabilityAPI) Get(ctx context.Context, vulnUUID uuid.UUID) (dtrack.Vulnerability, error) {
+	ret := _m.Called(ctx, vulnUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 dtrack.Vulnerability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (dtrack.Vulnerability, error)); ok {
+		return rf(ctx, vulnUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) dtrack.Vulnerability); ok {
+		r0 = rf(ctx, vulnUUID)
+	} else {
+		r0 = ret.Get(0).(dtrack.Vulnerability)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, vulnUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VulnerabilityAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type VulnerabilityAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - vulnUUID uuid.UUID
+func (_e *VulnerabilityAPI_Expecter) Get(ctx interface{}, vulnUUID interface{}) *VulnerabilityAPI_Get_Call {
+	return &VulnerabilityAPI_Get_Call{Call: _e.mock.On("Get", ctx, vulnUUID)}
+}
+
+func (_c *VulnerabilityAPI_Get_Call) Run(run func(ctx context.Context, vulnUUID uuid.UUID)) *VulnerabilityAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Get_Call) Return(_a0 dtrack.Vulnerability, _a1 error) *VulnerabilityAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Get_Call) RunAndReturn(run func(context.Context, uuid.UUID) (dtrack.Vulnerability, error)) *VulnerabilityAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAffectedComponents provides a mock function with given fields: ctx, source, vulnID
+func (_m *VulnerabilityAPI) GetAffectedComponents(ctx context.Context, source string, vulnID string) ([]dtrack.AffectedComponent, error) {
+	ret := _m.Called(ctx, source, vulnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAffectedComponents")
+	}
+
+	var r0 []dtrack.AffectedComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]dtrack.AffectedComponent, error)); ok {
+		return rf(ctx, source, vulnID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []dtrack.AffectedComponent); ok {
+		r0 = rf(ctx, source, vulnID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.AffectedComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, source, vulnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VulnerabilityAPI_GetAffectedComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAffectedComponents'
+type VulnerabilityAPI_GetAffectedComponents_Call struct {
+	*mock.Call
+}
+
+// GetAffectedComponents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source string
+//   - vulnID string
+func (_e *VulnerabilityAPI_Expecter) GetAffectedComponents(ctx interface{}, source interface{}, vulnID interface{}) *VulnerabilityAPI_GetAffectedComponents_Call {
+	return &VulnerabilityAPI_GetAffectedComponents_Call{Call: _e.mock.On("GetAffectedComponents", ctx, source, vulnID)}
+}
+
+func (_c *VulnerabilityAPI_GetAffectedComponents_Call) Run(run func(ctx context.Context, source string, vulnID string)) *VulnerabilityAPI_GetAffectedComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GetAffectedComponents_Call) Return(_a0 []dtrack.AffectedComponent, _a1 error) *VulnerabilityAPI_GetAffectedComponents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GetAffectedComponents_Call) RunAndReturn(run func(context.Context, string, string) ([]dtrack.AffectedComponent, error)) *VulnerabilityAPI_GetAffectedComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAliases provides a mock function with given fields: ctx, source, vulnID
+func (_m *VulnerabilityAPI) GetAliases(ctx context.Context, source string, vulnID string) ([]dtrack.VulnerabilityAlias, error) {
+	ret := _m.Called(ctx, source, vulnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAliases")
+	}
+
+	var r0 []dtrack.VulnerabilityAlias
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]dtrack.VulnerabilityAlias, error)); ok {
+		return rf(ctx, source, vulnID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []dtrack.VulnerabilityAlias); ok {
+		r0 = rf(ctx, source, vulnID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dtrack.VulnerabilityAlias)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, source, vulnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VulnerabilityAPI_GetAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAliases'
+type VulnerabilityAPI_GetAliases_Call struct {
+	*mock.Call
+}
+
+// GetAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source string
+//   - vulnID string
+func (_e *VulnerabilityAPI_Expecter) GetAliases(ctx interface{}, source interface{}, vulnID interface{}) *VulnerabilityAPI_GetAliases_Call {
+	return &VulnerabilityAPI_GetAliases_Call{Call: _e.mock.On("GetAliases", ctx, source, vulnID)}
+}
+
+func (_c *VulnerabilityAPI_GetAliases_Call) Run(run func(ctx context.Context, source string, vulnID string)) *VulnerabilityAPI_GetAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GetAliases_Call) Return(_a0 []dtrack.VulnerabilityAlias, _a1 error) *VulnerabilityAPI_GetAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GetAliases_Call) RunAndReturn(run func(context.Context, string, string) ([]dtrack.VulnerabilityAlias, error)) *VulnerabilityAPI_GetAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllForComponent provides a mock function with given fields: ctx, componentUUID, suppressed, po
+func (_m *VulnerabilityAPI) GetAllForComponent(ctx context.Context, componentUUID uuid.UUID, suppressed bool, po dtrack.PageOptions) (dtrack.Page[dtrack.Vulnerability], error) {
+	ret := _m.Called(ctx, componentUUID, suppressed, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllForComponent")
+	}
+
+	var r0 dtrack.Page[dtrack.Vulnerability]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) (dtrack.Page[dtrack.Vulnerability], error)); ok {
+		return rf(ctx, componentUUID, suppressed, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) dtrack.Page[dtrack.Vulnerability]); ok {
+		r0 = rf(ctx, componentUUID, suppressed, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Vulnerability])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, componentUUID, suppressed, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VulnerabilityAPI_GetAllForComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllForComponent'
+type VulnerabilityAPI_GetAllForComponent_Call struct {
+	*mock.Call
+}
+
+// GetAllForComponent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - componentUUID uuid.UUID
+//   - suppressed bool
+//   - po dtrack.PageOptions
+func (_e *VulnerabilityAPI_Expecter) GetAllForComponent(ctx interface{}, componentUUID interface{}, suppressed interface{}, po interface{}) *VulnerabilityAPI_GetAllForComponent_Call {
+	return &VulnerabilityAPI_GetAllForComponent_Call{Call: _e.mock.On("GetAllForComponent", ctx, componentUUID, suppressed, po)}
+}
+
+func (_c *VulnerabilityAPI_GetAllForComponent_Call) Run(run func(ctx context.Context, componentUUID uuid.UUID, suppressed bool, po dtrack.PageOptions)) *VulnerabilityAPI_GetAllForComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GetAllForComponent_Call) Return(_a0 dtrack.Page[dtrack.Vulnerability], _a1 error) *VulnerabilityAPI_GetAllForComponent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GetAllForComponent_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, dtrack.PageOptions) (dtrack.Page[dtrack.Vulnerability], error)) *VulnerabilityAPI_GetAllForComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllForProject provides a mock function with given fields: ctx, projectUUID, suppressed, po
+func (_m *VulnerabilityAPI) GetAllForProject(ctx context.Context, projectUUID uuid.UUID, suppressed bool, po dtrack.PageOptions) (dtrack.Page[dtrack.Vulnerability], error) {
+	ret := _m.Called(ctx, projectUUID, suppressed, po)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllForProject")
+	}
+
+	var r0 dtrack.Page[dtrack.Vulnerability]
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) (dtrack.Page[dtrack.Vulnerability], error)); ok {
+		return rf(ctx, projectUUID, suppressed, po)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) dtrack.Page[dtrack.Vulnerability]); ok {
+		r0 = rf(ctx, projectUUID, suppressed, po)
+	} else {
+		r0 = ret.Get(0).(dtrack.Page[dtrack.Vulnerability])
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, bool, dtrack.PageOptions) error); ok {
+		r1 = rf(ctx, projectUUID, suppressed, po)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VulnerabilityAPI_GetAllForProject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllForProject'
+type VulnerabilityAPI_GetAllForProject_Call struct {
+	*mock.Call
+}
+
+// GetAllForProject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectUUID uuid.UUID
+//   - suppressed bool
+//   - po dtrack.PageOptions
+func (_e *VulnerabilityAPI_Expecter) GetAllForProject(ctx interface{}, projectUUID interface{}, suppressed interface{}, po interface{}) *VulnerabilityAPI_GetAllForProject_Call {
+	return &VulnerabilityAPI_GetAllForProject_Call{Call: _e.mock.On("GetAllForProject", ctx, projectUUID, suppressed, po)}
+}
+
+func (_c *VulnerabilityAPI_GetAllForProject_Call) Run(run func(ctx context.Context, projectUUID uuid.UUID, suppressed bool, po dtrack.PageOptions)) *VulnerabilityAPI_GetAllForProject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(bool), args[3].(dtrack.PageOptions))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GetAllForProject_Call) Return(_a0 dtrack.Page[dtrack.Vulnerability], _a1 error) *VulnerabilityAPI_GetAllForProject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GetAllForProject_Call) RunAndReturn(run func(context.Context, uuid.UUID, bool, dtrack.PageOptions) (dtrack.Page[dtrack.Vulnerability], error)) *VulnerabilityAPI_GetAllForProject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBySourceAndVulnID provides a mock function with given fields: ctx, source, vulnID
+func (_m *VulnerabilityAPI) GetBySourceAndVulnID(ctx context.Context, source string, vulnID string) (dtrack.Vulnerability, error) {
+	ret := _m.Called(ctx, source, vulnID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySourceAndVulnID")
+	}
+
+	var r0 dtrack.Vulnerability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (dtrack.Vulnerability, error)); ok {
+		return rf(ctx, source, vulnID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) dtrack.Vulnerability); ok {
+		r0 = rf(ctx, source, vulnID)
+	} else {
+		r0 = ret.Get(0).(dtrack.Vulnerability)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, source, vulnID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VulnerabilityAPI_GetBySourceAndVulnID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBySourceAndVulnID'
+type VulnerabilityAPI_GetBySourceAndVulnID_Call struct {
+	*mock.Call
+}
+
+// GetBySourceAndVulnID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source string
+//   - vulnID string
+func (_e *VulnerabilityAPI_Expecter) GetBySourceAndVulnID(ctx interface{}, source interface{}, vulnID interface{}) *VulnerabilityAPI_GetBySourceAndVulnID_Call {
+	return &VulnerabilityAPI_GetBySourceAndVulnID_Call{Call: _e.mock.On("GetBySourceAndVulnID", ctx, source, vulnID)}
+}
+
+func (_c *VulnerabilityAPI_GetBySourceAndVulnID_Call) Run(run func(ctx context.Context, source string, vulnID string)) *VulnerabilityAPI_GetBySourceAndVulnID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GetBySourceAndVulnID_Call) Return(_a0 dtrack.Vulnerability, _a1 error) *VulnerabilityAPI_GetBySourceAndVulnID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_GetBySourceAndVulnID_Call) RunAndReturn(run func(context.Context, string, string) (dtrack.Vulnerability, error)) *VulnerabilityAPI_GetBySourceAndVulnID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unassign provides a mock function with given fields: ctx, vulnUUID, componentUUID
+func (_m *VulnerabilityAPI) Unassign(ctx context.Context, vulnUUID uuid.UUID, componentUUID uuid.UUID) error {
+	ret := _m.Called(ctx, vulnUUID, componentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unassign")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, vulnUUID, componentUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VulnerabilityAPI_Unassign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unassign'
+type VulnerabilityAPI_Unassign_Call struct {
+	*mock.Call
+}
+
+// Unassign is a helper method to define mock.On call
+//   - ctx context.Context
+//   - vulnUUID uuid.UUID
+//   - componentUUID uuid.UUID
+func (_e *VulnerabilityAPI_Expecter) Unassign(ctx interface{}, vulnUUID interface{}, componentUUID interface{}) *VulnerabilityAPI_Unassign_Call {
+	return &VulnerabilityAPI_Unassign_Call{Call: _e.mock.On("Unassign", ctx, vulnUUID, componentUUID)}
+}
+
+func (_c *VulnerabilityAPI_Unassign_Call) Run(run func(ctx context.Context, vulnUUID uuid.UUID, componentUUID uuid.UUID)) *VulnerabilityAPI_Unassign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Unassign_Call) Return(_a0 error) *VulnerabilityAPI_Unassign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Unassign_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *VulnerabilityAPI_Unassign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnassignBySourceAndVulnID provides a mock function with given fields: ctx, source, vulnID, componentUUID
+func (_m *VulnerabilityAPI) UnassignBySourceAndVulnID(ctx context.Context, source string, vulnID string, componentUUID uuid.UUID) error {
+	ret := _m.Called(ctx, source, vulnID, componentUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnassignBySourceAndVulnID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uuid.UUID) error); ok {
+		r0 = rf(ctx, source, vulnID, componentUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VulnerabilityAPI_UnassignBySourceAndVulnID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnassignBySourceAndVulnID'
+type VulnerabilityAPI_UnassignBySourceAndVulnID_Call struct {
+	*mock.Call
+}
+
+// UnassignBySourceAndVulnID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source string
+//   - vulnID string
+//   - componentUUID uuid.UUID
+func (_e *VulnerabilityAPI_Expecter) UnassignBySourceAndVulnID(ctx interface{}, source interface{}, vulnID interface{}, componentUUID interface{}) *VulnerabilityAPI_UnassignBySourceAndVulnID_Call {
+	return &VulnerabilityAPI_UnassignBySourceAndVulnID_Call{Call: _e.mock.On("UnassignBySourceAndVulnID", ctx, source, vulnID, componentUUID)}
+}
+
+func (_c *VulnerabilityAPI_UnassignBySourceAndVulnID_Call) Run(run func(ctx context.Context, source string, vulnID string, componentUUID uuid.UUID)) *VulnerabilityAPI_UnassignBySourceAndVulnID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_UnassignBySourceAndVulnID_Call) Return(_a0 error) *VulnerabilityAPI_UnassignBySourceAndVulnID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_UnassignBySourceAndVulnID_Call) RunAndReturn(run func(context.Context, string, string, uuid.UUID) error) *VulnerabilityAPI_UnassignBySourceAndVulnID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, vulnerability
+func (_m *VulnerabilityAPI) Update(ctx context.Context, vulnerability dtrack.Vulnerability) (dtrack.Vulnerability, error) {
+	ret := _m.Called(ctx, vulnerability)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 dtrack.Vulnerability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Vulnerability) (dtrack.Vulnerability, error)); ok {
+		return rf(ctx, vulnerability)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dtrack.Vulnerability) dtrack.Vulnerability); ok {
+		r0 = rf(ctx, vulnerability)
+	} else {
+		r0 = ret.Get(0).(dtrack.Vulnerability)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dtrack.Vulnerability) error); ok {
+		r1 = rf(ctx, vulnerability)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VulnerabilityAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type VulnerabilityAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - vulnerability dtrack.Vulnerability
+func (_e *VulnerabilityAPI_Expecter) Update(ctx interface{}, vulnerability interface{}) *VulnerabilityAPI_Update_Call {
+	return &VulnerabilityAPI_Update_Call{Call: _e.mock.On("Update", ctx, vulnerability)}
+}
+
+func (_c *VulnerabilityAPI_Update_Call) Run(run func(ctx context.Context, vulnerability dtrack.Vulnerability)) *VulnerabilityAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dtrack.Vulnerability))
+	})
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Update_Call) Return(_a0 dtrack.Vulnerability, _a1 error) *VulnerabilityAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VulnerabilityAPI_Update_Call) RunAndReturn(run func(context.Context, dtrack.Vulnerability) (dtrack.Vulnerability, error)) *VulnerabilityAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewVulnerabilityAPI creates a new instance of VulnerabilityAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVulnerabilityAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VulnerabilityAPI {
+	mock := &VulnerabilityAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
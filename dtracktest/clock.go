@@ -0,0 +1,72 @@
+package dtracktest
+
+import (
+	"sync"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// FakeClock is a dtrack.Clock for use with dtrack.WithClock, whose timers fire immediately,
+// advancing the clock by their duration. Retries, rate limiting and polling thus complete
+// without delay, while the time observed by the client progresses as if they had waited.
+type FakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+// NewFakeClock creates a FakeClock that starts at now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.now
+}
+
+func (c *FakeClock) NewTimer(d time.Duration) dtrack.Timer {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if d > 0 {
+		c.now = c.now.Add(d)
+	}
+	c.sleeps = append(c.sleeps, d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return fakeTimer{ch: ch}
+}
+
+// Advance moves the clock forward by d.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+// Sleeps returns the durations of all timers created so far, in order.
+func (c *FakeClock) Sleeps() []time.Duration {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]time.Duration(nil), c.sleeps...)
+}
+
+type fakeTimer struct {
+	ch chan time.Time
+}
+
+func (t fakeTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+// Stop reports false, as the timer has always fired already.
+func (t fakeTimer) Stop() bool {
+	return false
+}
@@ -0,0 +1,61 @@
+package dtracktest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeClock(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	client, err := server.NewClient(dtrack.WithClock(clock), dtrack.WithRateLimit(1, 1))
+	require.NoError(t, err)
+
+	// Fetching the version consumed the only token, so each request waits for a second.
+	for i := 0; i < 3; i++ {
+		_, err = client.Project.GetAll(context.Background(), dtrack.PageOptions{})
+		require.NoError(t, err)
+	}
+	require.Len(t, clock.Sleeps(), 3)
+	require.Equal(t, start.Add(3*time.Second), clock.Now())
+
+	start = clock.Now()
+	clock.Advance(time.Minute)
+	require.Equal(t, start.Add(time.Minute), clock.Now())
+
+	timer := clock.NewTimer(time.Hour)
+	require.Equal(t, start.Add(time.Hour+time.Minute), <-timer.C())
+	require.Equal(t, []time.Duration{time.Second, time.Second, time.Second, time.Hour}, clock.Sleeps())
+}
+
+func TestFakeClock_CanceledRateLimitedRequests(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	client, err := server.NewClient(dtrack.WithClock(clock), dtrack.WithRateLimit(1, 1))
+	require.NoError(t, err)
+
+	// Requests that are canceled while waiting for a token must not delay subsequent ones.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 10; i++ {
+		_, err = client.Project.GetAll(ctx, dtrack.PageOptions{})
+		require.ErrorIs(t, err, context.Canceled)
+	}
+
+	_, err = client.Project.GetAll(context.Background(), dtrack.PageOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{time.Second}, clock.Sleeps())
+	require.Equal(t, start.Add(time.Second), clock.Now())
+}
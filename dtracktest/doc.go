@@ -0,0 +1,19 @@
+// Package dtracktest provides a fake Dependency-Track server for hermetic tests.
+//
+// The server keeps its state in memory, and implements a subset of the API
+// that covers common workflows: managing projects, uploading BOMs, and fetching findings.
+//
+//	server := dtracktest.NewServer()
+//	defer server.Close()
+//
+//	project := server.AddProject(dtrack.Project{Name: "acme-app", Version: "1.0.0"})
+//	server.AddFindings(project.UUID, dtrack.Finding{...})
+//
+//	client, err := server.NewClient()
+//	// Run code under test against client, and inspect server.BOM(project.UUID) afterwards.
+//
+// Requests to endpoints that are not implemented are answered with 501 Not Implemented.
+//
+// For endpoints the fake server does not cover, or to test against the behavior of a specific
+// server version, Recorder records interactions with a real server, and replays them later.
+package dtracktest
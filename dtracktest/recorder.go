@@ -0,0 +1,341 @@
+package dtracktest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// RecorderMode determines whether a Recorder records or replays interactions.
+type RecorderMode int
+
+const (
+	// ModeReplay replays previously recorded interactions, without sending any requests.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests, and records the interactions.
+	ModeRecord
+)
+
+// Interaction is a recorded request and its response.
+// Request headers are never recorded, as they usually contain credentials.
+// For requests that exchange credentials, like logging in or generating API keys,
+// passwords, tokens and keys are redacted from request and response bodies.
+// Passwords and keys are redacted from the JSON bodies of all other requests and responses,
+// e.g. the API keys of teams listed by servers before 4.13. API keys in request URIs are
+// redacted as well.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URI    string `json:"uri"` // Path and query
+	Body   string `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"status"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type fixture struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// RecorderOptions configures a Recorder.
+type RecorderOptions struct {
+	// Transport is used to send requests in ModeRecord, defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// Sanitize is invoked for every interaction before it is recorded, after credentials
+	// have been redacted, and may be used to remove further sensitive data from it.
+	Sanitize func(*Interaction)
+}
+
+// Recorder is an http.RoundTripper that records interactions with a Dependency-Track
+// server to a fixture file, and replays them in later test runs.
+//
+// In ModeReplay, requests are matched against recorded interactions by their method and URI.
+// Interactions are replayed in the order they were recorded, each at most once.
+//
+// Recorders are meant to be the innermost transport of a client, so that credentials
+// added by the client's authentication options are not recorded:
+//
+//	recorder, err := dtracktest.NewRecorder("testdata/fixture.json", dtracktest.ModeReplay, dtracktest.RecorderOptions{})
+//	client, err := dtrack.NewClient(url, dtrack.WithHttpClient(&http.Client{Transport: recorder}), dtrack.WithAPIKey(apiKey))
+//	// ...
+//	err = recorder.Save() // In ModeRecord
+type Recorder struct {
+	path string
+	mode RecorderMode
+	opts RecorderOptions
+
+	mutex        sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// NewRecorder creates a Recorder for the fixture file at path.
+// In ModeReplay, the fixture file is loaded immediately.
+func NewRecorder(path string, mode RecorderMode, opts RecorderOptions) (*Recorder, error) {
+	if opts.Transport == nil {
+		opts.Transport = http.DefaultTransport
+	}
+
+	r := &Recorder{path: path, mode: mode, opts: opts}
+
+	if mode == ModeReplay {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read fixture: %w", err)
+		}
+
+		var f fixture
+		if err = json.Unmarshal(content, &f); err != nil {
+			return nil, fmt.Errorf("failed to decode fixture: %w", err)
+		}
+
+		r.interactions = f.Interactions
+		r.replayed = make([]bool, len(f.Interactions))
+	}
+
+	return r, nil
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ModeReplay {
+		return r.replay(req)
+	}
+
+	return r.record(req)
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, interaction := range r.interactions {
+		if r.replayed[i] || interaction.Request.Method != req.Method || interaction.Request.URI != redactURI(req.URL.RequestURI()) {
+			continue
+		}
+		r.replayed[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewBufferString(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded interaction for %s %s", req.Method, req.URL.RequestURI())
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URI:    redactURI(req.URL.RequestURI()),
+		},
+	}
+
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			content, _ := io.ReadAll(body)
+			interaction.Request.Body = string(content)
+		}
+	}
+
+	res, err := r.opts.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(content))
+
+	interaction.Response = RecordedResponse{
+		StatusCode: res.StatusCode,
+		Header:     res.Header.Clone(),
+		Body:       string(content),
+	}
+	interaction.Response.Header.Del("Date")
+	interaction.Response.Header.Del("Set-Cookie")
+
+	if isCredentialExchange(req) {
+		interaction.Request.Body = redactBody(interaction.Request.Body, req.Header.Get("Content-Type"))
+		interaction.Response.Body = redactBody(interaction.Response.Body, res.Header.Get("Content-Type"))
+	} else {
+		interaction.Request.Body = redactJSONBody(interaction.Request.Body, req.Header.Get("Content-Type"), credentialFields)
+		interaction.Response.Body = redactJSONBody(interaction.Response.Body, res.Header.Get("Content-Type"), credentialFields)
+	}
+
+	if r.opts.Sanitize != nil {
+		r.opts.Sanitize(&interaction)
+	}
+
+	r.mutex.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.mutex.Unlock()
+
+	return res, nil
+}
+
+// Save writes the recorded interactions to the fixture file. It is a no-op in ModeReplay.
+func (r *Recorder) Save() error {
+	if r.mode == ModeReplay {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	content, err := json.MarshalIndent(fixture{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, content, 0o644)
+}
+
+const redacted = "REDACTED"
+
+var (
+	credentialPathRegex = regexp.MustCompile(`/api/v1/(user/login|user/oidc/login|user/forceChangePassword|team/[^/]+/key|team/key/[^/]+)$`)
+	apiKeyURIRegex      = regexp.MustCompile(`(/api/v1/team/key/)[^/?]+`)
+)
+
+// sensitiveFields are the names of form and JSON fields that hold credentials.
+var sensitiveFields = map[string]bool{
+	"password":        true,
+	"newPassword":     true,
+	"confirmPassword": true,
+	"idToken":         true,
+	"accessToken":     true,
+	"token":           true,
+	"key":             true,
+}
+
+// credentialFields are the names of JSON fields that hold credentials in bodies of any endpoint,
+// e.g. the API keys of teams. Unlike sensitiveFields, it doesn't include token, which elsewhere
+// holds the tokens of events, e.g. of BOM uploads, that subsequent requests refer to.
+var credentialFields = map[string]bool{
+	"password":        true,
+	"newPassword":     true,
+	"confirmPassword": true,
+	"idToken":         true,
+	"accessToken":     true,
+	"key":             true,
+}
+
+// isCredentialExchange reports whether the bodies of req and its response contain credentials.
+func isCredentialExchange(req *http.Request) bool {
+	return (req.Method == http.MethodPost || req.Method == http.MethodPut) && credentialPathRegex.MatchString(req.URL.Path)
+}
+
+func redactURI(uri string) string {
+	return apiKeyURIRegex.ReplaceAllString(uri, "${1}"+redacted)
+}
+
+// redactBody redacts sensitive fields of JSON and form bodies.
+// Bodies of any other type, e.g. tokens returned as plain text, are redacted entirely.
+func redactBody(body, contentType string) string {
+	if body == "" {
+		return body
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "application/json":
+		var v interface{}
+		if err := json.Unmarshal([]byte(body), &v); err == nil {
+			if _, isString := v.(string); !isString {
+				redactJSON(v, sensitiveFields)
+				content, _ := json.Marshal(v)
+				return string(content)
+			}
+		}
+	case "application/x-www-form-urlencoded":
+		if values, err := url.ParseQuery(body); err == nil {
+			for name := range values {
+				if sensitiveFields[name] {
+					values.Set(name, redacted)
+				}
+			}
+			return values.Encode()
+		}
+	}
+
+	return redacted
+}
+
+// redactJSONBody redacts the given fields of a JSON body. Any other body is returned as is,
+// as are JSON bodies without any of the fields, so that their formatting is retained.
+func redactJSONBody(body, contentType string, fields map[string]bool) string {
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType != "application/json" || body == "" {
+		return body
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil || !redactJSON(v, fields) {
+		return body
+	}
+
+	content, _ := json.Marshal(v)
+	return string(content)
+}
+
+// redactJSON redacts string values of the given fields in v, and reports whether any were redacted.
+func redactJSON(v interface{}, fields map[string]bool) (redactedAny bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for name, value := range v {
+			if _, isString := value.(string); isString && fields[name] {
+				v[name] = redacted
+				redactedAny = true
+			} else if redactJSON(value, fields) {
+				redactedAny = true
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			if redactJSON(v[i], fields) {
+				redactedAny = true
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,142 @@
+package dtracktest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	fixturePath := filepath.Join(t.TempDir(), "testdata", "fixture.json")
+
+	server := NewServer(WithAPIKey("odt_secret"))
+	project := server.AddProject(dtrack.Project{Name: "acme-app", Version: "1.0.0", Description: "odt_leaked"})
+
+	// Record
+	recorder, err := NewRecorder(fixturePath, ModeRecord, RecorderOptions{
+		Sanitize: func(interaction *Interaction) {
+			interaction.Response.Body = strings.ReplaceAll(interaction.Response.Body, "odt_leaked", "REDACTED")
+		},
+	})
+	require.NoError(t, err)
+
+	client, err := dtrack.NewClient(server.URL, dtrack.WithHttpClient(&http.Client{Transport: recorder}), dtrack.WithAPIKey("odt_secret"))
+	require.NoError(t, err)
+
+	recorded, err := client.Project.Lookup(context.Background(), "acme-app", "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, project.UUID, recorded.UUID)
+
+	require.NoError(t, recorder.Save())
+	server.Close()
+
+	fixture, err := os.ReadFile(fixturePath)
+	require.NoError(t, err)
+	require.NotContains(t, string(fixture), "odt_secret")
+	require.NotContains(t, string(fixture), "odt_leaked")
+
+	// Replay
+	recorder, err = NewRecorder(fixturePath, ModeReplay, RecorderOptions{})
+	require.NoError(t, err)
+
+	client, err = dtrack.NewClient(server.URL, dtrack.WithHttpClient(&http.Client{Transport: recorder}), dtrack.WithAPIKey("odt_secret"))
+	require.NoError(t, err)
+
+	replayed, err := client.Project.Lookup(context.Background(), "acme-app", "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, project.UUID, replayed.UUID)
+	require.Equal(t, "REDACTED", replayed.Description)
+
+	// Interactions are replayed at most once.
+	_, err = client.Project.Lookup(context.Background(), "acme-app", "1.0.0")
+	require.ErrorContains(t, err, "no recorded interaction for GET /api/v1/project/lookup?name=acme-app&version=1.0.0")
+}
+
+func TestRecorder_RedactsCredentials(t *testing.T) {
+	fixturePath := filepath.Join(t.TempDir(), "fixture.json")
+	teamUUID := uuid.New()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/version":
+			writeJSON(w, http.StatusOK, dtrack.About{Version: DefaultVersion})
+		case r.URL.Path == "/api/v1/user/login":
+			if r.FormValue("username") != "admin" || r.FormValue("password") != "hunter2" {
+				writeError(w, http.StatusUnauthorized, "")
+				return
+			}
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("jwt-secret"))
+		case r.Header.Get("Authorization") != "Bearer jwt-secret":
+			writeError(w, http.StatusUnauthorized, "")
+		case r.URL.Path == "/api/v1/team/"+teamUUID.String()+"/key":
+			writeJSON(w, http.StatusCreated, dtrack.APIKey{Key: "odt_generated", MaskedKey: "odt_****"})
+		case r.URL.Path == "/api/v1/team/key/odt_generated":
+			writeJSON(w, http.StatusOK, dtrack.APIKey{Key: "odt_regenerated", MaskedKey: "odt_****"})
+		case r.URL.Path == "/api/v1/team":
+			// Servers before 4.13 return the full API keys of teams.
+			w.Header().Set("X-Total-Count", "1")
+			writeJSON(w, http.StatusOK, []dtrack.Team{{UUID: teamUUID, Name: "CI", APIKeys: []dtrack.APIKey{{Key: "odt_listed", MaskedKey: "odt_****"}}}})
+		default:
+			writeError(w, http.StatusNotFound, "")
+		}
+	}))
+	defer server.Close()
+
+	// Record
+	recorder, err := NewRecorder(fixturePath, ModeRecord, RecorderOptions{})
+	require.NoError(t, err)
+
+	client, err := dtrack.NewClient(server.URL, dtrack.WithHttpClient(&http.Client{Transport: recorder}), dtrack.WithUserCredentials("admin", "hunter2"))
+	require.NoError(t, err)
+
+	apiKey, err := client.Team.GenerateAPIKey(context.Background(), teamUUID)
+	require.NoError(t, err)
+	require.Equal(t, "odt_generated", apiKey.Key)
+
+	apiKey, err = client.Team.RegenerateAPIKey(context.Background(), apiKey.Key)
+	require.NoError(t, err)
+	require.Equal(t, "odt_regenerated", apiKey.Key)
+
+	teams, err := client.Team.GetAll(context.Background(), dtrack.PageOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "odt_listed", teams.Items[0].APIKeys[0].Key)
+
+	require.NoError(t, recorder.Save())
+
+	fixture, err := os.ReadFile(fixturePath)
+	require.NoError(t, err)
+	require.Contains(t, string(fixture), "username=admin")
+	for _, secret := range []string{"hunter2", "jwt-secret", "odt_generated", "odt_regenerated", "odt_listed"} {
+		require.NotContains(t, string(fixture), secret)
+	}
+
+	// Replay
+	recorder, err = NewRecorder(fixturePath, ModeReplay, RecorderOptions{})
+	require.NoError(t, err)
+
+	client, err = dtrack.NewClient(server.URL, dtrack.WithHttpClient(&http.Client{Transport: recorder}), dtrack.WithUserCredentials("admin", "hunter2"))
+	require.NoError(t, err)
+
+	apiKey, err = client.Team.GenerateAPIKey(context.Background(), teamUUID)
+	require.NoError(t, err)
+	require.Equal(t, "REDACTED", apiKey.Key)
+	require.Equal(t, "odt_****", apiKey.MaskedKey)
+
+	_, err = client.Team.RegenerateAPIKey(context.Background(), "odt_generated")
+	require.NoError(t, err)
+
+	teams, err = client.Team.GetAll(context.Background(), dtrack.PageOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "CI", teams.Items[0].Name)
+	require.Equal(t, "REDACTED", teams.Items[0].APIKeys[0].Key)
+	require.Equal(t, "odt_****", teams.Items[0].APIKeys[0].MaskedKey)
+}
@@ -0,0 +1,442 @@
+package dtracktest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+)
+
+// DefaultVersion is the Dependency-Track version reported by the server, unless configured otherwise.
+const DefaultVersion = "4.12.0"
+
+// Server is a fake Dependency-Track server.
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	version string
+	apiKey  string
+
+	mutex    sync.Mutex
+	projects []dtrack.Project // In order of creation
+	boms     map[uuid.UUID][]byte
+	findings map[uuid.UUID][]dtrack.Finding
+	tokens   map[string]struct{}
+}
+
+type Option func(*Server)
+
+// WithVersion configures the Dependency-Track version reported by the server.
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		s.version = version
+	}
+}
+
+// WithAPIKey configures the server to require the given API key.
+// Without it, requests are never rejected due to missing authentication.
+func WithAPIKey(apiKey string) Option {
+	return func(s *Server) {
+		s.apiKey = apiKey
+	}
+}
+
+// NewServer starts a fake Dependency-Track server.
+// The caller should call Close when finished, to shut it down.
+func NewServer(options ...Option) *Server {
+	s := &Server{
+		version:  DefaultVersion,
+		boms:     make(map[uuid.UUID][]byte),
+		findings: make(map[uuid.UUID][]dtrack.Finding),
+		tokens:   make(map[string]struct{}),
+	}
+	for _, option := range options {
+		option(s)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient creates a client for the server.
+// If the server requires an API key, it is configured automatically.
+func (s *Server) NewClient(options ...dtrack.ClientOption) (*dtrack.Client, error) {
+	if s.apiKey != "" {
+		options = append([]dtrack.ClientOption{dtrack.WithAPIKey(s.apiKey)}, options...)
+	}
+
+	return dtrack.NewClient(s.URL, options...)
+}
+
+// AddProject adds project to the server's state, and returns it.
+// A UUID is assigned to the project, unless it already has one.
+func (s *Server) AddProject(project dtrack.Project) dtrack.Project {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if project.UUID == uuid.Nil {
+		project.UUID = uuid.New()
+	}
+	s.projects = append(s.projects, project)
+
+	return project
+}
+
+// Projects returns all projects known to the server.
+func (s *Server) Projects() []dtrack.Project {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return append([]dtrack.Project(nil), s.projects...)
+}
+
+// AddFindings adds findings for the project with the given UUID.
+func (s *Server) AddFindings(projectUUID uuid.UUID, findings ...dtrack.Finding) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.findings[projectUUID] = append(s.findings[projectUUID], findings...)
+}
+
+// BOM returns the BOM that was most recently uploaded for the project with the given UUID.
+func (s *Server) BOM(projectUUID uuid.UUID) ([]byte, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	bom, ok := s.boms[projectUUID]
+	return bom, ok
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/version" {
+		writeJSON(w, http.StatusOK, dtrack.About{Application: "Dependency-Track", Version: s.version})
+		return
+	}
+
+	if s.apiKey != "" && r.Header.Get("X-Api-Key") != s.apiKey {
+		writeError(w, http.StatusUnauthorized, "")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/"), "/")
+
+	switch {
+	case segments[0] == "project":
+		s.serveProject(w, r, segments[1:])
+	case segments[0] == "bom" && len(segments) == 1:
+		s.serveBOMUpload(w, r)
+	case (segments[0] == "bom" || segments[0] == "event") && len(segments) == 3 && segments[1] == "token" && r.Method == http.MethodGet:
+		s.serveToken(w, segments[2])
+	case segments[0] == "finding" && len(segments) == 3 && segments[1] == "project" && r.Method == http.MethodGet:
+		s.serveFindings(w, r, segments[2])
+	default:
+		writeError(w, http.StatusNotImplemented, "")
+	}
+}
+
+func (s *Server) serveProject(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		var (
+			name            = r.URL.Query().Get("name")
+			searchText      = strings.ToLower(r.URL.Query().Get("searchText"))
+			excludeInactive = r.URL.Query().Get("excludeInactive") == "true"
+			onlyRoot        = r.URL.Query().Get("onlyRoot") == "true"
+			projects        []dtrack.Project
+		)
+		for _, project := range s.projects {
+			if name != "" && project.Name != name {
+				continue
+			}
+			if (excludeInactive && !project.Active) || (onlyRoot && project.ParentRef != nil) {
+				continue
+			}
+			if searchText != "" && !strings.Contains(strings.ToLower(project.Name), searchText) {
+				continue
+			}
+			projects = append(projects, project)
+		}
+		writePage(w, r, projects)
+	case len(segments) == 0 && r.Method == http.MethodPut:
+		var project dtrack.Project
+		if !readJSON(w, r, &project) {
+			return
+		}
+		if _, ok := s.lookupProject(project.Name, project.Version); ok {
+			writeError(w, http.StatusConflict, "A project with the specified name already exists.")
+			return
+		}
+		project.UUID = uuid.New()
+		s.projects = append(s.projects, project)
+		writeJSON(w, http.StatusCreated, project)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		var project dtrack.Project
+		if !readJSON(w, r, &project) {
+			return
+		}
+		i, ok := s.projectIndex(project.UUID)
+		if !ok {
+			writeError(w, http.StatusNotFound, "The UUID of the project could not be found.")
+			return
+		}
+		s.projects[i] = project
+		writeJSON(w, http.StatusOK, project)
+	case len(segments) == 1 && segments[0] == "lookup" && r.Method == http.MethodGet:
+		i, ok := s.lookupProject(r.URL.Query().Get("name"), r.URL.Query().Get("version"))
+		if !ok {
+			writeError(w, http.StatusNotFound, "The project could not be found.")
+			return
+		}
+		writeJSON(w, http.StatusOK, s.projects[i])
+	case len(segments) == 1:
+		projectUUID, err := uuid.Parse(segments[0])
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Invalid UUID")
+			return
+		}
+		i, ok := s.projectIndex(projectUUID)
+		if !ok {
+			writeError(w, http.StatusNotFound, "The project could not be found.")
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, s.projects[i])
+		case http.MethodPatch:
+			// Only top-level fields present in the request are updated.
+			current, _ := json.Marshal(s.projects[i])
+			var merged map[string]json.RawMessage
+			_ = json.Unmarshal(current, &merged)
+			var patch map[string]json.RawMessage
+			if !readJSON(w, r, &patch) {
+				return
+			}
+			for field, value := range patch {
+				merged[field] = value
+			}
+			mergedJSON, _ := json.Marshal(merged)
+			var project dtrack.Project
+			if err := json.Unmarshal(mergedJSON, &project); err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			project.UUID = projectUUID
+			s.projects[i] = project
+			writeJSON(w, http.StatusOK, project)
+		case http.MethodDelete:
+			s.projects = append(s.projects[:i], s.projects[i+1:]...)
+			delete(s.boms, projectUUID)
+			delete(s.findings, projectUUID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "")
+		}
+	default:
+		writeError(w, http.StatusNotImplemented, "")
+	}
+}
+
+// serveBOMUpload handles uploads via the PUT (JSON, base64 encoded BOM), and POST (multipart) endpoints.
+// BOMs are not processed, they are merely stored, and their processing is reported as completed immediately.
+func (s *Server) serveBOMUpload(w http.ResponseWriter, r *http.Request) {
+	var uploadReq dtrack.BOMUploadRequest
+	var bom []byte
+
+	switch r.Method {
+	case http.MethodPut:
+		if !readJSON(w, r, &uploadReq) {
+			return
+		}
+		var err error
+		bom, err = base64.StdEncoding.DecodeString(uploadReq.BOM)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "The BOM is not base64 encoded.")
+			return
+		}
+	case http.MethodPost:
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if projectUUID, err := uuid.Parse(r.FormValue("project")); err == nil {
+			uploadReq.ProjectUUID = &projectUUID
+		}
+		uploadReq.ProjectName = r.FormValue("projectName")
+		uploadReq.ProjectVersion = r.FormValue("projectVersion")
+		uploadReq.AutoCreate, _ = strconv.ParseBool(r.FormValue("autoCreate"))
+		bom = []byte(r.FormValue("bom"))
+		if files := r.MultipartForm.File["bom"]; len(files) > 0 {
+			// BOMs streamed via PostBomStream are uploaded as files.
+			file, err := files[0].Open()
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			bom, err = io.ReadAll(file)
+			_ = file.Close()
+			if err != nil {
+				writeError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "")
+		return
+	}
+
+	var (
+		i  int
+		ok bool
+	)
+	if uploadReq.ProjectUUID != nil {
+		i, ok = s.projectIndex(*uploadReq.ProjectUUID)
+	} else {
+		i, ok = s.lookupProject(uploadReq.ProjectName, uploadReq.ProjectVersion)
+		if !ok && uploadReq.AutoCreate && uploadReq.ProjectName != "" {
+			s.projects = append(s.projects, dtrack.Project{
+				UUID:    uuid.New(),
+				Name:    uploadReq.ProjectName,
+				Version: uploadReq.ProjectVersion,
+				Tags:    uploadReq.ProjectTags,
+				Active:  true,
+			})
+			i, ok = len(s.projects)-1, true
+		}
+	}
+	if !ok {
+		writeError(w, http.StatusNotFound, "The project could not be found.")
+		return
+	}
+
+	s.projects[i].LastBOMImport = int(time.Now().UnixMilli())
+	s.boms[s.projects[i].UUID] = bom
+
+	token := uuid.NewString()
+	s.tokens[token] = struct{}{}
+
+	writeJSON(w, http.StatusOK, map[string]string{"token": token})
+}
+
+func (s *Server) serveToken(w http.ResponseWriter, token string) {
+	if _, ok := s.tokens[token]; !ok {
+		writeError(w, http.StatusNotFound, "")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]bool{"processing": false})
+}
+
+func (s *Server) serveFindings(w http.ResponseWriter, r *http.Request, projectUUIDStr string) {
+	projectUUID, err := uuid.Parse(projectUUIDStr)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid UUID")
+		return
+	}
+	if _, ok := s.projectIndex(projectUUID); !ok {
+		writeError(w, http.StatusNotFound, "The project could not be found.")
+		return
+	}
+
+	suppressed, _ := strconv.ParseBool(r.URL.Query().Get("suppressed"))
+	source := r.URL.Query().Get("source")
+
+	var findings []dtrack.Finding
+	for _, finding := range s.findings[projectUUID] {
+		if finding.Analysis.Suppressed && !suppressed {
+			continue
+		}
+		if source != "" && finding.Vulnerability.Source != source {
+			continue
+		}
+		findings = append(findings, finding)
+	}
+
+	writePage(w, r, findings)
+}
+
+func (s *Server) projectIndex(projectUUID uuid.UUID) (int, bool) {
+	for i := range s.projects {
+		if s.projects[i].UUID == projectUUID {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func (s *Server) lookupProject(name, version string) (int, bool) {
+	for i := range s.projects {
+		if s.projects[i].Name == name && s.projects[i].Version == version {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// writePage writes the page of items requested by r, along with their total count.
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T) {
+	totalCount := len(items)
+
+	query := r.URL.Query()
+	pageSize, _ := strconv.Atoi(query.Get("limit"))
+	if pageSize == 0 {
+		pageSize, _ = strconv.Atoi(query.Get("pageSize"))
+	}
+	if pageSize > 0 {
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		if pageNumber, _ := strconv.Atoi(query.Get("pageNumber")); offset == 0 && pageNumber > 1 {
+			offset = (pageNumber - 1) * pageSize
+		}
+
+		if offset > len(items) {
+			offset = len(items)
+		}
+		end := offset + pageSize
+		if end > len(items) {
+			end = len(items)
+		}
+		items = items[offset:end]
+	}
+
+	if items == nil {
+		items = []T{}
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(totalCount))
+	writeJSON(w, http.StatusOK, items)
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(message))
+}
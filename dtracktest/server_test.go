@@ -0,0 +1,129 @@
+package dtracktest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_Projects(t *testing.T) {
+	server := NewServer(WithAPIKey("odt_test"))
+	defer server.Close()
+
+	existing := server.AddProject(dtrack.Project{Name: "acme-app", Version: "1.0.0"})
+
+	client, err := server.NewClient()
+	require.NoError(t, err)
+
+	created, err := client.Project.Create(context.Background(), dtrack.Project{Name: "acme-lib", Version: "2.0.0"})
+	require.NoError(t, err)
+	require.NotEqual(t, uuid.Nil, created.UUID)
+
+	_, err = client.Project.Create(context.Background(), dtrack.Project{Name: "acme-lib", Version: "2.0.0"})
+	require.ErrorIs(t, err, dtrack.ErrConflict)
+
+	project, err := client.Project.Lookup(context.Background(), "acme-app", "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, existing.UUID, project.UUID)
+
+	project, err = client.Project.Patch(context.Background(), existing.UUID, dtrack.Project{Description: "patched"})
+	require.NoError(t, err)
+	require.Equal(t, "acme-app", project.Name)
+	require.Equal(t, "patched", project.Description)
+
+	page, err := client.Project.GetAll(context.Background(), dtrack.PageOptions{PageNumber: 2, PageSize: 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, page.TotalCount)
+	require.Len(t, page.Items, 1)
+	require.Equal(t, created.UUID, page.Items[0].UUID)
+
+	page, err = client.Project.GetAll(context.Background(), dtrack.PageOptions{Offset: 0, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	require.Equal(t, existing.UUID, page.Items[0].UUID)
+
+	page, err = client.Project.GetAllFiltered(context.Background(), dtrack.PageOptions{}, dtrack.ProjectFilterOptions{SearchText: "LIB"})
+	require.NoError(t, err)
+	require.Equal(t, 1, page.TotalCount)
+	require.Equal(t, created.UUID, page.Items[0].UUID)
+
+	page, err = client.Project.GetAllFiltered(context.Background(), dtrack.PageOptions{}, dtrack.ProjectFilterOptions{ExcludeInactive: true})
+	require.NoError(t, err)
+	require.Equal(t, 0, page.TotalCount)
+
+	require.NoError(t, client.Project.Delete(context.Background(), created.UUID))
+	_, err = client.Project.Get(context.Background(), created.UUID)
+	require.ErrorIs(t, err, dtrack.ErrNotFound)
+	require.Len(t, server.Projects(), 1)
+}
+
+func TestServer_BOMUpload(t *testing.T) {
+	server := NewServer(WithVersion("4.11.0"))
+	defer server.Close()
+
+	client, err := server.NewClient()
+	require.NoError(t, err)
+
+	token, err := client.BOM.UploadJSON(context.Background(), dtrack.BOMUploadRequest{
+		ProjectName:    "acme-app",
+		ProjectVersion: "1.0.0",
+		AutoCreate:     true,
+	}, map[string]string{"bomFormat": "CycloneDX"})
+	require.NoError(t, err)
+	require.NoError(t, client.BOM.WaitForProcessing(context.Background(), token, dtrack.PollingOptions{Interval: time.Millisecond}))
+
+	project, err := client.Project.Lookup(context.Background(), "acme-app", "1.0.0")
+	require.NoError(t, err)
+	require.NotZero(t, project.LastBOMImport)
+
+	bom, ok := server.BOM(project.UUID)
+	require.True(t, ok)
+	require.JSONEq(t, `{"bomFormat":"CycloneDX"}`, string(bom))
+
+	_, err = client.BOM.PostBom(context.Background(), dtrack.BOMUploadRequest{ProjectUUID: &project.UUID, BOM: "<bom/>"})
+	require.NoError(t, err)
+	bom, _ = server.BOM(project.UUID)
+	require.Equal(t, "<bom/>", string(bom))
+
+	_, err = client.BOM.PostBomStream(context.Background(), dtrack.BOMUploadRequest{ProjectUUID: &project.UUID}, strings.NewReader("<bom>streamed</bom>"))
+	require.NoError(t, err)
+	bom, _ = server.BOM(project.UUID)
+	require.Equal(t, "<bom>streamed</bom>", string(bom))
+
+	unknownUUID := uuid.New()
+	_, err = client.BOM.PostBom(context.Background(), dtrack.BOMUploadRequest{ProjectUUID: &unknownUUID, BOM: "<bom/>"})
+	require.True(t, errors.Is(err, dtrack.ErrNotFound))
+}
+
+func TestServer_Findings(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	project := server.AddProject(dtrack.Project{Name: "acme-app", Version: "1.0.0"})
+	server.AddFindings(project.UUID,
+		dtrack.Finding{Vulnerability: dtrack.FindingVulnerability{VulnID: "CVE-1", Source: "NVD"}},
+		dtrack.Finding{Vulnerability: dtrack.FindingVulnerability{VulnID: "GHSA-2", Source: "GITHUB"}},
+		dtrack.Finding{Vulnerability: dtrack.FindingVulnerability{VulnID: "CVE-3", Source: "NVD"}, Analysis: dtrack.FindingAnalysis{Suppressed: true}})
+
+	client, err := server.NewClient()
+	require.NoError(t, err)
+
+	findings, err := client.Finding.GetAll(context.Background(), project.UUID, false, dtrack.PageOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, findings.TotalCount)
+
+	findings, err = client.Finding.GetAll(context.Background(), project.UUID, true, dtrack.PageOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 3, findings.TotalCount)
+
+	findings, err = client.Finding.GetAllBySource(context.Background(), project.UUID, false, "NVD", dtrack.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, findings.Items, 1)
+	require.Equal(t, "CVE-1", findings.Items[0].Vulnerability.VulnID)
+}
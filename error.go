@@ -1,21 +1,79 @@
 package dtrack
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 )
 
+// Sentinel errors for common API error kinds.
+// They can be matched against errors returned by the client using errors.Is,
+// while errors.As with *APIError provides access to the details.
+var (
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+)
+
 type APIError struct {
 	StatusCode int
 	Message    string
+	Method     string // HTTP method of the failed request
+	Path       string // URL path of the failed request
+
+	// ProblemDetails holds the parsed response body, if the server
+	// responded with application/problem+json (since v4.11.0).
+	ProblemDetails *ProblemDetails
+}
+
+// ProblemDetails describes an error as per RFC 9457.
+type ProblemDetails struct {
+	Type     string `json:"type,omitempty"`
+	Status   int    `json:"status,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
 }
 
 func (e APIError) Error() string {
-	if e.Message == "" {
-		return fmt.Sprintf("api error (status: %d)", e.StatusCode)
+	msg := e.Message
+	if pd := e.ProblemDetails; pd != nil && (pd.Title != "" || pd.Detail != "") {
+		switch {
+		case pd.Title == "":
+			msg = pd.Detail
+		case pd.Detail == "":
+			msg = pd.Title
+		default:
+			msg = fmt.Sprintf("%s: %s", pd.Title, pd.Detail)
+		}
+	}
+	if msg == "" {
+		msg = "api error"
 	}
-	return fmt.Sprintf("%s (status: %d)", e.Message, e.StatusCode)
+	if e.Method != "" && e.Path != "" {
+		msg = fmt.Sprintf("%s %s: %s", e.Method, e.Path, msg)
+	}
+	return fmt.Sprintf("%s (status: %d)", msg, e.StatusCode)
+}
+
+// Is reports whether the error matches target, which is one of
+// ErrUnauthorized, ErrForbidden, ErrNotFound or ErrConflict.
+func (e APIError) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	}
+	return false
 }
 
 func checkResponseForError(res *http.Response) error {
@@ -24,10 +82,21 @@ func checkResponseForError(res *http.Response) error {
 	}
 
 	apiErr := &APIError{StatusCode: res.StatusCode}
+	if res.Request != nil {
+		apiErr.Method = res.Request.Method
+		apiErr.Path = res.Request.URL.Path
+	}
 
 	body, err := io.ReadAll(res.Body)
 	if err == nil && body != nil {
 		apiErr.Message = string(body)
+
+		if mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type")); mediaType == "application/problem+json" {
+			var problemDetails ProblemDetails
+			if json.Unmarshal(body, &problemDetails) == nil {
+				apiErr.ProblemDetails = &problemDetails
+			}
+		}
 	}
 
 	return apiErr
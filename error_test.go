@@ -0,0 +1,66 @@
+package dtrack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	for _, tc := range []struct {
+		statusCode int
+		target     error
+	}{
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, ErrConflict},
+	} {
+		t.Run(http.StatusText(tc.statusCode), func(t *testing.T) {
+			client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte("nope"))
+			}))
+
+			projectUUID := uuid.New()
+			_, err := client.Project.Get(context.Background(), projectUUID)
+			require.ErrorIs(t, err, tc.target)
+
+			var apiErr *APIError
+			require.True(t, errors.As(err, &apiErr))
+			require.Equal(t, tc.statusCode, apiErr.StatusCode)
+			require.Equal(t, http.MethodGet, apiErr.Method)
+			require.Equal(t, "/api/v1/project/"+projectUUID.String(), apiErr.Path)
+			require.Equal(t, "nope", apiErr.Message)
+
+			for _, other := range []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrConflict} {
+				if other != tc.target {
+					require.False(t, errors.Is(err, other))
+				}
+			}
+		})
+	}
+}
+
+func TestAPIError_ProblemDetails(t *testing.T) {
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status":400,"title":"Invalid tag","detail":"Tag names must not be empty"}`))
+	}))
+
+	_, err := client.Project.Get(context.Background(), uuid.New())
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, &ProblemDetails{
+		Status: http.StatusBadRequest,
+		Title:  "Invalid tag",
+		Detail: "Tag names must not be empty",
+	}, apiErr.ProblemDetails)
+	require.Contains(t, err.Error(), "Invalid tag: Tag names must not be empty (status: 400)")
+}
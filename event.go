@@ -40,3 +40,12 @@ func (es EventService) IsBeingProcessed(ctx context.Context, token EventToken) (
 
 	return processingResponse.Processing, nil
 }
+
+// WaitForProcessing blocks until the event associated with a given token has been processed.
+// It returns an error when polling fails, the timeout configured in opts is exceeded, or ctx is done.
+func (es EventService) WaitForProcessing(ctx context.Context, token EventToken, opts PollingOptions) error {
+	return poll(ctx, es.client.clock, opts, func(ctx context.Context) (bool, error) {
+		processing, err := es.IsBeingProcessed(ctx, token)
+		return !processing, err
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -31,14 +33,16 @@ type FindingAttribution struct {
 }
 
 type FindingComponent struct {
-	UUID          uuid.UUID `json:"uuid"`
-	Group         string    `json:"group"`
-	Name          string    `json:"name"`
-	Version       string    `json:"version"`
-	CPE           string    `json:"cpe"`
-	PURL          string    `json:"purl"`
-	LatestVersion string    `json:"latestVersion"`
-	Project       uuid.UUID `json:"project"`
+	UUID           uuid.UUID `json:"uuid"`
+	Group          string    `json:"group"`
+	Name           string    `json:"name"`
+	Version        string    `json:"version"`
+	CPE            string    `json:"cpe"`
+	PURL           string    `json:"purl"`
+	LatestVersion  string    `json:"latestVersion"`
+	Project        uuid.UUID `json:"project"`
+	ProjectName    string    `json:"projectName,omitempty"`    // Only populated for portfolio findings
+	ProjectVersion string    `json:"projectVersion,omitempty"` // Only populated for portfolio findings
 }
 
 type FindingVulnerability struct {
@@ -51,7 +55,12 @@ type FindingVulnerability struct {
 	Description                 string               `json:"description"`
 	Recommendation              string               `json:"recommendation"`
 	CVSSV2BaseScore             float64              `json:"cvssV2BaseScore"`
+	CVSSV2Vector                string               `json:"cvssV2Vector"`
 	CVSSV3BaseScore             float64              `json:"cvssV3BaseScore"`
+	CVSSV3Vector                string               `json:"cvssV3Vector"`
+	CVSSV4Score                 float64              `json:"cvssV4Score,omitempty"`  // Since v4.13.0
+	CVSSV4Vector                string               `json:"cvssV4Vector,omitempty"` // Since v4.13.0
+	OWASPRRVector               string               `json:"owaspRRVector"`
 	Severity                    string               `json:"severity"`
 	SeverityRank                int                  `json:"severityRank"`
 	OWASPRRBusinessImpactScore  float64              `json:"owaspBusinessImpactScore"`
@@ -62,6 +71,27 @@ type FindingVulnerability struct {
 	CWEs                        []CWE                `json:"cwes"`
 }
 
+// GroupedFinding describes a vulnerability along with the number of projects it affects.
+type GroupedFinding struct {
+	Vulnerability GroupedFindingVulnerability `json:"vulnerability"`
+	Attribution   GroupedFindingAttribution   `json:"attribution"`
+}
+
+type GroupedFindingVulnerability struct {
+	Source               string  `json:"source"`
+	VulnID               string  `json:"vulnId"`
+	Title                string  `json:"title"`
+	Severity             string  `json:"severity"`
+	CVSSV2BaseScore      float64 `json:"cvssV2BaseScore"`
+	CVSSV3BaseScore      float64 `json:"cvssV3BaseScore"`
+	CWEs                 []CWE   `json:"cwes"`
+	AffectedProjectCount int     `json:"affectedProjectCount"`
+}
+
+type GroupedFindingAttribution struct {
+	AnalyzerIdentity string `json:"analyzerIdentity"`
+}
+
 type FindingService struct {
 	client *Client
 }
@@ -86,6 +116,222 @@ func (f FindingService) GetAll(ctx context.Context, projectUUID uuid.UUID, suppr
 	return
 }
 
+// GetAllBySource fetches all findings for a given project that were reported by a
+// given vulnerability source (e.g. NVD, GITHUB, OSV). If source is empty, findings of all sources are fetched.
+func (f FindingService) GetAllBySource(ctx context.Context, projectUUID uuid.UUID, suppressed bool, source string, po PageOptions) (p Page[Finding], err error) {
+	params := map[string]string{
+		"suppressed": strconv.FormatBool(suppressed),
+	}
+	if source != "" {
+		params["source"] = source
+	}
+
+	req, err := f.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/finding/project/%s", projectUUID), withParams(params), withPageOptions(po))
+	if err != nil {
+		return
+	}
+
+	res, err := f.client.doRequest(req, &p.Items)
+	if err != nil {
+		return
+	}
+
+	p.TotalCount = res.TotalCount
+	return
+}
+
+// PortfolioFindingFilterOptions describes server-side filters for portfolio-wide findings.
+type PortfolioFindingFilterOptions struct {
+	ShowInactive         bool
+	ShowSuppressed       bool
+	Severities           []string // e.g. CRITICAL, HIGH
+	AnalysisStates       []AnalysisState
+	VendorResponses      []AnalysisResponse
+	PublishDateFrom      time.Time
+	PublishDateTo        time.Time
+	AttributedOnDateFrom time.Time
+	AttributedOnDateTo   time.Time
+	TextSearchFields     []string // e.g. VULNERABILITY_ID, VULNERABILITY_TITLE, COMPONENT_NAME
+	TextSearchInput      string
+	CVSSV2From           *float64
+	CVSSV2To             *float64
+	CVSSV3From           *float64
+	CVSSV3To             *float64
+}
+
+func withPortfolioFindingFilterOptions(filterOptions PortfolioFindingFilterOptions) requestOption {
+	return func(req *http.Request) error {
+		query := req.URL.Query()
+		if filterOptions.ShowInactive {
+			query.Set("showInactive", "true")
+		}
+		if filterOptions.ShowSuppressed {
+			query.Set("showSuppressed", "true")
+		}
+		if len(filterOptions.Severities) > 0 {
+			query.Set("severity", strings.Join(filterOptions.Severities, ","))
+		}
+		if len(filterOptions.AnalysisStates) > 0 {
+			states := make([]string, len(filterOptions.AnalysisStates))
+			for i := range filterOptions.AnalysisStates {
+				states[i] = string(filterOptions.AnalysisStates[i])
+			}
+			query.Set("analysisStatus", strings.Join(states, ","))
+		}
+		if len(filterOptions.VendorResponses) > 0 {
+			responses := make([]string, len(filterOptions.VendorResponses))
+			for i := range filterOptions.VendorResponses {
+				responses[i] = string(filterOptions.VendorResponses[i])
+			}
+			query.Set("vendorResponse", strings.Join(responses, ","))
+		}
+		if !filterOptions.PublishDateFrom.IsZero() {
+			query.Set("publishDateFrom", filterOptions.PublishDateFrom.Format("2006-01-02"))
+		}
+		if !filterOptions.PublishDateTo.IsZero() {
+			query.Set("publishDateTo", filterOptions.PublishDateTo.Format("2006-01-02"))
+		}
+		if !filterOptions.AttributedOnDateFrom.IsZero() {
+			query.Set("attributedOnDateFrom", filterOptions.AttributedOnDateFrom.Format("2006-01-02"))
+		}
+		if !filterOptions.AttributedOnDateTo.IsZero() {
+			query.Set("attributedOnDateTo", filterOptions.AttributedOnDateTo.Format("2006-01-02"))
+		}
+		if len(filterOptions.TextSearchFields) > 0 {
+			query.Set("textSearchField", strings.Join(filterOptions.TextSearchFields, ","))
+		}
+		if len(filterOptions.TextSearchInput) > 0 {
+			query.Set("textSearchInput", filterOptions.TextSearchInput)
+		}
+		if filterOptions.CVSSV2From != nil {
+			query.Set("cvssv2From", strconv.FormatFloat(*filterOptions.CVSSV2From, 'f', -1, 64))
+		}
+		if filterOptions.CVSSV2To != nil {
+			query.Set("cvssv2To", strconv.FormatFloat(*filterOptions.CVSSV2To, 'f', -1, 64))
+		}
+		if filterOptions.CVSSV3From != nil {
+			query.Set("cvssv3From", strconv.FormatFloat(*filterOptions.CVSSV3From, 'f', -1, 64))
+		}
+		if filterOptions.CVSSV3To != nil {
+			query.Set("cvssv3To", strconv.FormatFloat(*filterOptions.CVSSV3To, 'f', -1, 64))
+		}
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+// GetAllForPortfolio fetches findings across all projects of the portfolio.
+// This feature is available in Dependency-Track v4.11.0 and newer.
+func (f FindingService) GetAllForPortfolio(ctx context.Context, filterOptions PortfolioFindingFilterOptions, po PageOptions) (p Page[Finding], err error) {
+	err = f.client.assertServerVersionAtLeast("4.11.0")
+	if err != nil {
+		return
+	}
+
+	req, err := f.client.newRequest(ctx, http.MethodGet, "api/v1/finding", withPortfolioFindingFilterOptions(filterOptions), withPageOptions(po))
+	if err != nil {
+		return
+	}
+
+	res, err := f.client.doRequest(req, &p.Items)
+	if err != nil {
+		return
+	}
+
+	p.TotalCount = res.TotalCount
+	return
+}
+
+// GetAllGrouped fetches findings across all projects of the portfolio, grouped by vulnerability.
+// Filters not supported for grouped findings, e.g. AnalysisStates, are ignored by the server.
+// This feature is available in Dependency-Track v4.11.0 and newer.
+func (f FindingService) GetAllGrouped(ctx context.Context, filterOptions PortfolioFindingFilterOptions, po PageOptions) (p Page[GroupedFinding], err error) {
+	err = f.client.assertServerVersionAtLeast("4.11.0")
+	if err != nil {
+		return
+	}
+
+	req, err := f.client.newRequest(ctx, http.MethodGet, "api/v1/finding/grouped", withPortfolioFindingFilterOptions(filterOptions), withPageOptions(po))
+	if err != nil {
+		return
+	}
+
+	res, err := f.client.doRequest(req, &p.Items)
+	if err != nil {
+		return
+	}
+
+	p.TotalCount = res.TotalCount
+	return
+}
+
+// FindingFilter describes criteria findings must meet in order to be considered actionable.
+// A finding matches the filter only if it meets all configured criteria.
+type FindingFilter struct {
+	MinEPSSScore      float64             // Minimum EPSS score, zero disables the check
+	MinEPSSPercentile float64             // Minimum EPSS percentile, zero disables the check
+	KnownExploited    map[string]struct{} // IDs of known exploited vulnerabilities (e.g. from CISA KEV), nil disables the check
+}
+
+// Matches determines whether a given finding meets all criteria of the filter.
+// Known exploited vulnerabilities are matched by their ID, as well as the IDs of their aliases.
+func (ff FindingFilter) Matches(finding Finding) bool {
+	if ff.MinEPSSScore > 0 && finding.Vulnerability.EPSSScore < ff.MinEPSSScore {
+		return false
+	}
+	if ff.MinEPSSPercentile > 0 && finding.Vulnerability.EPSSPercentile < ff.MinEPSSPercentile {
+		return false
+	}
+	if ff.KnownExploited != nil && !ff.isKnownExploited(finding.Vulnerability) {
+		return false
+	}
+
+	return true
+}
+
+func (ff FindingFilter) isKnownExploited(vuln FindingVulnerability) bool {
+	if _, ok := ff.KnownExploited[vuln.VulnID]; ok {
+		return true
+	}
+
+	for _, alias := range vuln.Aliases {
+		for _, id := range alias.IDs() {
+			if _, ok := ff.KnownExploited[id]; ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// FilterFindings returns all findings that match a given filter.
+func FilterFindings(findings []Finding, filter FindingFilter) (filtered []Finding) {
+	for i := range findings {
+		if filter.Matches(findings[i]) {
+			filtered = append(filtered, findings[i])
+		}
+	}
+
+	return
+}
+
+// GetAllFiltered fetches all findings for a given project, and returns only those matching the filter.
+// The server does not support filtering by EPSS or known exploitation, thus filtering is performed client-side.
+func (f FindingService) GetAllFiltered(ctx context.Context, projectUUID uuid.UUID, suppressed bool, filter FindingFilter) (findings []Finding, err error) {
+	err = ForEach(
+		func(po PageOptions) (Page[Finding], error) { return f.GetAll(ctx, projectUUID, suppressed, po) },
+		func(item Finding) error {
+			if filter.Matches(item) {
+				findings = append(findings, item)
+			}
+			return nil
+		},
+	)
+
+	return
+}
+
 // ExportFPF exports the findings of a given project in the File Packaging Format (FPF).
 func (f FindingService) ExportFPF(ctx context.Context, projectUUID uuid.UUID) (d []byte, err error) {
 	req, err := f.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/finding/project/%s/export", projectUUID))
@@ -104,6 +350,7 @@ func (f FindingService) ExportFPF(ctx context.Context, projectUUID uuid.UUID) (d
 }
 
 // AnalyzeProject triggers an analysis for a given project.
+// The returned token may be passed to EventService.WaitForProcessing to await the analysis' completion.
 // This feature is available in Dependency-Track v4.7.0 and newer.
 func (f FindingService) AnalyzeProject(ctx context.Context, projectUUID uuid.UUID) (token BOMUploadToken, err error) {
 	req, err := f.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("api/v1/finding/project/%s/analyze", projectUUID))
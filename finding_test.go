@@ -0,0 +1,184 @@
+package dtrack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterFindings(t *testing.T) {
+	findings := []Finding{
+		{Vulnerability: FindingVulnerability{VulnID: "CVE-1", EPSSScore: 0.9, EPSSPercentile: 0.99}},
+		{Vulnerability: FindingVulnerability{VulnID: "CVE-2", EPSSScore: 0.1, EPSSPercentile: 0.5}},
+		{Vulnerability: FindingVulnerability{VulnID: "GHSA-3", EPSSScore: 0.5, Aliases: []VulnerabilityAlias{{CveID: "CVE-3", GhsaID: "GHSA-3"}}}},
+	}
+
+	testCases := []struct {
+		name    string
+		filter  FindingFilter
+		wantIDs []string
+	}{
+		{
+			name:    "no criteria",
+			filter:  FindingFilter{},
+			wantIDs: []string{"CVE-1", "CVE-2", "GHSA-3"},
+		},
+		{
+			name:    "min epss score",
+			filter:  FindingFilter{MinEPSSScore: 0.5},
+			wantIDs: []string{"CVE-1", "GHSA-3"},
+		},
+		{
+			name:    "min epss percentile",
+			filter:  FindingFilter{MinEPSSPercentile: 0.9},
+			wantIDs: []string{"CVE-1"},
+		},
+		{
+			name:    "known exploited by alias",
+			filter:  FindingFilter{KnownExploited: map[string]struct{}{"CVE-2": {}, "CVE-3": {}}},
+			wantIDs: []string{"CVE-2", "GHSA-3"},
+		},
+		{
+			name:    "all criteria",
+			filter:  FindingFilter{MinEPSSScore: 0.5, KnownExploited: map[string]struct{}{"CVE-2": {}, "CVE-3": {}}},
+			wantIDs: []string{"GHSA-3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotIDs []string
+			for _, finding := range FilterFindings(findings, tc.filter) {
+				gotIDs = append(gotIDs, finding.Vulnerability.VulnID)
+			}
+			require.Equal(t, tc.wantIDs, gotIDs)
+		})
+	}
+}
+
+func TestFindingService_GetAllForPortfolio(t *testing.T) {
+	var rawQueries []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/finding", r.URL.Path)
+		rawQueries = append(rawQueries, r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", "1")
+		_, _ = w.Write([]byte(`[{"vulnerability":{"vulnId":"CVE-2021-44228"}}]`))
+	})
+
+	client := setUpTestServer(t, "4.11.0", handler)
+
+	page, err := client.Finding.GetAllForPortfolio(context.Background(), PortfolioFindingFilterOptions{}, PageOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, page.TotalCount)
+	require.Equal(t, "CVE-2021-44228", page.Items[0].Vulnerability.VulnID)
+
+	cvss := func(score float64) *float64 { return &score }
+	_, err = client.Finding.GetAllForPortfolio(context.Background(), PortfolioFindingFilterOptions{
+		ShowInactive:         true,
+		ShowSuppressed:       true,
+		Severities:           []string{"CRITICAL", "HIGH"},
+		AnalysisStates:       []AnalysisState{AnalysisStateExploitable, AnalysisStateInTriage},
+		VendorResponses:      []AnalysisResponse{AnalysisResponseWillNotFix, AnalysisResponseUpdate},
+		PublishDateFrom:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		PublishDateTo:        time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		AttributedOnDateFrom: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		AttributedOnDateTo:   time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		TextSearchFields:     []string{"VULNERABILITY_ID", "COMPONENT_NAME"},
+		TextSearchInput:      "log4j",
+		CVSSV2From:           cvss(4),
+		CVSSV2To:             cvss(10),
+		CVSSV3From:           cvss(7.5),
+		CVSSV3To:             cvss(9.8),
+	}, PageOptions{PageNumber: 2, PageSize: 10})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"",
+		"analysisStatus=EXPLOITABLE%2CIN_TRIAGE" +
+			"&attributedOnDateFrom=2024-02-01&attributedOnDateTo=2024-02-29" +
+			"&cvssv2From=4&cvssv2To=10&cvssv3From=7.5&cvssv3To=9.8" +
+			"&pageNumber=2&pageSize=10" +
+			"&publishDateFrom=2024-01-01&publishDateTo=2024-01-31" +
+			"&severity=CRITICAL%2CHIGH&showInactive=true&showSuppressed=true" +
+			"&textSearchField=VULNERABILITY_ID%2CCOMPONENT_NAME&textSearchInput=log4j" +
+			"&vendorResponse=WILL_NOT_FIX%2CUPDATE",
+	}, rawQueries)
+
+	client = setUpTestServer(t, "4.10.1", handler)
+	_, err = client.Finding.GetAllForPortfolio(context.Background(), PortfolioFindingFilterOptions{}, PageOptions{})
+	require.ErrorContains(t, err, "server version must be at least 4.11.0")
+	require.Len(t, rawQueries, 2)
+}
+
+func TestFindingService_GetAllGrouped(t *testing.T) {
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/v1/finding/grouped", r.URL.Path)
+		require.Equal(t, "pageNumber=1&pageSize=25&severity=CRITICAL&showInactive=true", r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", "1")
+		_, _ = w.Write([]byte(`[{
+			"vulnerability": {
+				"source": "NVD",
+				"vulnId": "CVE-2021-44228",
+				"title": "Log4Shell",
+				"severity": "CRITICAL",
+				"cvssV3BaseScore": 10.0,
+				"cwes": [{"cweId": 502, "name": "Deserialization of Untrusted Data"}],
+				"affectedProjectCount": 3
+			},
+			"attribution": {"analyzerIdentity": "INTERNAL_ANALYZER"}
+		}]`))
+	}))
+
+	page, err := client.Finding.GetAllGrouped(context.Background(), PortfolioFindingFilterOptions{
+		ShowInactive: true,
+		Severities:   []string{"CRITICAL"},
+	}, PageOptions{PageNumber: 1, PageSize: 25})
+	require.NoError(t, err)
+	require.Equal(t, 1, page.TotalCount)
+	require.Equal(t, GroupedFinding{
+		Vulnerability: GroupedFindingVulnerability{
+			Source:               "NVD",
+			VulnID:               "CVE-2021-44228",
+			Title:                "Log4Shell",
+			Severity:             "CRITICAL",
+			CVSSV3BaseScore:      10.0,
+			CWEs:                 []CWE{{ID: 502, Name: "Deserialization of Untrusted Data"}},
+			AffectedProjectCount: 3,
+		},
+		Attribution: GroupedFindingAttribution{AnalyzerIdentity: "INTERNAL_ANALYZER"},
+	}, page.Items[0])
+}
+
+func TestFindingService_GetAllBySource(t *testing.T) {
+	projectUUID := uuid.New()
+	expectedQuery := "pageNumber=1&pageSize=10&source=GITHUB&suppressed=true"
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "/api/v1/finding/project/"+projectUUID.String(), r.URL.Path)
+		require.Equal(t, expectedQuery, r.URL.RawQuery)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", "1")
+		_, _ = w.Write([]byte(`[{"vulnerability":{"source":"GITHUB","vulnId":"GHSA-jfh8-c2jp-5v3q"}}]`))
+	}))
+
+	page, err := client.Finding.GetAllBySource(context.Background(), projectUUID, true, "GITHUB", PageOptions{PageNumber: 1, PageSize: 10})
+	require.NoError(t, err)
+	require.Equal(t, 1, page.TotalCount)
+	require.Equal(t, "GHSA-jfh8-c2jp-5v3q", page.Items[0].Vulnerability.VulnID)
+
+	// Without source, findings of all sources are fetched.
+	expectedQuery = "pageNumber=1&pageSize=10&suppressed=false"
+	_, err = client.Finding.GetAllBySource(context.Background(), projectUUID, false, "", PageOptions{PageNumber: 1, PageSize: 10})
+	require.NoError(t, err)
+}
@@ -0,0 +1,5 @@
+// Package gate provides a quality gate for Dependency-Track projects, intended to be used in CI pipelines.
+//
+// A gate evaluates the current metrics of a project against a set of thresholds,
+// and returns a verdict that lists every threshold that was exceeded.
+package gate
@@ -0,0 +1,122 @@
+package gate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+)
+
+// Thresholds configures the limits a project must not exceed to pass the gate.
+// Limits that are nil are not evaluated.
+type Thresholds struct {
+	MaxCritical         *int
+	MaxHigh             *int
+	MaxMedium           *int
+	MaxRiskScore        *float64
+	MaxFailedViolations *int // Limit for policy violations with state FAIL
+}
+
+// Limit is a convenience function to populate limits in Thresholds.
+func Limit[T int | float64](value T) *T {
+	return &value
+}
+
+type Check string
+
+const (
+	CheckCritical         Check = "CRITICAL"
+	CheckHigh             Check = "HIGH"
+	CheckMedium           Check = "MEDIUM"
+	CheckRiskScore        Check = "RISK_SCORE"
+	CheckFailedViolations Check = "FAILED_VIOLATIONS"
+)
+
+// Reason describes a threshold that was exceeded.
+type Reason struct {
+	Check  Check
+	Limit  float64
+	Actual float64
+}
+
+func (r Reason) String() string {
+	return fmt.Sprintf("%s: %v exceeds limit of %v", r.Check, r.Actual, r.Limit)
+}
+
+type Verdict struct {
+	Passed  bool
+	Reasons []Reason // Exceeded thresholds, empty if the gate passed
+	Metrics dtrack.ProjectMetrics
+}
+
+// DefaultRefreshTimeout is the maximum duration to wait for a refresh of metrics,
+// unless a timeout is configured in Options.Polling.
+const DefaultRefreshTimeout = 5 * time.Minute
+
+type Options struct {
+	// Refresh triggers a refresh of the project's metrics, and waits for it
+	// to complete before evaluating them. Without it, metrics may be stale
+	// for up to an hour, depending on the server's configuration.
+	Refresh bool
+
+	// Polling configures how the completion of the refresh is waited for.
+	// The timeout defaults to DefaultRefreshTimeout, as the refresh may never be observed
+	// to complete when the clocks of client and server are out of sync.
+	Polling dtrack.PollingOptions
+}
+
+// Evaluate fetches the current metrics of a project and evaluates them against thresholds.
+func Evaluate(ctx context.Context, client *dtrack.Client, projectUUID uuid.UUID, thresholds Thresholds, opts Options) (v Verdict, err error) {
+	if opts.Refresh {
+		since := time.Now()
+
+		err = client.Metrics.RefreshProjectMetrics(ctx, projectUUID)
+		if err != nil {
+			return v, fmt.Errorf("failed to refresh metrics: %w", err)
+		}
+
+		polling := opts.Polling
+		if polling.Timeout <= 0 {
+			polling.Timeout = DefaultRefreshTimeout
+		}
+
+		err = client.Metrics.WaitForProjectMetrics(ctx, projectUUID, since, polling)
+		if err != nil {
+			return v, fmt.Errorf("failed to wait for metrics refresh: %w", err)
+		}
+	}
+
+	metrics, err := client.Metrics.LatestProjectMetrics(ctx, projectUUID)
+	if err != nil {
+		return v, fmt.Errorf("failed to fetch metrics: %w", err)
+	}
+
+	return EvaluateMetrics(metrics, thresholds), nil
+}
+
+// EvaluateMetrics evaluates metrics against thresholds.
+func EvaluateMetrics(metrics dtrack.ProjectMetrics, thresholds Thresholds) Verdict {
+	var reasons []Reason
+
+	checkInt := func(check Check, limit *int, actual int) {
+		if limit != nil && actual > *limit {
+			reasons = append(reasons, Reason{Check: check, Limit: float64(*limit), Actual: float64(actual)})
+		}
+	}
+
+	checkInt(CheckCritical, thresholds.MaxCritical, metrics.Critical)
+	checkInt(CheckHigh, thresholds.MaxHigh, metrics.High)
+	checkInt(CheckMedium, thresholds.MaxMedium, metrics.Medium)
+	if thresholds.MaxRiskScore != nil && metrics.InheritedRiskScore > *thresholds.MaxRiskScore {
+		reasons = append(reasons, Reason{Check: CheckRiskScore, Limit: *thresholds.MaxRiskScore, Actual: metrics.InheritedRiskScore})
+	}
+	checkInt(CheckFailedViolations, thresholds.MaxFailedViolations, metrics.PolicyViolationsFail)
+
+	return Verdict{
+		Passed:  len(reasons) == 0,
+		Reasons: reasons,
+		Metrics: metrics,
+	}
+}
@@ -0,0 +1,130 @@
+package gate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// setUpTestServer starts an HTTP server that serves version information, and delegates
+// all other requests to handler.
+func setUpTestServer(t *testing.T, handler http.Handler) *dtrack.Client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/version", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(dtrack.About{Version: "4.11.0"})
+	})
+	mux.Handle("/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := dtrack.NewClient(server.URL)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestEvaluateMetrics(t *testing.T) {
+	metrics := dtrack.ProjectMetrics{
+		Critical:             1,
+		High:                 5,
+		Medium:               20,
+		InheritedRiskScore:   42.5,
+		PolicyViolationsFail: 0,
+	}
+
+	t.Run("Pass", func(t *testing.T) {
+		verdict := EvaluateMetrics(metrics, Thresholds{
+			MaxCritical:         Limit(1),
+			MaxHigh:             Limit(5),
+			MaxRiskScore:        Limit(50.0),
+			MaxFailedViolations: Limit(0),
+		})
+		require.True(t, verdict.Passed)
+		require.Empty(t, verdict.Reasons)
+	})
+
+	t.Run("Fail", func(t *testing.T) {
+		verdict := EvaluateMetrics(metrics, Thresholds{
+			MaxCritical:  Limit(0),
+			MaxRiskScore: Limit(40.0),
+		})
+		require.False(t, verdict.Passed)
+		require.Equal(t, []Reason{
+			{Check: CheckCritical, Limit: 0, Actual: 1},
+			{Check: CheckRiskScore, Limit: 40, Actual: 42.5},
+		}, verdict.Reasons)
+		require.Equal(t, "CRITICAL: 1 exceeds limit of 0", verdict.Reasons[0].String())
+	})
+
+	t.Run("NoThresholds", func(t *testing.T) {
+		require.True(t, EvaluateMetrics(metrics, Thresholds{}).Passed)
+	})
+}
+
+func TestEvaluate(t *testing.T) {
+	projectUUID := uuid.New()
+	thresholds := Thresholds{MaxCritical: Limit(0)}
+
+	t.Run("Refresh", func(t *testing.T) {
+		var refreshed atomic.Bool
+		var polls atomic.Int32
+
+		client := setUpTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v1/metrics/project/" + projectUUID.String() + "/refresh":
+				refreshed.Store(true)
+			case "/api/v1/metrics/project/" + projectUUID.String() + "/current":
+				metrics := dtrack.ProjectMetrics{Critical: 2, LastOccurrence: int(time.Now().Add(-time.Hour).UnixMilli())}
+				// The refresh completes on the second poll.
+				if refreshed.Load() && polls.Add(1) >= 2 {
+					metrics = dtrack.ProjectMetrics{Critical: 1, LastOccurrence: int(time.Now().UnixMilli())}
+				}
+				_ = json.NewEncoder(w).Encode(metrics)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		verdict, err := Evaluate(context.Background(), client, projectUUID, thresholds, Options{
+			Refresh: true,
+			Polling: dtrack.PollingOptions{Interval: time.Millisecond},
+		})
+		require.NoError(t, err)
+		require.True(t, refreshed.Load())
+		require.False(t, verdict.Passed)
+		require.Equal(t, 1, verdict.Metrics.Critical)
+		require.Equal(t, []Reason{{Check: CheckCritical, Limit: 0, Actual: 1}}, verdict.Reasons)
+	})
+
+	t.Run("RefreshTimeout", func(t *testing.T) {
+		client := setUpTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Metrics are never updated, e.g. because the server's clock is behind.
+			_ = json.NewEncoder(w).Encode(dtrack.ProjectMetrics{LastOccurrence: int(time.Now().Add(-time.Hour).UnixMilli())})
+		}))
+
+		_, err := Evaluate(context.Background(), client, projectUUID, thresholds, Options{
+			Refresh: true,
+			Polling: dtrack.PollingOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond},
+		})
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("FetchError", func(t *testing.T) {
+		client := setUpTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+
+		_, err := Evaluate(context.Background(), client, projectUUID, thresholds, Options{})
+		require.ErrorIs(t, err, dtrack.ErrForbidden)
+		require.ErrorContains(t, err, "failed to fetch metrics")
+	})
+}
@@ -2,7 +2,9 @@ package dtrack
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/google/uuid"
 )
@@ -19,6 +21,7 @@ type License struct {
 	FSFLibre            bool      `json:"isFsfLibre"`
 	DeprecatedLicenseID bool      `json:"isDeprecatedLicenseId"`
 	SeeAlso             []string  `json:"seeAlso"`
+	CustomLicense       bool      `json:"isCustomLicense"`
 }
 
 type LicenseService struct {
@@ -39,3 +42,50 @@ func (l LicenseService) GetAll(ctx context.Context, po PageOptions) (p Page[Lice
 	p.TotalCount = res.TotalCount
 	return
 }
+
+// Get fetches a license by its SPDX license ID, e.g. "Apache-2.0".
+func (l LicenseService) Get(ctx context.Context, licenseID string) (license License, err error) {
+	req, err := l.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/license/%s", url.PathEscape(licenseID)))
+	if err != nil {
+		return
+	}
+
+	_, err = l.client.doRequest(req, &license)
+	return
+}
+
+// GetAllConcise fetches all licenses, omitting bulky fields like their text, template and header.
+// The response is not paginated.
+func (l LicenseService) GetAllConcise(ctx context.Context) (licenses []License, err error) {
+	req, err := l.client.newRequest(ctx, http.MethodGet, "api/v1/license/concise")
+	if err != nil {
+		return
+	}
+
+	_, err = l.client.doRequest(req, &licenses)
+	return
+}
+
+// Create creates a custom license.
+// Both the license ID and name must be set, and the license ID must not conflict with an existing license.
+func (l LicenseService) Create(ctx context.Context, license License) (created License, err error) {
+	req, err := l.client.newRequest(ctx, http.MethodPut, "api/v1/license", withBody(license))
+	if err != nil {
+		return
+	}
+
+	_, err = l.client.doRequest(req, &created)
+	return
+}
+
+// Delete deletes a custom license by its license ID.
+// Licenses that are not custom can not be deleted.
+func (l LicenseService) Delete(ctx context.Context, licenseID string) (err error) {
+	req, err := l.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/license/%s", url.PathEscape(licenseID)))
+	if err != nil {
+		return
+	}
+
+	_, err = l.client.doRequest(req, nil)
+	return
+}
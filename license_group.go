@@ -0,0 +1,99 @@
+package dtrack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+type LicenseGroup struct {
+	UUID       uuid.UUID `json:"uuid,omitempty"`
+	Name       string    `json:"name"`
+	Licenses   []License `json:"licenses,omitempty"`
+	RiskWeight int       `json:"riskWeight"`
+}
+
+type LicenseGroupService struct {
+	client *Client
+}
+
+func (lgs LicenseGroupService) Get(ctx context.Context, licenseGroupUUID uuid.UUID) (lg LicenseGroup, err error) {
+	req, err := lgs.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/licenseGroup/%s", licenseGroupUUID))
+	if err != nil {
+		return
+	}
+
+	_, err = lgs.client.doRequest(req, &lg)
+	return
+}
+
+func (lgs LicenseGroupService) GetAll(ctx context.Context, po PageOptions) (p Page[LicenseGroup], err error) {
+	req, err := lgs.client.newRequest(ctx, http.MethodGet, "api/v1/licenseGroup", withPageOptions(po))
+	if err != nil {
+		return
+	}
+
+	res, err := lgs.client.doRequest(req, &p.Items)
+	if err != nil {
+		return
+	}
+
+	p.TotalCount = res.TotalCount
+	return
+}
+
+func (lgs LicenseGroupService) Create(ctx context.Context, licenseGroup LicenseGroup) (lg LicenseGroup, err error) {
+	req, err := lgs.client.newRequest(ctx, http.MethodPut, "api/v1/licenseGroup", withBody(licenseGroup))
+	if err != nil {
+		return
+	}
+
+	_, err = lgs.client.doRequest(req, &lg)
+	return
+}
+
+// Update updates a license group, as identified by its UUID.
+// Note that the licenses of a group are not updated; use AddLicense and RemoveLicense instead.
+func (lgs LicenseGroupService) Update(ctx context.Context, licenseGroup LicenseGroup) (lg LicenseGroup, err error) {
+	req, err := lgs.client.newRequest(ctx, http.MethodPost, "api/v1/licenseGroup", withBody(licenseGroup))
+	if err != nil {
+		return
+	}
+
+	_, err = lgs.client.doRequest(req, &lg)
+	return
+}
+
+func (lgs LicenseGroupService) Delete(ctx context.Context, licenseGroupUUID uuid.UUID) (err error) {
+	req, err := lgs.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/licenseGroup/%s", licenseGroupUUID))
+	if err != nil {
+		return
+	}
+
+	_, err = lgs.client.doRequest(req, nil)
+	return
+}
+
+// AddLicense adds a license to a license group.
+func (lgs LicenseGroupService) AddLicense(ctx context.Context, licenseGroupUUID, licenseUUID uuid.UUID) (lg LicenseGroup, err error) {
+	req, err := lgs.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("api/v1/licenseGroup/%s/license/%s", licenseGroupUUID, licenseUUID))
+	if err != nil {
+		return
+	}
+
+	_, err = lgs.client.doRequest(req, &lg)
+	return
+}
+
+// RemoveLicense removes a license from a license group.
+func (lgs LicenseGroupService) RemoveLicense(ctx context.Context, licenseGroupUUID, licenseUUID uuid.UUID) (lg LicenseGroup, err error) {
+	req, err := lgs.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/licenseGroup/%s/license/%s", licenseGroupUUID, licenseUUID))
+	if err != nil {
+		return
+	}
+
+	_, err = lgs.client.doRequest(req, &lg)
+	return
+}
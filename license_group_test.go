@@ -0,0 +1,45 @@
+package dtrack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLicenseGroupLifecycle(t *testing.T) {
+	client := setUpContainer(t, testContainerOptions{
+		APIPermissions: []string{
+			PermissionPolicyManagement,
+		},
+	})
+
+	licenses, err := client.License.GetAll(context.Background(), PageOptions{PageSize: 1})
+	require.NoError(t, err)
+	require.NotEmpty(t, licenses.Items)
+	license := licenses.Items[0]
+
+	group, err := client.LicenseGroup.Create(context.Background(), LicenseGroup{Name: "test_group"})
+	require.NoError(t, err)
+	require.Equal(t, "test_group", group.Name)
+
+	group.RiskWeight = 5
+	group, err = client.LicenseGroup.Update(context.Background(), group)
+	require.NoError(t, err)
+	require.Equal(t, 5, group.RiskWeight)
+
+	group, err = client.LicenseGroup.AddLicense(context.Background(), group.UUID, license.UUID)
+	require.NoError(t, err)
+	require.Len(t, group.Licenses, 1)
+	require.Equal(t, license.UUID, group.Licenses[0].UUID)
+
+	group, err = client.LicenseGroup.RemoveLicense(context.Background(), group.UUID, license.UUID)
+	require.NoError(t, err)
+	require.Empty(t, group.Licenses)
+
+	err = client.LicenseGroup.Delete(context.Background(), group.UUID)
+	require.NoError(t, err)
+
+	_, err = client.LicenseGroup.Get(context.Background(), group.UUID)
+	require.Error(t, err)
+}
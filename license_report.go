@@ -0,0 +1,137 @@
+package dtrack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// DefaultCopyleftLicenseGroups are the names of license groups that are considered
+// copyleft by default. Both groups are provisioned by Dependency-Track out of the box.
+var DefaultCopyleftLicenseGroups = []string{"Copyleft", "Weak Copyleft"}
+
+type LicenseComplianceOptions struct {
+	// CopyleftGroups are the names of license groups that are considered copyleft.
+	// Defaults to DefaultCopyleftLicenseGroups.
+	CopyleftGroups []string
+}
+
+// LicenseComplianceEntry describes the license situation of a single component.
+type LicenseComplianceEntry struct {
+	Project       Project
+	Component     Component
+	License       *License // Resolved license, nil if the license could not be resolved
+	LicenseGroups []string // Names of the license groups the resolved license is part of
+}
+
+type LicenseComplianceReport struct {
+	Entries         []LicenseComplianceEntry
+	UnknownLicenses []LicenseComplianceEntry // Components whose license could not be resolved
+	CopyleftHits    []LicenseComplianceEntry // Components with a license that is part of a copyleft group
+	PolicyConflicts []PolicyViolation        // Unsuppressed license policy violations
+}
+
+// ComplianceReport walks all components of a project, resolves their licenses and license groups,
+// and reports unknown licenses, copyleft licenses and license policy violations.
+func (l LicenseService) ComplianceReport(ctx context.Context, projectUUID uuid.UUID, opts LicenseComplianceOptions) (r LicenseComplianceReport, err error) {
+	groupsByLicense, err := l.fetchLicenseGroups(ctx)
+	if err != nil {
+		return
+	}
+
+	project, err := l.client.Project.Get(ctx, projectUUID)
+	if err != nil {
+		return
+	}
+
+	err = l.appendComplianceReport(ctx, &r, project, groupsByLicense, opts)
+	return
+}
+
+// PortfolioComplianceReport is like ComplianceReport, but covers all projects in the portfolio.
+func (l LicenseService) PortfolioComplianceReport(ctx context.Context, opts LicenseComplianceOptions) (r LicenseComplianceReport, err error) {
+	groupsByLicense, err := l.fetchLicenseGroups(ctx)
+	if err != nil {
+		return
+	}
+
+	err = ForEach(func(po PageOptions) (Page[Project], error) {
+		return l.client.Project.GetAll(ctx, po)
+	}, func(project Project) error {
+		return l.appendComplianceReport(ctx, &r, project, groupsByLicense, opts)
+	})
+	return
+}
+
+// fetchLicenseGroups fetches all license groups, and returns their names by the UUIDs of their licenses.
+func (l LicenseService) fetchLicenseGroups(ctx context.Context) (map[uuid.UUID][]string, error) {
+	groups, err := FetchAll(func(po PageOptions) (Page[LicenseGroup], error) {
+		return l.client.LicenseGroup.GetAll(ctx, po)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch license groups: %w", err)
+	}
+
+	groupsByLicense := make(map[uuid.UUID][]string)
+	for _, group := range groups {
+		for _, license := range group.Licenses {
+			groupsByLicense[license.UUID] = append(groupsByLicense[license.UUID], group.Name)
+		}
+	}
+
+	return groupsByLicense, nil
+}
+
+func (l LicenseService) appendComplianceReport(ctx context.Context, r *LicenseComplianceReport, project Project, groupsByLicense map[uuid.UUID][]string, opts LicenseComplianceOptions) error {
+	copyleftGroups := opts.CopyleftGroups
+	if copyleftGroups == nil {
+		copyleftGroups = DefaultCopyleftLicenseGroups
+	}
+
+	components, err := FetchAll(func(po PageOptions) (Page[Component], error) {
+		return l.client.Component.GetAll(ctx, project.UUID, po, ComponentFilterOptions{})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch components of project %s: %w", project.UUID, err)
+	}
+
+	for _, component := range components {
+		entry := LicenseComplianceEntry{
+			Project:   project,
+			Component: component,
+			License:   component.ResolvedLicense,
+		}
+		if entry.License != nil {
+			entry.LicenseGroups = groupsByLicense[entry.License.UUID]
+		}
+		r.Entries = append(r.Entries, entry)
+
+		if entry.License == nil {
+			r.UnknownLicenses = append(r.UnknownLicenses, entry)
+			continue
+		}
+
+		for _, group := range entry.LicenseGroups {
+			if containsString(copyleftGroups, group) {
+				r.CopyleftHits = append(r.CopyleftHits, entry)
+				break
+			}
+		}
+	}
+
+	violations, err := FetchAll(func(po PageOptions) (Page[PolicyViolation], error) {
+		return l.client.PolicyViolation.GetAllForProject(ctx, project.UUID, false, po)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch policy violations of project %s: %w", project.UUID, err)
+	}
+
+	for _, violation := range violations {
+		if violation.Type == string(PolicyViolationTypeLicense) {
+			r.PolicyConflicts = append(r.PolicyConflicts, violation)
+		}
+	}
+
+	return nil
+}
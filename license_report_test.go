@@ -0,0 +1,66 @@
+package dtrack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLicenseService_ComplianceReport(t *testing.T) {
+	projectUUID := uuid.New()
+	gpl := License{UUID: uuid.New(), LicenseID: "GPL-3.0-only"}
+	mit := License{UUID: uuid.New(), LicenseID: "MIT"}
+
+	respond := func(w http.ResponseWriter, v interface{}, totalCount int) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", strconv.Itoa(totalCount))
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/licenseGroup", func(w http.ResponseWriter, _ *http.Request) {
+		respond(w, []LicenseGroup{
+			{Name: "Copyleft", Licenses: []License{gpl}},
+			{Name: "Permissive", Licenses: []License{mit}},
+		}, 2)
+	})
+	mux.HandleFunc("/api/v1/project/"+projectUUID.String(), func(w http.ResponseWriter, _ *http.Request) {
+		respond(w, Project{UUID: projectUUID, Name: "acme-app"}, 0)
+	})
+	mux.HandleFunc("/api/v1/component/project/"+projectUUID.String(), func(w http.ResponseWriter, _ *http.Request) {
+		respond(w, []Component{
+			{Name: "gpl-lib", ResolvedLicense: &gpl},
+			{Name: "mit-lib", ResolvedLicense: &mit},
+			{Name: "mystery-lib", License: "Proprietary"},
+		}, 3)
+	})
+	mux.HandleFunc("/api/v1/violation/project/"+projectUUID.String(), func(w http.ResponseWriter, _ *http.Request) {
+		respond(w, []PolicyViolation{
+			{Type: "LICENSE", Component: Component{Name: "gpl-lib"}},
+			{Type: "SECURITY", Component: Component{Name: "mit-lib"}},
+		}, 2)
+	})
+
+	client := setUpTestServer(t, "4.11.0", mux)
+
+	report, err := client.License.ComplianceReport(context.Background(), projectUUID, LicenseComplianceOptions{})
+	require.NoError(t, err)
+
+	require.Len(t, report.Entries, 3)
+	require.Equal(t, []string{"Permissive"}, report.Entries[1].LicenseGroups)
+
+	require.Len(t, report.UnknownLicenses, 1)
+	require.Equal(t, "mystery-lib", report.UnknownLicenses[0].Component.Name)
+
+	require.Len(t, report.CopyleftHits, 1)
+	require.Equal(t, "gpl-lib", report.CopyleftHits[0].Component.Name)
+	require.Equal(t, "acme-app", report.CopyleftHits[0].Project.Name)
+
+	require.Len(t, report.PolicyConflicts, 1)
+	require.Equal(t, "gpl-lib", report.PolicyConflicts[0].Component.Name)
+}
@@ -0,0 +1,53 @@
+package dtrack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLicenseService_Get(t *testing.T) {
+	client := setUpContainer(t, testContainerOptions{
+		APIPermissions: []string{
+			PermissionViewPortfolio,
+		},
+	})
+
+	license, err := client.License.Get(context.Background(), "Apache-2.0")
+	require.NoError(t, err)
+	require.Equal(t, "Apache-2.0", license.LicenseID)
+	require.False(t, license.CustomLicense)
+
+	licenses, err := client.License.GetAllConcise(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, licenses)
+}
+
+func TestLicenseService_CustomLicense(t *testing.T) {
+	client := setUpContainer(t, testContainerOptions{
+		APIPermissions: []string{
+			PermissionSystemConfiguration,
+			PermissionViewPortfolio,
+		},
+	})
+
+	license, err := client.License.Create(context.Background(), License{
+		LicenseID: "LicenseRef-Acme-Internal",
+		Name:      "Acme Internal License",
+		Text:      "All rights reserved.",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "LicenseRef-Acme-Internal", license.LicenseID)
+	require.True(t, license.CustomLicense)
+
+	license, err = client.License.Get(context.Background(), "LicenseRef-Acme-Internal")
+	require.NoError(t, err)
+	require.Equal(t, "Acme Internal License", license.Name)
+
+	err = client.License.Delete(context.Background(), "LicenseRef-Acme-Internal")
+	require.NoError(t, err)
+
+	_, err = client.License.Get(context.Background(), "LicenseRef-Acme-Internal")
+	require.Error(t, err)
+}
@@ -0,0 +1,30 @@
+package dtrack
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// requestLogger is notified about requests sent by the client.
+// It decouples the client from log/slog, which requires go1.21.
+type requestLogger interface {
+	rateLimited(req *http.Request, delay time.Duration)
+	requestStarted(req *http.Request, attempt int)
+	requestFinished(req *http.Request, attempt int, res *http.Response, err error, elapsed time.Duration)
+	retrying(req *http.Request, attempt int, delay time.Duration)
+}
+
+// apiKeyPathRe matches API keys (or their public IDs since v4.13.0) in URL paths.
+var apiKeyPathRe = regexp.MustCompile(`(/key/)[^/]+|odt_[A-Za-z0-9_]+`)
+
+// redactPath replaces API keys in path, so that it is safe to be logged or traced.
+func redactPath(path string) string {
+	return apiKeyPathRe.ReplaceAllStringFunc(path, func(match string) string {
+		if strings.HasPrefix(match, "/key/") {
+			return "/key/REDACTED"
+		}
+		return "REDACTED"
+	})
+}
@@ -0,0 +1,102 @@
+//go:build go1.21
+
+package dtrack
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// LogLevels configures the levels at which the client logs.
+type LogLevels struct {
+	Request   slog.Level // Level for requests being sent, and responses being received
+	Retry     slog.Level // Level for requests being retried
+	RateLimit slog.Level // Level for requests being delayed by the rate limit
+}
+
+// DefaultLogLevels are the levels used by WithLogger, unless configured otherwise via WithLogLevels.
+var DefaultLogLevels = LogLevels{
+	Request:   slog.LevelDebug,
+	Retry:     slog.LevelWarn,
+	RateLimit: slog.LevelDebug,
+}
+
+// WithLogger configures the client to log its requests to logger.
+// API keys are redacted from logged URL paths.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) error {
+		c.slogLogger().logger = logger
+		return nil
+	}
+}
+
+// WithLogLevels configures the levels at which the client logs.
+// Unless WithLogger is used as well, logs are written to slog.Default().
+func WithLogLevels(levels LogLevels) ClientOption {
+	return func(c *Client) error {
+		c.slogLogger().levels = levels
+		return nil
+	}
+}
+
+func (c *Client) slogLogger() *slogRequestLogger {
+	if l, ok := c.logger.(*slogRequestLogger); ok {
+		return l
+	}
+
+	l := &slogRequestLogger{logger: slog.Default(), levels: DefaultLogLevels}
+	c.logger = l
+	return l
+}
+
+type slogRequestLogger struct {
+	logger *slog.Logger
+	levels LogLevels
+}
+
+func (l slogRequestLogger) rateLimited(req *http.Request, delay time.Duration) {
+	l.log(req, l.levels.RateLimit, "delaying request due to rate limit",
+		slog.Duration("delay", delay))
+}
+
+func (l slogRequestLogger) requestStarted(req *http.Request, attempt int) {
+	l.log(req, l.levels.Request, "sending request",
+		slog.Int("attempt", attempt+1))
+}
+
+func (l slogRequestLogger) requestFinished(req *http.Request, attempt int, res *http.Response, err error, elapsed time.Duration) {
+	if err != nil {
+		l.log(req, l.levels.Request, "request failed",
+			slog.Int("attempt", attempt+1),
+			slog.Duration("elapsed", elapsed),
+			slog.Any("error", err))
+		return
+	}
+
+	l.log(req, l.levels.Request, "received response",
+		slog.Int("attempt", attempt+1),
+		slog.Duration("elapsed", elapsed),
+		slog.Int("status", res.StatusCode))
+}
+
+func (l slogRequestLogger) retrying(req *http.Request, attempt int, delay time.Duration) {
+	l.log(req, l.levels.Retry, "retrying request",
+		slog.Int("attempt", attempt+1),
+		slog.Duration("delay", delay))
+}
+
+func (l slogRequestLogger) log(req *http.Request, level slog.Level, msg string, attrs ...slog.Attr) {
+	ctx := req.Context()
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs = append([]slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("path", redactPath(req.URL.Path)),
+	}, attrs...)
+	l.logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+var _ requestLogger = slogRequestLogger{}
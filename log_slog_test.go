@@ -0,0 +1,76 @@
+//go:build go1.21
+
+package dtrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	attempts := 0
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"key":"odt_new"}`))
+	}), WithLogger(logger), WithRetry(RetryOptions{MaxRetries: 1, InitialBackoff: 1}))
+	buf.Reset()
+
+	_, err := client.Team.RegenerateAPIKey(context.Background(), "odt_secret")
+	require.NoError(t, err)
+
+	var records []map[string]any
+	decoder := json.NewDecoder(&buf)
+	for decoder.More() {
+		var record map[string]any
+		require.NoError(t, decoder.Decode(&record))
+		records = append(records, record)
+	}
+
+	require.Len(t, records, 5)
+	require.Equal(t, "sending request", records[0]["msg"])
+	require.Equal(t, "received response", records[1]["msg"])
+	require.Equal(t, float64(http.StatusServiceUnavailable), records[1]["status"])
+	require.Equal(t, "retrying request", records[2]["msg"])
+	require.Equal(t, "WARN", records[2]["level"])
+	require.Equal(t, float64(2), records[2]["attempt"])
+	require.Equal(t, "sending request", records[3]["msg"])
+	require.Equal(t, "received response", records[4]["msg"])
+	require.Equal(t, float64(http.StatusOK), records[4]["status"])
+
+	for _, record := range records {
+		require.Equal(t, http.MethodPost, record["method"])
+		require.Equal(t, "/api/v1/team/key/REDACTED", record["path"])
+	}
+	require.NotContains(t, buf.String(), "odt_secret")
+}
+
+func TestWithLogLevels(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	_ = setUpTestServer(t, "4.11.0", nil, WithLogger(logger))
+	require.Empty(t, buf.String())
+
+	_ = setUpTestServer(t, "4.11.0", nil, WithLogLevels(LogLevels{Request: slog.LevelInfo}), WithLogger(logger))
+	require.Contains(t, buf.String(), `"msg":"sending request"`)
+}
+
+func TestRedactPath(t *testing.T) {
+	require.Equal(t, "/api/v1/team/key/REDACTED/comment", redactPath("/api/v1/team/key/odt_abc/comment"))
+	require.Equal(t, "/api/v1/team/key/REDACTED", redactPath("/api/v1/team/key/abc123"))
+	require.Equal(t, "/api/v1/foo/REDACTED", redactPath("/api/v1/foo/odt_abc"))
+	require.Equal(t, "/api/v1/project/key", redactPath("/api/v1/project/key"))
+}
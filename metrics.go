@@ -77,6 +77,44 @@ type ProjectMetrics struct {
 	PolicyViolationsOperationalUnaudited int     `json:"policyViolationsOperationalUnaudited"`
 }
 
+type ComponentMetrics struct {
+	FirstOccurrence                      int     `json:"firstOccurrence"`
+	LastOccurrence                       int     `json:"lastOccurrence"`
+	InheritedRiskScore                   float64 `json:"inheritedRiskScore"`
+	Vulnerabilities                      int     `json:"vulnerabilities"`
+	Suppressed                           int     `json:"suppressed"`
+	Critical                             int     `json:"critical"`
+	High                                 int     `json:"high"`
+	Medium                               int     `json:"medium"`
+	Low                                  int     `json:"low"`
+	Unassigned                           int     `json:"unassigned"`
+	FindingsTotal                        int     `json:"findingsTotal"`
+	FindingsAudited                      int     `json:"findingsAudited"`
+	FindingsUnaudited                    int     `json:"findingsUnaudited"`
+	PolicyViolationsTotal                int     `json:"policyViolationsTotal"`
+	PolicyViolationsFail                 int     `json:"policyViolationsFail"`
+	PolicyViolationsWarn                 int     `json:"policyViolationsWarn"`
+	PolicyViolationsInfo                 int     `json:"policyViolationsInfo"`
+	PolicyViolationsAudited              int     `json:"policyViolationsAudited"`
+	PolicyViolationsUnaudited            int     `json:"policyViolationsUnaudited"`
+	PolicyViolationsSecurityTotal        int     `json:"policyViolationsSecurityTotal"`
+	PolicyViolationsSecurityAudited      int     `json:"policyViolationsSecurityAudited"`
+	PolicyViolationsSecurityUnaudited    int     `json:"policyViolationsSecurityUnaudited"`
+	PolicyViolationsLicenseTotal         int     `json:"policyViolationsLicenseTotal"`
+	PolicyViolationsLicenseAudited       int     `json:"policyViolationsLicenseAudited"`
+	PolicyViolationsLicenseUnaudited     int     `json:"policyViolationsLicenseUnaudited"`
+	PolicyViolationsOperationalTotal     int     `json:"policyViolationsOperationalTotal"`
+	PolicyViolationsOperationalAudited   int     `json:"policyViolationsOperationalAudited"`
+	PolicyViolationsOperationalUnaudited int     `json:"policyViolationsOperationalUnaudited"`
+}
+
+type VulnerabilityMetrics struct {
+	Year       int `json:"year"`
+	Month      int `json:"month,omitempty"`
+	Count      int `json:"count"`
+	MeasuredAt int `json:"measuredAt"`
+}
+
 type MetricsService struct {
 	client *Client
 }
@@ -91,6 +129,8 @@ func (ms MetricsService) LatestPortfolioMetrics(ctx context.Context) (m Portfoli
 	return
 }
 
+// PortfolioMetricsSince fetches the metrics history of the portfolio, starting at the given date.
+// Only the date portion of date, in its own location, is considered.
 func (ms MetricsService) PortfolioMetricsSince(ctx context.Context, date time.Time) (m []PortfolioMetrics, err error) {
 	req, err := ms.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/metrics/portfolio/since/%s", date.Format("20060102")))
 	if err != nil {
@@ -101,6 +141,7 @@ func (ms MetricsService) PortfolioMetricsSince(ctx context.Context, date time.Ti
 	return
 }
 
+// PortfolioMetricsSinceDays fetches the metrics history of the portfolio for the given number of days.
 func (ms MetricsService) PortfolioMetricsSinceDays(ctx context.Context, days uint) (m []PortfolioMetrics, err error) {
 	req, err := ms.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/metrics/portfolio/%d/days", days))
 	if err != nil {
@@ -131,6 +172,8 @@ func (ms MetricsService) LatestProjectMetrics(ctx context.Context, projectUUID u
 	return
 }
 
+// ProjectMetricsSince fetches the metrics history of a project, starting at the given date.
+// Only the date portion of date, in its own location, is considered.
 func (ms MetricsService) ProjectMetricsSince(ctx context.Context, projectUUID uuid.UUID, date time.Time) (m []ProjectMetrics, err error) {
 	req, err := ms.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/metrics/project/%s/since/%s", projectUUID, date.Format("20060102")))
 	if err != nil {
@@ -141,6 +184,7 @@ func (ms MetricsService) ProjectMetricsSince(ctx context.Context, projectUUID uu
 	return
 }
 
+// ProjectMetricsSinceDays fetches the metrics history of a project for the given number of days.
 func (ms MetricsService) ProjectMetricsSinceDays(ctx context.Context, projectUUID uuid.UUID, days uint) (m []ProjectMetrics, err error) {
 	req, err := ms.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/metrics/project/%s/days/%d", projectUUID, days))
 	if err != nil {
@@ -160,3 +204,91 @@ func (ms MetricsService) RefreshProjectMetrics(ctx context.Context, projectUUID
 	_, err = ms.client.doRequest(req, nil)
 	return
 }
+
+func (ms MetricsService) LatestComponentMetrics(ctx context.Context, componentUUID uuid.UUID) (m ComponentMetrics, err error) {
+	req, err := ms.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/metrics/component/%s/current", componentUUID))
+	if err != nil {
+		return
+	}
+
+	_, err = ms.client.doRequest(req, &m)
+	return
+}
+
+// ComponentMetricsSince fetches the metrics history of a component, starting at the given date.
+// Only the date portion of date, in its own location, is considered.
+func (ms MetricsService) ComponentMetricsSince(ctx context.Context, componentUUID uuid.UUID, date time.Time) (m []ComponentMetrics, err error) {
+	req, err := ms.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/metrics/component/%s/since/%s", componentUUID, date.Format("20060102")))
+	if err != nil {
+		return
+	}
+
+	_, err = ms.client.doRequest(req, &m)
+	return
+}
+
+// ComponentMetricsSinceDays fetches the metrics history of a component for the given number of days.
+func (ms MetricsService) ComponentMetricsSinceDays(ctx context.Context, componentUUID uuid.UUID, days uint) (m []ComponentMetrics, err error) {
+	req, err := ms.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/metrics/component/%s/days/%d", componentUUID, days))
+	if err != nil {
+		return
+	}
+
+	_, err = ms.client.doRequest(req, &m)
+	return
+}
+
+func (ms MetricsService) RefreshComponentMetrics(ctx context.Context, componentUUID uuid.UUID) (err error) {
+	req, err := ms.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/metrics/component/%s/refresh", componentUUID))
+	if err != nil {
+		return
+	}
+
+	_, err = ms.client.doRequest(req, nil)
+	return
+}
+
+// WaitForPortfolioMetrics blocks until the current portfolio metrics have been updated at or after since.
+// It is intended to be used after triggering a refresh via RefreshPortfolioMetrics, which does not signal its completion:
+//
+//	since := time.Now()
+//	err := client.Metrics.RefreshPortfolioMetrics(ctx)
+//	// ...
+//	err = client.Metrics.WaitForPortfolioMetrics(ctx, since, dtrack.PollingOptions{Timeout: 5 * time.Minute})
+//
+// Note that since is compared to timestamps generated by the server, so clocks of client and server should be in sync.
+func (ms MetricsService) WaitForPortfolioMetrics(ctx context.Context, since time.Time, opts PollingOptions) error {
+	return poll(ctx, ms.client.clock, opts, func(ctx context.Context) (bool, error) {
+		m, err := ms.LatestPortfolioMetrics(ctx)
+		return int64(m.LastOccurrence) >= since.UnixMilli(), err
+	})
+}
+
+// WaitForProjectMetrics blocks until the current metrics of a project have been updated at or after since.
+// Refer to WaitForPortfolioMetrics for details.
+func (ms MetricsService) WaitForProjectMetrics(ctx context.Context, projectUUID uuid.UUID, since time.Time, opts PollingOptions) error {
+	return poll(ctx, ms.client.clock, opts, func(ctx context.Context) (bool, error) {
+		m, err := ms.LatestProjectMetrics(ctx, projectUUID)
+		return int64(m.LastOccurrence) >= since.UnixMilli(), err
+	})
+}
+
+// WaitForComponentMetrics blocks until the current metrics of a component have been updated at or after since.
+// Refer to WaitForPortfolioMetrics for details.
+func (ms MetricsService) WaitForComponentMetrics(ctx context.Context, componentUUID uuid.UUID, since time.Time, opts PollingOptions) error {
+	return poll(ctx, ms.client.clock, opts, func(ctx context.Context) (bool, error) {
+		m, err := ms.LatestComponentMetrics(ctx, componentUUID)
+		return int64(m.LastOccurrence) >= since.UnixMilli(), err
+	})
+}
+
+// VulnerabilityMetrics fetches the number of vulnerabilities in the database, grouped by year and month.
+func (ms MetricsService) VulnerabilityMetrics(ctx context.Context) (m []VulnerabilityMetrics, err error) {
+	req, err := ms.client.newRequest(ctx, http.MethodGet, "api/v1/metrics/vulnerability")
+	if err != nil {
+		return
+	}
+
+	_, err = ms.client.doRequest(req, &m)
+	return
+}
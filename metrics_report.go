@@ -0,0 +1,164 @@
+package dtrack
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type MetricsReportFormat string
+
+const (
+	MetricsReportFormatCSV  MetricsReportFormat = "CSV"
+	MetricsReportFormatJSON MetricsReportFormat = "JSON"
+)
+
+type MetricsReportColumn string
+
+const (
+	MetricsReportColumnUUID                  MetricsReportColumn = "uuid"
+	MetricsReportColumnGroup                 MetricsReportColumn = "group"
+	MetricsReportColumnName                  MetricsReportColumn = "name"
+	MetricsReportColumnVersion               MetricsReportColumn = "version"
+	MetricsReportColumnActive                MetricsReportColumn = "active"
+	MetricsReportColumnLastBOMImport         MetricsReportColumn = "lastBomImport"
+	MetricsReportColumnComponents            MetricsReportColumn = "components"
+	MetricsReportColumnVulnerableComponents  MetricsReportColumn = "vulnerableComponents"
+	MetricsReportColumnVulnerabilities       MetricsReportColumn = "vulnerabilities"
+	MetricsReportColumnCritical              MetricsReportColumn = "critical"
+	MetricsReportColumnHigh                  MetricsReportColumn = "high"
+	MetricsReportColumnMedium                MetricsReportColumn = "medium"
+	MetricsReportColumnLow                   MetricsReportColumn = "low"
+	MetricsReportColumnUnassigned            MetricsReportColumn = "unassigned"
+	MetricsReportColumnSuppressed            MetricsReportColumn = "suppressed"
+	MetricsReportColumnInheritedRiskScore    MetricsReportColumn = "inheritedRiskScore"
+	MetricsReportColumnFindingsUnaudited     MetricsReportColumn = "findingsUnaudited"
+	MetricsReportColumnPolicyViolationsTotal MetricsReportColumn = "policyViolationsTotal"
+	MetricsReportColumnPolicyViolationsFail  MetricsReportColumn = "policyViolationsFail"
+	MetricsReportColumnPolicyViolationsWarn  MetricsReportColumn = "policyViolationsWarn"
+	MetricsReportColumnPolicyViolationsInfo  MetricsReportColumn = "policyViolationsInfo"
+)
+
+// DefaultMetricsReportColumns are the columns included in metrics reports when none are configured.
+var DefaultMetricsReportColumns = []MetricsReportColumn{
+	MetricsReportColumnUUID,
+	MetricsReportColumnName,
+	MetricsReportColumnVersion,
+	MetricsReportColumnCritical,
+	MetricsReportColumnHigh,
+	MetricsReportColumnMedium,
+	MetricsReportColumnLow,
+	MetricsReportColumnUnassigned,
+	MetricsReportColumnInheritedRiskScore,
+	MetricsReportColumnPolicyViolationsTotal,
+}
+
+var metricsReportColumnValues = map[MetricsReportColumn]func(p Project) interface{}{
+	MetricsReportColumnUUID:                  func(p Project) interface{} { return p.UUID.String() },
+	MetricsReportColumnGroup:                 func(p Project) interface{} { return p.Group },
+	MetricsReportColumnName:                  func(p Project) interface{} { return p.Name },
+	MetricsReportColumnVersion:               func(p Project) interface{} { return p.Version },
+	MetricsReportColumnActive:                func(p Project) interface{} { return p.Active },
+	MetricsReportColumnLastBOMImport:         func(p Project) interface{} { return p.LastBOMImport },
+	MetricsReportColumnComponents:            func(p Project) interface{} { return p.Metrics.Components },
+	MetricsReportColumnVulnerableComponents:  func(p Project) interface{} { return p.Metrics.VulnerableComponents },
+	MetricsReportColumnVulnerabilities:       func(p Project) interface{} { return p.Metrics.Vulnerabilities },
+	MetricsReportColumnCritical:              func(p Project) interface{} { return p.Metrics.Critical },
+	MetricsReportColumnHigh:                  func(p Project) interface{} { return p.Metrics.High },
+	MetricsReportColumnMedium:                func(p Project) interface{} { return p.Metrics.Medium },
+	MetricsReportColumnLow:                   func(p Project) interface{} { return p.Metrics.Low },
+	MetricsReportColumnUnassigned:            func(p Project) interface{} { return p.Metrics.Unassigned },
+	MetricsReportColumnSuppressed:            func(p Project) interface{} { return p.Metrics.Suppressed },
+	MetricsReportColumnInheritedRiskScore:    func(p Project) interface{} { return p.Metrics.InheritedRiskScore },
+	MetricsReportColumnFindingsUnaudited:     func(p Project) interface{} { return p.Metrics.FindingsUnaudited },
+	MetricsReportColumnPolicyViolationsTotal: func(p Project) interface{} { return p.Metrics.PolicyViolationsTotal },
+	MetricsReportColumnPolicyViolationsFail:  func(p Project) interface{} { return p.Metrics.PolicyViolationsFail },
+	MetricsReportColumnPolicyViolationsWarn:  func(p Project) interface{} { return p.Metrics.PolicyViolationsWarn },
+	MetricsReportColumnPolicyViolationsInfo:  func(p Project) interface{} { return p.Metrics.PolicyViolationsInfo },
+}
+
+type MetricsReportOptions struct {
+	Format  MetricsReportFormat   // Defaults to MetricsReportFormatCSV
+	Columns []MetricsReportColumn // Defaults to DefaultMetricsReportColumns
+}
+
+// ExportPortfolioReport walks all projects in the portfolio, and writes their current metrics to w.
+//
+// CSV reports contain a header row with the column names, followed by one row per project.
+// JSON reports contain an array with one object per project, keyed by column name.
+func (ms MetricsService) ExportPortfolioReport(ctx context.Context, w io.Writer, opts MetricsReportOptions) error {
+	columns := opts.Columns
+	if len(columns) == 0 {
+		columns = DefaultMetricsReportColumns
+	}
+	for _, column := range columns {
+		if _, ok := metricsReportColumnValues[column]; !ok {
+			return fmt.Errorf("unknown column: %s", column)
+		}
+	}
+
+	projects, err := FetchAll(func(po PageOptions) (Page[Project], error) {
+		return ms.client.Project.GetAll(ctx, po)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch projects: %w", err)
+	}
+
+	switch opts.Format {
+	case MetricsReportFormatCSV, "":
+		return writeMetricsReportCSV(w, projects, columns)
+	case MetricsReportFormatJSON:
+		return writeMetricsReportJSON(w, projects, columns)
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+}
+
+func writeMetricsReportCSV(w io.Writer, projects []Project, columns []MetricsReportColumn) error {
+	csvWriter := csv.NewWriter(w)
+
+	record := make([]string, len(columns))
+	for i, column := range columns {
+		record[i] = string(column)
+	}
+	if err := csvWriter.Write(record); err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		for i, column := range columns {
+			switch value := metricsReportColumnValues[column](project).(type) {
+			case string:
+				record[i] = value
+			case int:
+				record[i] = strconv.Itoa(value)
+			case float64:
+				record[i] = strconv.FormatFloat(value, 'f', -1, 64)
+			default:
+				record[i] = fmt.Sprint(value)
+			}
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+func writeMetricsReportJSON(w io.Writer, projects []Project, columns []MetricsReportColumn) error {
+	rows := make([]map[MetricsReportColumn]interface{}, 0, len(projects))
+	for _, project := range projects {
+		row := make(map[MetricsReportColumn]interface{}, len(columns))
+		for _, column := range columns {
+			row[column] = metricsReportColumnValues[column](project)
+		}
+		rows = append(rows, row)
+	}
+
+	return json.NewEncoder(w).Encode(rows)
+}
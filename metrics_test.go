@@ -0,0 +1,158 @@
+package dtrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsService_History(t *testing.T) {
+	var paths []string
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write([]byte("[]"))
+	}))
+
+	projectUUID := uuid.MustParse("dcde8bcc-85d7-4aa5-9fd0-9e2a7e3cb1b5")
+	date := time.Date(2024, time.March, 7, 23, 30, 0, 0, time.UTC)
+
+	_, err := client.Metrics.PortfolioMetricsSince(context.Background(), date)
+	require.NoError(t, err)
+	_, err = client.Metrics.ProjectMetricsSince(context.Background(), projectUUID, date)
+	require.NoError(t, err)
+	_, err = client.Metrics.ProjectMetricsSinceDays(context.Background(), projectUUID, 30)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"/api/v1/metrics/portfolio/since/20240307",
+		"/api/v1/metrics/project/dcde8bcc-85d7-4aa5-9fd0-9e2a7e3cb1b5/since/20240307",
+		"/api/v1/metrics/project/dcde8bcc-85d7-4aa5-9fd0-9e2a7e3cb1b5/days/30",
+	}, paths)
+}
+
+func TestMetricsService_ComponentMetrics(t *testing.T) {
+	var requests []string
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch path.Base(r.URL.Path) {
+		case "current":
+			_, _ = w.Write([]byte(`{"critical":2,"inheritedRiskScore":20.5}`))
+		case "refresh":
+			w.WriteHeader(http.StatusOK)
+		default:
+			_, _ = w.Write([]byte(`[{"critical":1},{"critical":2}]`))
+		}
+	}))
+
+	componentUUID := uuid.MustParse("6f4a3a1e-0b2c-4d5e-8f90-1a2b3c4d5e6f")
+	date := time.Date(2024, time.March, 7, 23, 30, 0, 0, time.UTC)
+
+	current, err := client.Metrics.LatestComponentMetrics(context.Background(), componentUUID)
+	require.NoError(t, err)
+	require.Equal(t, 2, current.Critical)
+	require.Equal(t, 20.5, current.InheritedRiskScore)
+
+	history, err := client.Metrics.ComponentMetricsSince(context.Background(), componentUUID, date)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, 2, history[1].Critical)
+
+	_, err = client.Metrics.ComponentMetricsSinceDays(context.Background(), componentUUID, 30)
+	require.NoError(t, err)
+
+	err = client.Metrics.RefreshComponentMetrics(context.Background(), componentUUID)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"GET /api/v1/metrics/component/6f4a3a1e-0b2c-4d5e-8f90-1a2b3c4d5e6f/current",
+		"GET /api/v1/metrics/component/6f4a3a1e-0b2c-4d5e-8f90-1a2b3c4d5e6f/since/20240307",
+		"GET /api/v1/metrics/component/6f4a3a1e-0b2c-4d5e-8f90-1a2b3c4d5e6f/days/30",
+		"GET /api/v1/metrics/component/6f4a3a1e-0b2c-4d5e-8f90-1a2b3c4d5e6f/refresh",
+	}, requests)
+}
+
+func TestMetricsService_WaitForProjectMetrics(t *testing.T) {
+	since := time.Now()
+	polls := 0
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		lastOccurrence := since.Add(-time.Hour)
+		if polls == 3 {
+			lastOccurrence = since.Add(time.Second)
+		}
+		_, _ = fmt.Fprintf(w, `{"lastOccurrence":%d}`, lastOccurrence.UnixMilli())
+	}))
+
+	err := client.Metrics.WaitForProjectMetrics(context.Background(), uuid.New(), since, PollingOptions{Interval: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, 3, polls)
+}
+
+func TestMetricsService_ProjectMetricsDelta(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, time.March, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]ProjectMetrics{
+			{FirstOccurrence: int(day(1).UnixMilli()), LastOccurrence: int(day(3).UnixMilli()), Critical: 2, High: 5, InheritedRiskScore: 40},
+			{FirstOccurrence: int(day(4).UnixMilli()), LastOccurrence: int(day(6).UnixMilli()), Critical: 3, High: 4, InheritedRiskScore: 45},
+			{FirstOccurrence: int(day(7).UnixMilli()), LastOccurrence: int(day(9).UnixMilli()), Critical: 3, High: 1, InheritedRiskScore: 30},
+		})
+	}))
+
+	delta, err := client.Metrics.ProjectMetricsDelta(context.Background(), uuid.New(), day(2), day(8))
+	require.NoError(t, err)
+	require.Equal(t, 1, delta.Critical)
+	require.Equal(t, -4, delta.High)
+	require.Equal(t, -10.0, delta.InheritedRiskScore)
+
+	delta, err = client.Metrics.ProjectMetricsDelta(context.Background(), uuid.New(), day(2), day(5))
+	require.NoError(t, err)
+	require.Equal(t, 1, delta.Critical)
+	require.Equal(t, -1, delta.High)
+
+	_, err = client.Metrics.ProjectMetricsDelta(context.Background(), uuid.New(), day(8), day(2))
+	require.Error(t, err)
+}
+
+func TestMetricsService_ExportPortfolioReport(t *testing.T) {
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Total-Count", "2")
+		_ = json.NewEncoder(w).Encode([]Project{
+			{Name: "acme-app", Version: "1.0.0", Metrics: ProjectMetrics{Critical: 1, InheritedRiskScore: 12.5}},
+			{Name: "acme, inc. lib", Version: "2.0.0"},
+		})
+	}))
+
+	columns := []MetricsReportColumn{MetricsReportColumnName, MetricsReportColumnCritical, MetricsReportColumnInheritedRiskScore}
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := client.Metrics.ExportPortfolioReport(context.Background(), &buf, MetricsReportOptions{Columns: columns})
+		require.NoError(t, err)
+		require.Equal(t, "name,critical,inheritedRiskScore\nacme-app,1,12.5\n\"acme, inc. lib\",0,0\n", buf.String())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := client.Metrics.ExportPortfolioReport(context.Background(), &buf, MetricsReportOptions{Format: MetricsReportFormatJSON, Columns: columns})
+		require.NoError(t, err)
+		require.JSONEq(t, `[{"name":"acme-app","critical":1,"inheritedRiskScore":12.5},{"name":"acme, inc. lib","critical":0,"inheritedRiskScore":0}]`, buf.String())
+	})
+
+	t.Run("UnknownColumn", func(t *testing.T) {
+		err := client.Metrics.ExportPortfolioReport(context.Background(), io.Discard, MetricsReportOptions{Columns: []MetricsReportColumn{"foo"}})
+		require.Error(t, err)
+	})
+}
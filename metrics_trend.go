@@ -0,0 +1,86 @@
+package dtrack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProjectMetricsDelta describes how the metrics of a project changed between two points in time.
+//
+// Counts are net changes, e.g. a Critical delta of 1 may result from two new and one resolved
+// critical vulnerability. Positive values indicate a deterioration, negative values an improvement.
+type ProjectMetricsDelta struct {
+	From                  ProjectMetrics
+	To                    ProjectMetrics
+	Critical              int
+	High                  int
+	Medium                int
+	Low                   int
+	Unassigned            int
+	Vulnerabilities       int
+	InheritedRiskScore    float64
+	PolicyViolationsTotal int
+	PolicyViolationsFail  int
+}
+
+// CompareProjectMetrics computes the delta between two project metrics snapshots.
+func CompareProjectMetrics(from, to ProjectMetrics) ProjectMetricsDelta {
+	return ProjectMetricsDelta{
+		From:                  from,
+		To:                    to,
+		Critical:              to.Critical - from.Critical,
+		High:                  to.High - from.High,
+		Medium:                to.Medium - from.Medium,
+		Low:                   to.Low - from.Low,
+		Unassigned:            to.Unassigned - from.Unassigned,
+		Vulnerabilities:       to.Vulnerabilities - from.Vulnerabilities,
+		InheritedRiskScore:    to.InheritedRiskScore - from.InheritedRiskScore,
+		PolicyViolationsTotal: to.PolicyViolationsTotal - from.PolicyViolationsTotal,
+		PolicyViolationsFail:  to.PolicyViolationsFail - from.PolicyViolationsFail,
+	}
+}
+
+// ProjectMetricsDelta fetches the metrics history of a project, and computes the delta between
+// the metrics that were in effect at from and at to. If the history doesn't reach back to from,
+// the earliest available metrics are used instead.
+func (ms MetricsService) ProjectMetricsDelta(ctx context.Context, projectUUID uuid.UUID, from, to time.Time) (d ProjectMetricsDelta, err error) {
+	if to.Before(from) {
+		err = fmt.Errorf("to (%s) must not be before from (%s)", to, from)
+		return
+	}
+
+	history, err := ms.ProjectMetricsSince(ctx, projectUUID, from)
+	if err != nil {
+		return
+	}
+
+	fromMetrics, ok := metricsInEffectAt(history, from)
+	if !ok && len(history) > 0 {
+		fromMetrics = history[0]
+	}
+
+	toMetrics, ok := metricsInEffectAt(history, to)
+	if !ok {
+		err = fmt.Errorf("no metrics available for project %s at %s", projectUUID, to)
+		return
+	}
+
+	d = CompareProjectMetrics(fromMetrics, toMetrics)
+	return
+}
+
+// metricsInEffectAt returns the latest metrics from history that were recorded at or before t.
+func metricsInEffectAt(history []ProjectMetrics, t time.Time) (m ProjectMetrics, ok bool) {
+	for _, metrics := range history {
+		if int64(metrics.FirstOccurrence) <= t.UnixMilli() &&
+			(!ok || metrics.FirstOccurrence >= m.FirstOccurrence) {
+			m = metrics
+			ok = true
+		}
+	}
+
+	return
+}
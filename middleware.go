@@ -0,0 +1,55 @@
+package dtrack
+
+import (
+	"io"
+	"net/http"
+)
+
+// RequestMiddleware may modify a request before it is sent.
+// Returning an error aborts the request.
+type RequestMiddleware func(req *http.Request) error
+
+// ResponseHook is invoked with a response after it was received, and before the client
+// processes it. Returning an error fails the request as if the response was never received,
+// so it may be retried according to the client's RetryOptions.
+type ResponseHook func(res *http.Response) error
+
+// WithRequestMiddleware adds middleware that is invoked for every request
+// the client sends, including retries. Middleware is invoked in the order it was added.
+func WithRequestMiddleware(middleware RequestMiddleware) ClientOption {
+	return func(c *Client) error {
+		c.requestMiddleware = append(c.requestMiddleware, middleware)
+		return nil
+	}
+}
+
+// WithResponseHook adds a hook that is invoked for every response the client receives,
+// including those of retried requests. Hooks are invoked in the order they were added.
+func WithResponseHook(hook ResponseHook) ClientOption {
+	return func(c *Client) error {
+		c.responseHooks = append(c.responseHooks, hook)
+		return nil
+	}
+}
+
+func (c Client) applyRequestMiddleware(req *http.Request) error {
+	for _, middleware := range c.requestMiddleware {
+		if err := middleware(req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c Client) applyResponseHooks(res *http.Response) error {
+	for _, hook := range c.responseHooks {
+		if err := hook(res); err != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package dtrack
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRequestMiddleware(t *testing.T) {
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Tenant") != "acme" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}), WithRequestMiddleware(func(req *http.Request) error {
+		req.Header.Set("X-Tenant", "acme")
+		return nil
+	}))
+
+	_, err := client.Metrics.LatestPortfolioMetrics(context.Background())
+	require.NoError(t, err)
+
+	failingErr := errors.New("nope")
+	client = setUpTestServer(t, "4.11.0", nil, WithRequestMiddleware(func(req *http.Request) error {
+		if req.URL.Path == "/api/version" {
+			return nil
+		}
+		return failingErr
+	}))
+
+	_, err = client.Metrics.LatestPortfolioMetrics(context.Background())
+	require.ErrorIs(t, err, failingErr)
+}
+
+func TestWithResponseHook(t *testing.T) {
+	var (
+		chaosErr = errors.New("chaos")
+		statuses []int
+		attempts int
+	)
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}), WithRetry(RetryOptions{MaxRetries: 1, InitialBackoff: 1}), WithResponseHook(func(res *http.Response) error {
+		statuses = append(statuses, res.StatusCode)
+		return nil
+	}), WithResponseHook(func(res *http.Response) error {
+		if res.Request.URL.Path == "/api/version" {
+			return nil
+		}
+		attempts++
+		if attempts == 1 {
+			return chaosErr
+		}
+		return nil
+	}))
+
+	// The first attempt fails due to the hook, and is retried.
+	_, err := client.Metrics.LatestPortfolioMetrics(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, attempts)
+	require.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusOK}, statuses)
+
+	// Without retries, the hook's error is returned.
+	attempts = 0
+	client.retryOptions.MaxRetries = 0
+	_, err = client.Metrics.LatestPortfolioMetrics(context.Background())
+	require.ErrorIs(t, err, chaosErr)
+}
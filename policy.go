@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/google/uuid"
 )
@@ -94,6 +95,7 @@ func (ps PolicyService) Update(ctx context.Context, policy Policy) (p Policy, er
 	return
 }
 
+// AddProject limits the scope of a policy to a given project, in addition to already assigned projects.
 func (ps PolicyService) AddProject(ctx context.Context, policyUUID, projectUUID uuid.UUID) (p Policy, err error) {
 	req, err := ps.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("api/v1/policy/%s/project/%s", policyUUID, projectUUID))
 	if err != nil {
@@ -104,6 +106,7 @@ func (ps PolicyService) AddProject(ctx context.Context, policyUUID, projectUUID
 	return
 }
 
+// DeleteProject removes a project from the scope of a policy.
 func (ps PolicyService) DeleteProject(ctx context.Context, policyUUID, projectUUID uuid.UUID) (p Policy, err error) {
 	req, err := ps.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/policy/%s/project/%s", policyUUID, projectUUID))
 	if err != nil {
@@ -114,8 +117,9 @@ func (ps PolicyService) DeleteProject(ctx context.Context, policyUUID, projectUU
 	return
 }
 
+// AddTag limits the scope of a policy to projects with a given tag, in addition to already assigned tags.
 func (ps PolicyService) AddTag(ctx context.Context, policyUUID uuid.UUID, tagName string) (p Policy, err error) {
-	req, err := ps.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("api/v1/policy/%s/tag/%s", policyUUID, tagName))
+	req, err := ps.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("api/v1/policy/%s/tag/%s", policyUUID, url.PathEscape(tagName)))
 	if err != nil {
 		return
 	}
@@ -124,8 +128,9 @@ func (ps PolicyService) AddTag(ctx context.Context, policyUUID uuid.UUID, tagNam
 	return
 }
 
+// DeleteTag removes a tag from the scope of a policy.
 func (ps PolicyService) DeleteTag(ctx context.Context, policyUUID uuid.UUID, tagName string) (p Policy, err error) {
-	req, err := ps.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/policy/%s/tag/%s", policyUUID, tagName))
+	req, err := ps.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/policy/%s/tag/%s", policyUUID, url.PathEscape(tagName)))
 	if err != nil {
 		return
 	}
@@ -0,0 +1,250 @@
+package policy
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+)
+
+func condition(subject dtrack.PolicyConditionSubject, operator dtrack.PolicyConditionOperator, value string, violationType dtrack.PolicyViolationType) dtrack.PolicyCondition {
+	return dtrack.PolicyCondition{
+		Subject:       subject,
+		Operator:      operator,
+		Value:         value,
+		ViolationType: violationType,
+	}
+}
+
+type SeverityLevel string
+
+const (
+	Critical   SeverityLevel = "CRITICAL"
+	High       SeverityLevel = "HIGH"
+	Medium     SeverityLevel = "MEDIUM"
+	Low        SeverityLevel = "LOW"
+	Info       SeverityLevel = "INFO"
+	Unassigned SeverityLevel = "UNASSIGNED"
+)
+
+// severityLevels holds all severity levels, ordered from highest to lowest.
+var severityLevels = []SeverityLevel{Critical, High, Medium, Low, Info, Unassigned}
+
+type SeverityBuilder struct{}
+
+// Severity builds conditions on the severity of vulnerabilities.
+func Severity() SeverityBuilder {
+	return SeverityBuilder{}
+}
+
+func (SeverityBuilder) Is(severity SeverityLevel) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectSeverity, dtrack.PolicyConditionOperatorIs, string(severity), dtrack.PolicyViolationTypeSecurity)
+}
+
+func (SeverityBuilder) IsNot(severity SeverityLevel) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectSeverity, dtrack.PolicyConditionOperatorIsNot, string(severity), dtrack.PolicyViolationTypeSecurity)
+}
+
+// AtLeast emits one condition for the given severity, and one for every severity above it.
+//
+// Dependency-Track does not support ordering comparisons for severities,
+// so the emitted conditions must be part of a policy with operator ANY.
+func (sb SeverityBuilder) AtLeast(severity SeverityLevel) []dtrack.PolicyCondition {
+	var conditions []dtrack.PolicyCondition
+	for _, level := range severityLevels {
+		conditions = append(conditions, sb.Is(level))
+		if level == severity {
+			return conditions
+		}
+	}
+
+	// Unknown severity level; don't emit conditions that would match more than intended.
+	return nil
+}
+
+type LicenseBuilder struct{}
+
+// License builds conditions on the license of components.
+func License() LicenseBuilder {
+	return LicenseBuilder{}
+}
+
+// Is matches components with the license identified by licenseUUID.
+func (LicenseBuilder) Is(licenseUUID uuid.UUID) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectLicense, dtrack.PolicyConditionOperatorIs, licenseUUID.String(), dtrack.PolicyViolationTypeLicense)
+}
+
+// IsNot matches components without the license identified by licenseUUID.
+func (LicenseBuilder) IsNot(licenseUUID uuid.UUID) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectLicense, dtrack.PolicyConditionOperatorIsNot, licenseUUID.String(), dtrack.PolicyViolationTypeLicense)
+}
+
+// IsUnresolved matches components whose license could not be resolved.
+func (LicenseBuilder) IsUnresolved() dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectLicense, dtrack.PolicyConditionOperatorIs, "unresolved", dtrack.PolicyViolationTypeLicense)
+}
+
+// InGroup matches components with a license that is part of the license group identified by groupUUID.
+// Note that Dependency-Track references license groups by UUID, not by name.
+func (LicenseBuilder) InGroup(groupUUID uuid.UUID) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectLicenseGroup, dtrack.PolicyConditionOperatorIs, groupUUID.String(), dtrack.PolicyViolationTypeLicense)
+}
+
+// NotInGroup matches components with a license that is not part of the license group identified by groupUUID.
+func (LicenseBuilder) NotInGroup(groupUUID uuid.UUID) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectLicenseGroup, dtrack.PolicyConditionOperatorIsNot, groupUUID.String(), dtrack.PolicyViolationTypeLicense)
+}
+
+// RegexBuilder builds conditions on subjects that are matched using regular expressions.
+type RegexBuilder struct {
+	subject dtrack.PolicyConditionSubject
+}
+
+// PackageURL builds conditions on the package URL of components.
+func PackageURL() RegexBuilder {
+	return RegexBuilder{subject: dtrack.PolicyConditionSubjectPackageURL}
+}
+
+// CPE builds conditions on the CPE of components.
+func CPE() RegexBuilder {
+	return RegexBuilder{subject: dtrack.PolicyConditionSubjectCPE}
+}
+
+// SWIDTagID builds conditions on the SWID tag ID of components.
+func SWIDTagID() RegexBuilder {
+	return RegexBuilder{subject: dtrack.PolicyConditionSubjectSWIDTagID}
+}
+
+func (rb RegexBuilder) Matches(pattern string) dtrack.PolicyCondition {
+	return condition(rb.subject, dtrack.PolicyConditionOperatorMatches, pattern, dtrack.PolicyViolationTypeOperational)
+}
+
+func (rb RegexBuilder) NoMatch(pattern string) dtrack.PolicyCondition {
+	return condition(rb.subject, dtrack.PolicyConditionOperatorNoMatch, pattern, dtrack.PolicyViolationTypeOperational)
+}
+
+// NumericBuilder builds conditions on subjects that support numeric comparisons.
+type NumericBuilder struct {
+	subject       dtrack.PolicyConditionSubject
+	violationType dtrack.PolicyViolationType
+}
+
+// Version builds conditions on the version of components.
+func Version() NumericBuilder {
+	return NumericBuilder{subject: dtrack.PolicyConditionSubjectVersion, violationType: dtrack.PolicyViolationTypeOperational}
+}
+
+// Age builds conditions on the age of components.
+// Values must be ISO-8601 periods, e.g. P1Y.
+func Age() NumericBuilder {
+	return NumericBuilder{subject: dtrack.PolicyConditionSubjectAge, violationType: dtrack.PolicyViolationTypeOperational}
+}
+
+func (nb NumericBuilder) Equal(value string) dtrack.PolicyCondition {
+	return condition(nb.subject, dtrack.PolicyConditionOperatorNumericEqual, value, nb.violationType)
+}
+
+func (nb NumericBuilder) NotEqual(value string) dtrack.PolicyCondition {
+	return condition(nb.subject, dtrack.PolicyConditionOperatorNumericNotEqual, value, nb.violationType)
+}
+
+func (nb NumericBuilder) GreaterThan(value string) dtrack.PolicyCondition {
+	return condition(nb.subject, dtrack.PolicyConditionOperatorNumericGreaterThan, value, nb.violationType)
+}
+
+func (nb NumericBuilder) GreaterThanOrEqual(value string) dtrack.PolicyCondition {
+	return condition(nb.subject, dtrack.PolicyConditionOperatorNumericGreaterThanOrEqual, value, nb.violationType)
+}
+
+func (nb NumericBuilder) LessThan(value string) dtrack.PolicyCondition {
+	return condition(nb.subject, dtrack.PolicyConditionOperatorNumericLessThan, value, nb.violationType)
+}
+
+func (nb NumericBuilder) LessThanOrEqual(value string) dtrack.PolicyCondition {
+	return condition(nb.subject, dtrack.PolicyConditionOperatorNumericLesserThanOrEqual, value, nb.violationType)
+}
+
+type EPSSBuilder struct{}
+
+// EPSS builds conditions on the EPSS score of vulnerabilities.
+func EPSS() EPSSBuilder {
+	return EPSSBuilder{}
+}
+
+func (EPSSBuilder) formatScore(score float64) string {
+	return strconv.FormatFloat(score, 'f', -1, 64)
+}
+
+func (eb EPSSBuilder) GreaterThan(score float64) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectEPSS, dtrack.PolicyConditionOperatorNumericGreaterThan, eb.formatScore(score), dtrack.PolicyViolationTypeSecurity)
+}
+
+func (eb EPSSBuilder) GreaterThanOrEqual(score float64) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectEPSS, dtrack.PolicyConditionOperatorNumericGreaterThanOrEqual, eb.formatScore(score), dtrack.PolicyViolationTypeSecurity)
+}
+
+type CWEBuilder struct{}
+
+// CWE builds conditions on the CWEs of vulnerabilities.
+func CWE() CWEBuilder {
+	return CWEBuilder{}
+}
+
+func (CWEBuilder) formatIDs(ids []int) string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, strconv.Itoa(id))
+	}
+	return strings.Join(values, ",")
+}
+
+func (cb CWEBuilder) ContainsAny(ids ...int) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectCWE, dtrack.PolicyConditionOperatorContainsAny, cb.formatIDs(ids), dtrack.PolicyViolationTypeSecurity)
+}
+
+func (cb CWEBuilder) ContainsAll(ids ...int) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectCWE, dtrack.PolicyConditionOperatorContainsAll, cb.formatIDs(ids), dtrack.PolicyViolationTypeSecurity)
+}
+
+type VulnerabilityIDBuilder struct{}
+
+// VulnerabilityID builds conditions on the ID of vulnerabilities, e.g. CVE-2021-44228.
+func VulnerabilityID() VulnerabilityIDBuilder {
+	return VulnerabilityIDBuilder{}
+}
+
+func (VulnerabilityIDBuilder) Is(vulnID string) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectVulnerabilityID, dtrack.PolicyConditionOperatorIs, vulnID, dtrack.PolicyViolationTypeSecurity)
+}
+
+func (VulnerabilityIDBuilder) IsNot(vulnID string) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectVulnerabilityID, dtrack.PolicyConditionOperatorIsNot, vulnID, dtrack.PolicyViolationTypeSecurity)
+}
+
+type CoordinatesBuilder struct{}
+
+// Coordinates builds conditions on the group, name and version of components.
+// Each coordinate is a regular expression; empty coordinates are ignored by the server.
+func Coordinates() CoordinatesBuilder {
+	return CoordinatesBuilder{}
+}
+
+func (CoordinatesBuilder) formatCoordinates(group, name, version string) string {
+	// Marshalling a struct of strings can't fail.
+	value, _ := json.Marshal(struct {
+		Group   string `json:"group"`
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}{group, name, version})
+	return string(value)
+}
+
+func (cb CoordinatesBuilder) Matches(group, name, version string) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectCoordinates, dtrack.PolicyConditionOperatorMatches, cb.formatCoordinates(group, name, version), dtrack.PolicyViolationTypeOperational)
+}
+
+func (cb CoordinatesBuilder) NoMatch(group, name, version string) dtrack.PolicyCondition {
+	return condition(dtrack.PolicyConditionSubjectCoordinates, dtrack.PolicyConditionOperatorNoMatch, cb.formatCoordinates(group, name, version), dtrack.PolicyViolationTypeOperational)
+}
@@ -0,0 +1,37 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeverityBuilder_AtLeast(t *testing.T) {
+	require.Equal(t, []dtrack.PolicyCondition{
+		{Subject: dtrack.PolicyConditionSubjectSeverity, Operator: dtrack.PolicyConditionOperatorIs, Value: "CRITICAL", ViolationType: dtrack.PolicyViolationTypeSecurity},
+		{Subject: dtrack.PolicyConditionSubjectSeverity, Operator: dtrack.PolicyConditionOperatorIs, Value: "HIGH", ViolationType: dtrack.PolicyViolationTypeSecurity},
+	}, Severity().AtLeast(High))
+
+	require.Len(t, Severity().AtLeast(Unassigned), 6)
+	require.Nil(t, Severity().AtLeast("foo"))
+}
+
+func TestLicenseBuilder_InGroup(t *testing.T) {
+	groupUUID := uuid.New()
+	require.Equal(t, dtrack.PolicyCondition{
+		Subject:       dtrack.PolicyConditionSubjectLicenseGroup,
+		Operator:      dtrack.PolicyConditionOperatorIs,
+		Value:         groupUUID.String(),
+		ViolationType: dtrack.PolicyViolationTypeLicense,
+	}, License().InGroup(groupUUID))
+}
+
+func TestCWEBuilder_ContainsAny(t *testing.T) {
+	require.Equal(t, "79,89", CWE().ContainsAny(79, 89).Value)
+}
+
+func TestCoordinatesBuilder_Matches(t *testing.T) {
+	require.Equal(t, `{"group":"org.example","name":".*","version":""}`, Coordinates().Matches("org.example", ".*", "").Value)
+}
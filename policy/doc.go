@@ -0,0 +1,14 @@
+// Package policy provides a fluent builder for Dependency-Track policy conditions.
+//
+// Dependency-Track expects a specific combination of operator and value format
+// for every condition subject. The builders in this package only expose valid
+// combinations, and take care of formatting values, for example:
+//
+//	conditions := []dtrack.PolicyCondition{
+//		policy.License().InGroup(copyleftGroupUUID),
+//		policy.PackageURL().Matches("^pkg:maven/org\\.example/.*$"),
+//	}
+//	conditions = append(conditions, policy.Severity().AtLeast(policy.High)...)
+//
+// The violation type of the emitted conditions is set according to their subject.
+package policy
@@ -9,13 +9,22 @@ import (
 )
 
 type PolicyCondition struct {
-	UUID     uuid.UUID               `json:"uuid,omitempty"`
-	Policy   *Policy                 `json:"policy,omitempty"`
-	Operator PolicyConditionOperator `json:"operator"`
-	Subject  PolicyConditionSubject  `json:"subject"`
-	Value    string                  `json:"value"`
+	UUID          uuid.UUID               `json:"uuid,omitempty"`
+	Policy        *Policy                 `json:"policy,omitempty"`
+	Operator      PolicyConditionOperator `json:"operator"`
+	Subject       PolicyConditionSubject  `json:"subject"`
+	Value         string                  `json:"value"`
+	ViolationType PolicyViolationType     `json:"violationType,omitempty"`
 }
 
+type PolicyViolationType string
+
+const (
+	PolicyViolationTypeLicense     PolicyViolationType = "LICENSE"
+	PolicyViolationTypeOperational PolicyViolationType = "OPERATIONAL"
+	PolicyViolationTypeSecurity    PolicyViolationType = "SECURITY"
+)
+
 type PolicyConditionService struct {
 	client *Client
 }
@@ -52,8 +61,11 @@ const (
 	PolicyConditionSubjectComponentHash   PolicyConditionSubject = "COMPONENT_HASH"
 	PolicyConditionSubjectCWE             PolicyConditionSubject = "CWE"
 	PolicyConditionSubjectVulnerabilityID PolicyConditionSubject = "VULNERABILITY_ID"
+	PolicyConditionSubjectVersionDistance PolicyConditionSubject = "VERSION_DISTANCE"
+	PolicyConditionSubjectEPSS            PolicyConditionSubject = "EPSS" // Since v4.12.0
 )
 
+// Create adds a condition to a given policy.
 func (pcs PolicyConditionService) Create(ctx context.Context, policyUUID uuid.UUID, policyCondition PolicyCondition) (p PolicyCondition, err error) {
 	req, err := pcs.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("api/v1/policy/%s/condition", policyUUID), withBody(policyCondition))
 	if err != nil {
@@ -64,6 +76,7 @@ func (pcs PolicyConditionService) Create(ctx context.Context, policyUUID uuid.UU
 	return
 }
 
+// Update updates a policy condition, as identified by its UUID.
 func (pcs PolicyConditionService) Update(ctx context.Context, policyCondition PolicyCondition) (p PolicyCondition, err error) {
 	req, err := pcs.client.newRequest(ctx, http.MethodPost, "api/v1/policy/condition", withBody(policyCondition))
 	if err != nil {
@@ -74,6 +87,7 @@ func (pcs PolicyConditionService) Update(ctx context.Context, policyCondition Po
 	return
 }
 
+// Delete deletes a policy condition.
 func (pcs PolicyConditionService) Delete(ctx context.Context, policyConditionUUID uuid.UUID) (err error) {
 	req, err := pcs.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/policy/condition/%s", policyConditionUUID))
 	if err != nil {
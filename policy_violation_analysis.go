@@ -2,6 +2,7 @@ package dtrack
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -15,6 +16,17 @@ const (
 	ViolationAnalysisStateRejected ViolationAnalysisState = "REJECTED"
 )
 
+// IsValid determines whether s is a known violation analysis state.
+func (s ViolationAnalysisState) IsValid() bool {
+	switch s {
+	case ViolationAnalysisStateNotSet,
+		ViolationAnalysisStateApproved,
+		ViolationAnalysisStateRejected:
+		return true
+	}
+	return false
+}
+
 type ViolationAnalysis struct {
 	Comments   []ViolationAnalysisComment `json:"analysisComments"`
 	State      ViolationAnalysisState     `json:"analysisState"`
@@ -35,10 +47,26 @@ type ViolationAnalysisRequest struct {
 	Suppressed      *bool                  `json:"isSuppressed,omitempty"`
 }
 
+// validate ensures that all values of the request that are set are valid.
+func (vr ViolationAnalysisRequest) validate() error {
+	if vr.State != "" && !vr.State.IsValid() {
+		return fmt.Errorf("invalid violation analysis state: %s", vr.State)
+	}
+	return nil
+}
+
+// ViolationAnalysisDecision describes an analysis decision to apply to multiple policy violations.
+type ViolationAnalysisDecision struct {
+	State      ViolationAnalysisState
+	Comment    string
+	Suppressed *bool
+}
+
 type ViolationAnalysisService struct {
 	client *Client
 }
 
+// Get fetches the analysis of a policy violation affecting a given component.
 func (vas ViolationAnalysisService) Get(ctx context.Context, componentUUID, policyViolationUUID uuid.UUID) (va ViolationAnalysis, err error) {
 	params := map[string]string{
 		"component":       componentUUID.String(),
@@ -54,7 +82,14 @@ func (vas ViolationAnalysisService) Get(ctx context.Context, componentUUID, poli
 	return
 }
 
+// Update records an analysis decision for a policy violation, creating the analysis if necessary.
+// Invalid states are rejected without contacting the server.
 func (vas ViolationAnalysisService) Update(ctx context.Context, analysisReq ViolationAnalysisRequest) (va ViolationAnalysis, err error) {
+	err = analysisReq.validate()
+	if err != nil {
+		return
+	}
+
 	req, err := vas.client.newRequest(ctx, http.MethodPut, "api/v1/violation/analysis", withBody(analysisReq))
 	if err != nil {
 		return
@@ -63,3 +98,42 @@ func (vas ViolationAnalysisService) Update(ctx context.Context, analysisReq Viol
 	_, err = vas.client.doRequest(req, &va)
 	return
 }
+
+// AddComment appends a comment to the analysis trail of a policy violation,
+// without modifying the analysis decision itself.
+func (vas ViolationAnalysisService) AddComment(ctx context.Context, componentUUID, policyViolationUUID uuid.UUID, comment string) (va ViolationAnalysis, err error) {
+	return vas.Update(ctx, ViolationAnalysisRequest{
+		Component:       componentUUID,
+		PolicyViolation: policyViolationUUID,
+		Comment:         comment,
+	})
+}
+
+// BulkUpdate applies the same analysis decision to all given policy violations,
+// with at most concurrency updates being performed at a time.
+// If updating any of the violations fails, a *BulkError[uuid.UUID] is returned,
+// holding the errors keyed by the UUID of the respective violation.
+func (vas ViolationAnalysisService) BulkUpdate(ctx context.Context, violations []PolicyViolation, decision ViolationAnalysisDecision, concurrency int) error {
+	errs := forEachConcurrently(ctx, violations, concurrency, func(ctx context.Context, violation PolicyViolation) error {
+		_, err := vas.Update(ctx, ViolationAnalysisRequest{
+			Component:       violation.Component.UUID,
+			PolicyViolation: violation.UUID,
+			Comment:         decision.Comment,
+			State:           decision.State,
+			Suppressed:      decision.Suppressed,
+		})
+		return err
+	})
+
+	bulkErr := &BulkError[uuid.UUID]{Errors: make(map[uuid.UUID]error)}
+	for i, err := range errs {
+		if err != nil {
+			bulkErr.Errors[violations[i].UUID] = err
+		}
+	}
+	if len(bulkErr.Errors) > 0 {
+		return bulkErr
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package dtrack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViolationAnalysisService_BulkUpdate(t *testing.T) {
+	var (
+		failingViolation = uuid.New()
+		mutex            sync.Mutex
+		updated          []uuid.UUID
+	)
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var analysisReq ViolationAnalysisRequest
+		if err := json.NewDecoder(r.Body).Decode(&analysisReq); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if analysisReq.PolicyViolation == failingViolation {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if analysisReq.State != ViolationAnalysisStateApproved || analysisReq.Comment != "waived" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mutex.Lock()
+		updated = append(updated, analysisReq.PolicyViolation)
+		mutex.Unlock()
+
+		_, _ = w.Write([]byte(`{"analysisState":"APPROVED","isSuppressed":true}`))
+	}))
+
+	violations := []PolicyViolation{{UUID: uuid.New()}, {UUID: failingViolation}, {UUID: uuid.New()}}
+
+	err := client.ViolationAnalysis.BulkUpdate(context.Background(), violations, ViolationAnalysisDecision{
+		State:      ViolationAnalysisStateApproved,
+		Comment:    "waived",
+		Suppressed: OptionalBoolOf(true),
+	}, 2)
+
+	var bulkErr *BulkError[uuid.UUID]
+	require.True(t, errors.As(err, &bulkErr))
+	require.Len(t, bulkErr.Errors, 1)
+	require.Contains(t, bulkErr.Errors, failingViolation)
+	require.ElementsMatch(t, []uuid.UUID{violations[0].UUID, violations[2].UUID}, updated)
+}
+
+func TestViolationAnalysisState_IsValid(t *testing.T) {
+	require.True(t, ViolationAnalysisStateApproved.IsValid())
+	require.False(t, ViolationAnalysisState("FOO").IsValid())
+}
+
+func TestViolationAnalysisService_Update_InvalidRequest(t *testing.T) {
+	client := setUpTestServer(t, "4.11.0", nil)
+
+	_, err := client.ViolationAnalysis.Update(context.Background(), ViolationAnalysisRequest{
+		State: "FOO",
+	})
+	require.EqualError(t, err, "invalid violation analysis state: FOO")
+}
+
+func TestViolationAnalysisService_AddComment(t *testing.T) {
+	component, violation := uuid.New(), uuid.New()
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/api/v1/violation/analysis", r.URL.Path)
+
+		// The analysis decision itself must not be part of the request.
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, fmt.Sprintf(`{"component":%q,"policyViolation":%q,"comment":"Waived"}`, component, violation), string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"analysisState":"APPROVED","analysisComments":[{"comment":"Waived","commenter":"admin"}]}`))
+	}))
+
+	analysis, err := client.ViolationAnalysis.AddComment(context.Background(), component, violation, "Waived")
+	require.NoError(t, err)
+	require.Equal(t, ViolationAnalysisStateApproved, analysis.State)
+	require.Equal(t, []ViolationAnalysisComment{{Comment: "Waived", Commenter: "admin"}}, analysis.Comments)
+}
@@ -0,0 +1,7 @@
+// Package policysync provides the functionality to manage Dependency-Track policies as code.
+//
+// Policies are declared as Go structs, or loaded from YAML documents, and reconciled
+// with the policies that exist on the server: missing policies are created, diverging
+// policies are updated, and, if requested, policies that are not declared are deleted.
+// Policies are matched by their name.
+package policysync
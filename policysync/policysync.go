@@ -0,0 +1,312 @@
+package policysync
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// Policy is the declarative representation of a policy.
+type Policy struct {
+	Name            string                      `yaml:"name"`
+	Operator        dtrack.PolicyOperator       `yaml:"operator"`
+	ViolationState  dtrack.PolicyViolationState `yaml:"violationState"`
+	IncludeChildren bool                        `yaml:"includeChildren,omitempty"`
+	Conditions      []Condition                 `yaml:"conditions,omitempty"`
+	Tags            []string                    `yaml:"tags,omitempty"`
+}
+
+// Condition is the declarative representation of a policy condition.
+type Condition struct {
+	Subject  dtrack.PolicyConditionSubject  `yaml:"subject"`
+	Operator dtrack.PolicyConditionOperator `yaml:"operator"`
+	Value    string                         `yaml:"value"`
+
+	// ViolationType of the condition. When empty, the violation type of an existing
+	// condition is retained, e.g. when it was set by the server or via the UI.
+	ViolationType dtrack.PolicyViolationType `yaml:"violationType,omitempty"`
+}
+
+type policiesDocument struct {
+	Policies []Policy `yaml:"policies"`
+}
+
+// LoadYAML reads policy declarations from a YAML document of the following structure:
+//
+//	policies:
+//	  - name: No Copyleft
+//	    operator: ANY
+//	    violationState: FAIL
+//	    conditions:
+//	      - subject: LICENSE_GROUP
+//	        operator: IS
+//	        value: 7a3b9e2c-4f1d-4c8a-9b6e-2d5f8a1c3e70 # UUID of the license group
+//	        violationType: LICENSE
+//
+// Like in the API, license groups and licenses are referenced by UUID, not by name.
+func LoadYAML(r io.Reader) ([]Policy, error) {
+	var doc policiesDocument
+	if err := yaml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode policies: %w", err)
+	}
+
+	return doc.Policies, nil
+}
+
+type ActionType string
+
+const (
+	ActionCreatePolicy    ActionType = "CREATE_POLICY"
+	ActionUpdatePolicy    ActionType = "UPDATE_POLICY"
+	ActionDeletePolicy    ActionType = "DELETE_POLICY"
+	ActionCreateCondition ActionType = "CREATE_CONDITION"
+	ActionUpdateCondition ActionType = "UPDATE_CONDITION"
+	ActionDeleteCondition ActionType = "DELETE_CONDITION"
+	ActionAddTag          ActionType = "ADD_TAG"
+	ActionRemoveTag       ActionType = "REMOVE_TAG"
+)
+
+// Action is a single modification necessary to reconcile the server's policies with their declarations.
+type Action struct {
+	Type       ActionType
+	Policy     string    // Name of the policy
+	PolicyUUID uuid.UUID // UUID of the policy, uuid.Nil if the policy is yet to be created
+	Condition  *dtrack.PolicyCondition
+	Tag        string
+}
+
+func (a Action) String() string {
+	switch a.Type {
+	case ActionCreateCondition, ActionUpdateCondition, ActionDeleteCondition:
+		return fmt.Sprintf("%s %q: %s %s %s", a.Type, a.Policy, a.Condition.Subject, a.Condition.Operator, a.Condition.Value)
+	case ActionAddTag, ActionRemoveTag:
+		return fmt.Sprintf("%s %q: %s", a.Type, a.Policy, a.Tag)
+	default:
+		return fmt.Sprintf("%s %q", a.Type, a.Policy)
+	}
+}
+
+type Options struct {
+	Prune  bool // Delete policies that exist on the server, but are not declared
+	DryRun bool // Only plan the necessary actions, without performing them
+}
+
+// Sync reconciles the policies on the server with the given declarations.
+// It returns the actions that were performed, or that would have been performed in dry-run mode.
+// When performing an action fails, the actions performed until then are returned along with the error.
+func Sync(ctx context.Context, client *dtrack.Client, policies []Policy, opts Options) ([]Action, error) {
+	existing, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Policy], error) {
+		return client.Policy.GetAll(ctx, po)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch policies: %w", err)
+	}
+
+	actions, err := Plan(policies, existing, opts.Prune)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		return actions, nil
+	}
+
+	return apply(ctx, client, policies, actions)
+}
+
+// Plan determines the actions necessary to reconcile existing policies with the given declarations.
+func Plan(policies []Policy, existing []dtrack.Policy, prune bool) ([]Action, error) {
+	existingByName := make(map[string]dtrack.Policy, len(existing))
+	for _, policy := range existing {
+		existingByName[policy.Name] = policy
+	}
+
+	var actions []Action
+	declared := make(map[string]struct{}, len(policies))
+	for _, policy := range policies {
+		if policy.Name == "" {
+			return nil, fmt.Errorf("policy name must not be empty")
+		}
+		if _, ok := declared[policy.Name]; ok {
+			return nil, fmt.Errorf("policy %q is declared more than once", policy.Name)
+		}
+		declared[policy.Name] = struct{}{}
+
+		current, ok := existingByName[policy.Name]
+		if !ok {
+			actions = append(actions, Action{Type: ActionCreatePolicy, Policy: policy.Name})
+		} else if current.Operator != policy.Operator ||
+			current.ViolationState != policy.ViolationState ||
+			current.IncludeChildren != policy.IncludeChildren {
+			actions = append(actions, Action{Type: ActionUpdatePolicy, Policy: policy.Name, PolicyUUID: current.UUID})
+		}
+
+		actions = append(actions, planConditions(policy, current)...)
+		actions = append(actions, planTags(policy, current)...)
+	}
+
+	if prune {
+		for _, policy := range existing {
+			if _, ok := declared[policy.Name]; !ok {
+				actions = append(actions, Action{Type: ActionDeletePolicy, Policy: policy.Name, PolicyUUID: policy.UUID})
+			}
+		}
+	}
+
+	return actions, nil
+}
+
+func conditionKey(subject dtrack.PolicyConditionSubject, operator dtrack.PolicyConditionOperator, value string) string {
+	return fmt.Sprintf("%s|%s|%s", subject, operator, value)
+}
+
+func planConditions(policy Policy, current dtrack.Policy) (actions []Action) {
+	// Conditions are matched by subject, operator and value.
+	// Multiple identical conditions are tracked by count.
+	// Matched conditions whose violation type differs are updated, unless no violation type is declared.
+	unmatched := make(map[string][]dtrack.PolicyCondition)
+	for _, condition := range current.PolicyConditions {
+		key := conditionKey(condition.Subject, condition.Operator, condition.Value)
+		unmatched[key] = append(unmatched[key], condition)
+	}
+
+	for _, condition := range policy.Conditions {
+		key := conditionKey(condition.Subject, condition.Operator, condition.Value)
+		if candidates := unmatched[key]; len(candidates) > 0 {
+			// Prefer a candidate with the same violation type, so that no update is necessary.
+			match := 0
+			for i := range candidates {
+				if candidates[i].ViolationType == condition.ViolationType {
+					match = i
+					break
+				}
+			}
+
+			matched := candidates[match]
+			unmatched[key] = append(candidates[:match:match], candidates[match+1:]...)
+
+			if condition.ViolationType != "" && matched.ViolationType != condition.ViolationType {
+				matched.ViolationType = condition.ViolationType
+				actions = append(actions, Action{Type: ActionUpdateCondition, Policy: policy.Name, PolicyUUID: current.UUID, Condition: &matched})
+			}
+			continue
+		}
+
+		actions = append(actions, Action{
+			Type:       ActionCreateCondition,
+			Policy:     policy.Name,
+			PolicyUUID: current.UUID,
+			Condition: &dtrack.PolicyCondition{
+				Subject:       condition.Subject,
+				Operator:      condition.Operator,
+				Value:         condition.Value,
+				ViolationType: condition.ViolationType,
+			},
+		})
+	}
+
+	for _, condition := range current.PolicyConditions {
+		key := conditionKey(condition.Subject, condition.Operator, condition.Value)
+		for _, unmatchedCondition := range unmatched[key] {
+			if unmatchedCondition.UUID == condition.UUID {
+				condition := condition
+				actions = append(actions, Action{Type: ActionDeleteCondition, Policy: policy.Name, PolicyUUID: current.UUID, Condition: &condition})
+				break
+			}
+		}
+	}
+
+	return
+}
+
+func planTags(policy Policy, current dtrack.Policy) (actions []Action) {
+	// Tag names are case-insensitive, and stored in lower case by the server.
+	currentTags := make(map[string]struct{}, len(current.Tags))
+	for _, tag := range current.Tags {
+		currentTags[strings.ToLower(tag.Name)] = struct{}{}
+	}
+
+	declaredTags := make(map[string]struct{}, len(policy.Tags))
+	for _, tag := range policy.Tags {
+		tag = strings.ToLower(tag)
+		if _, ok := declaredTags[tag]; ok {
+			continue
+		}
+		declaredTags[tag] = struct{}{}
+
+		if _, ok := currentTags[tag]; !ok {
+			actions = append(actions, Action{Type: ActionAddTag, Policy: policy.Name, PolicyUUID: current.UUID, Tag: tag})
+		}
+	}
+
+	for _, tag := range current.Tags {
+		if _, ok := declaredTags[strings.ToLower(tag.Name)]; !ok {
+			actions = append(actions, Action{Type: ActionRemoveTag, Policy: policy.Name, PolicyUUID: current.UUID, Tag: tag.Name})
+		}
+	}
+
+	return
+}
+
+func apply(ctx context.Context, client *dtrack.Client, policies []Policy, actions []Action) ([]Action, error) {
+	policiesByName := make(map[string]Policy, len(policies))
+	for _, policy := range policies {
+		policiesByName[policy.Name] = policy
+	}
+
+	// Policies that are yet to be created don't have a UUID during planning.
+	createdUUIDs := make(map[string]uuid.UUID)
+
+	for i, action := range actions {
+		if action.PolicyUUID == uuid.Nil {
+			action.PolicyUUID = createdUUIDs[action.Policy]
+		}
+
+		policy := policiesByName[action.Policy]
+
+		var err error
+		switch action.Type {
+		case ActionCreatePolicy:
+			var created dtrack.Policy
+			created, err = client.Policy.Create(ctx, dtrack.Policy{
+				Name:            policy.Name,
+				Operator:        policy.Operator,
+				ViolationState:  policy.ViolationState,
+				IncludeChildren: policy.IncludeChildren,
+			})
+			createdUUIDs[action.Policy] = created.UUID
+			action.PolicyUUID = created.UUID
+		case ActionUpdatePolicy:
+			_, err = client.Policy.Update(ctx, dtrack.Policy{
+				UUID:            action.PolicyUUID,
+				Name:            policy.Name,
+				Operator:        policy.Operator,
+				ViolationState:  policy.ViolationState,
+				IncludeChildren: policy.IncludeChildren,
+			})
+		case ActionDeletePolicy:
+			err = client.Policy.Delete(ctx, action.PolicyUUID)
+		case ActionCreateCondition:
+			_, err = client.PolicyCondition.Create(ctx, action.PolicyUUID, *action.Condition)
+		case ActionUpdateCondition:
+			_, err = client.PolicyCondition.Update(ctx, *action.Condition)
+		case ActionDeleteCondition:
+			err = client.PolicyCondition.Delete(ctx, action.Condition.UUID)
+		case ActionAddTag:
+			_, err = client.Policy.AddTag(ctx, action.PolicyUUID, action.Tag)
+		case ActionRemoveTag:
+			_, err = client.Policy.DeleteTag(ctx, action.PolicyUUID, action.Tag)
+		}
+		if err != nil {
+			return actions[:i], fmt.Errorf("failed to perform action %s: %w", action, err)
+		}
+
+		actions[i] = action
+	}
+
+	return actions, nil
+}
@@ -0,0 +1,314 @@
+package policysync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadYAML(t *testing.T) {
+	policies, err := LoadYAML(strings.NewReader(`
+policies:
+  - name: No Copyleft
+    operator: ANY
+    violationState: FAIL
+    tags: [production]
+    conditions:
+      - subject: LICENSE_GROUP
+        operator: IS
+        value: 7a3b9e2c-4f1d-4c8a-9b6e-2d5f8a1c3e70
+        violationType: LICENSE
+`))
+	require.NoError(t, err)
+	require.Equal(t, []Policy{
+		{
+			Name:           "No Copyleft",
+			Operator:       dtrack.PolicyOperatorAny,
+			ViolationState: dtrack.PolicyViolationStateFail,
+			Tags:           []string{"production"},
+			Conditions: []Condition{
+				{
+					Subject:       dtrack.PolicyConditionSubjectLicenseGroup,
+					Operator:      dtrack.PolicyConditionOperatorIs,
+					Value:         "7a3b9e2c-4f1d-4c8a-9b6e-2d5f8a1c3e70",
+					ViolationType: dtrack.PolicyViolationTypeLicense,
+				},
+			},
+		},
+	}, policies)
+}
+
+func TestPlan(t *testing.T) {
+	unchangedUUID := uuid.New()
+	changedUUID := uuid.New()
+	undeclaredUUID := uuid.New()
+	staleConditionUUID := uuid.New()
+
+	existing := []dtrack.Policy{
+		{
+			UUID:           unchangedUUID,
+			Name:           "unchanged",
+			Operator:       dtrack.PolicyOperatorAny,
+			ViolationState: dtrack.PolicyViolationStateWarn,
+			PolicyConditions: []dtrack.PolicyCondition{
+				{UUID: uuid.New(), Subject: dtrack.PolicyConditionSubjectAge, Operator: dtrack.PolicyConditionOperatorNumericGreaterThan, Value: "P1Y"},
+			},
+			Tags: []dtrack.Tag{{Name: "foo"}},
+		},
+		{
+			UUID:           changedUUID,
+			Name:           "changed",
+			Operator:       dtrack.PolicyOperatorAny,
+			ViolationState: dtrack.PolicyViolationStateWarn,
+			PolicyConditions: []dtrack.PolicyCondition{
+				{UUID: staleConditionUUID, Subject: dtrack.PolicyConditionSubjectAge, Operator: dtrack.PolicyConditionOperatorNumericGreaterThan, Value: "P1Y"},
+			},
+			Tags: []dtrack.Tag{{Name: "foo"}},
+		},
+		{
+			UUID: undeclaredUUID,
+			Name: "undeclared",
+		},
+	}
+
+	policies := []Policy{
+		{
+			Name:           "unchanged",
+			Operator:       dtrack.PolicyOperatorAny,
+			ViolationState: dtrack.PolicyViolationStateWarn,
+			Conditions: []Condition{
+				{Subject: dtrack.PolicyConditionSubjectAge, Operator: dtrack.PolicyConditionOperatorNumericGreaterThan, Value: "P1Y"},
+			},
+			Tags: []string{"FOO"},
+		},
+		{
+			Name:           "changed",
+			Operator:       dtrack.PolicyOperatorAll,
+			ViolationState: dtrack.PolicyViolationStateWarn,
+			Conditions: []Condition{
+				{Subject: dtrack.PolicyConditionSubjectAge, Operator: dtrack.PolicyConditionOperatorNumericGreaterThan, Value: "P2Y"},
+			},
+			Tags: []string{"bar"},
+		},
+		{
+			Name:           "new",
+			Operator:       dtrack.PolicyOperatorAny,
+			ViolationState: dtrack.PolicyViolationStateFail,
+			Tags:           []string{"baz"},
+		},
+	}
+
+	t.Run("WithoutPrune", func(t *testing.T) {
+		actions, err := Plan(policies, existing, false)
+		require.NoError(t, err)
+		require.Equal(t, []Action{
+			{Type: ActionUpdatePolicy, Policy: "changed", PolicyUUID: changedUUID},
+			{
+				Type:       ActionCreateCondition,
+				Policy:     "changed",
+				PolicyUUID: changedUUID,
+				Condition:  &dtrack.PolicyCondition{Subject: dtrack.PolicyConditionSubjectAge, Operator: dtrack.PolicyConditionOperatorNumericGreaterThan, Value: "P2Y"},
+			},
+			{
+				Type:       ActionDeleteCondition,
+				Policy:     "changed",
+				PolicyUUID: changedUUID,
+				Condition:  &existing[1].PolicyConditions[0],
+			},
+			{Type: ActionAddTag, Policy: "changed", PolicyUUID: changedUUID, Tag: "bar"},
+			{Type: ActionRemoveTag, Policy: "changed", PolicyUUID: changedUUID, Tag: "foo"},
+			{Type: ActionCreatePolicy, Policy: "new"},
+			{Type: ActionAddTag, Policy: "new", Tag: "baz"},
+		}, actions)
+	})
+
+	t.Run("WithPrune", func(t *testing.T) {
+		actions, err := Plan(policies, existing, true)
+		require.NoError(t, err)
+		require.Len(t, actions, 8)
+		require.Equal(t, Action{Type: ActionDeletePolicy, Policy: "undeclared", PolicyUUID: undeclaredUUID}, actions[7])
+	})
+
+	t.Run("ViolationType", func(t *testing.T) {
+		conditionUUID := uuid.New()
+		existing := []dtrack.Policy{
+			{
+				UUID:           changedUUID,
+				Name:           "changed",
+				Operator:       dtrack.PolicyOperatorAny,
+				ViolationState: dtrack.PolicyViolationStateWarn,
+				PolicyConditions: []dtrack.PolicyCondition{
+					{UUID: conditionUUID, Subject: dtrack.PolicyConditionSubjectAge, Operator: dtrack.PolicyConditionOperatorNumericGreaterThan, Value: "P1Y", ViolationType: dtrack.PolicyViolationTypeOperational},
+				},
+			},
+		}
+		policies := []Policy{
+			{
+				Name:           "changed",
+				Operator:       dtrack.PolicyOperatorAny,
+				ViolationState: dtrack.PolicyViolationStateWarn,
+				Conditions: []Condition{
+					{Subject: dtrack.PolicyConditionSubjectAge, Operator: dtrack.PolicyConditionOperatorNumericGreaterThan, Value: "P1Y", ViolationType: dtrack.PolicyViolationTypeSecurity},
+				},
+			},
+		}
+
+		actions, err := Plan(policies, existing, false)
+		require.NoError(t, err)
+		require.Equal(t, []Action{
+			{
+				Type:       ActionUpdateCondition,
+				Policy:     "changed",
+				PolicyUUID: changedUUID,
+				Condition:  &dtrack.PolicyCondition{UUID: conditionUUID, Subject: dtrack.PolicyConditionSubjectAge, Operator: dtrack.PolicyConditionOperatorNumericGreaterThan, Value: "P1Y", ViolationType: dtrack.PolicyViolationTypeSecurity},
+			},
+		}, actions)
+		require.Equal(t, dtrack.PolicyViolationTypeOperational, existing[0].PolicyConditions[0].ViolationType)
+
+		// Without a declared violation type, the existing one is retained.
+		policies[0].Conditions[0].ViolationType = ""
+		actions, err = Plan(policies, existing, false)
+		require.NoError(t, err)
+		require.Empty(t, actions)
+	})
+
+	t.Run("DuplicateName", func(t *testing.T) {
+		_, err := Plan([]Policy{{Name: "foo"}, {Name: "foo"}}, nil, false)
+		require.Error(t, err)
+	})
+}
+
+// setUpTestServer starts an HTTP server that serves version information, and delegates
+// all other requests to handler.
+func setUpTestServer(t *testing.T, handler http.Handler) *dtrack.Client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/version", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(dtrack.About{Version: "4.11.0"})
+	})
+	mux.Handle("/", handler)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := dtrack.NewClient(server.URL)
+	require.NoError(t, err)
+
+	return client
+}
+
+// policyServer is a minimal in-memory implementation of the policy endpoints.
+type policyServer struct {
+	mutex    sync.Mutex
+	policies []dtrack.Policy
+	requests []string
+}
+
+func (s *policyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v1/policy")
+	if r.Method != http.MethodGet {
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	}
+
+	policyIndex := func(policyUUID string) int {
+		for i := range s.policies {
+			if s.policies[i].UUID.String() == policyUUID {
+				return i
+			}
+		}
+		return -1
+	}
+
+	switch {
+	case r.Method == http.MethodGet && path == "":
+		w.Header().Set("X-Total-Count", strconv.Itoa(len(s.policies)))
+		_ = json.NewEncoder(w).Encode(s.policies)
+	case r.Method == http.MethodPut && path == "":
+		var policy dtrack.Policy
+		_ = json.NewDecoder(r.Body).Decode(&policy)
+		policy.UUID = uuid.New()
+		s.policies = append(s.policies, policy)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(policy)
+	case r.Method == http.MethodPut && strings.HasSuffix(path, "/condition"):
+		i := policyIndex(strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/condition"))
+		if i < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var condition dtrack.PolicyCondition
+		_ = json.NewDecoder(r.Body).Decode(&condition)
+		condition.UUID = uuid.New()
+		if condition.ViolationType == "" {
+			condition.ViolationType = dtrack.PolicyViolationTypeOperational // Default of the server
+		}
+		s.policies[i].PolicyConditions = append(s.policies[i].PolicyConditions, condition)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(condition)
+	case r.Method == http.MethodPost && strings.Contains(path, "/tag/"):
+		parts := strings.Split(strings.TrimPrefix(path, "/"), "/tag/")
+		i := policyIndex(parts[0])
+		if i < 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.policies[i].Tags = append(s.policies[i].Tags, dtrack.Tag{Name: parts[1]})
+		_ = json.NewEncoder(w).Encode(s.policies[i])
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func TestSync(t *testing.T) {
+	server := &policyServer{}
+	client := setUpTestServer(t, server)
+
+	policies := []Policy{
+		{
+			Name:           "Outdated",
+			Operator:       dtrack.PolicyOperatorAny,
+			ViolationState: dtrack.PolicyViolationStateWarn,
+			Conditions: []Condition{
+				// Without violation type, for which the server assigns a default.
+				{Subject: dtrack.PolicyConditionSubjectAge, Operator: dtrack.PolicyConditionOperatorNumericGreaterThan, Value: "P1Y"},
+			},
+			Tags: []string{"production"},
+		},
+	}
+
+	actions, err := Sync(context.Background(), client, policies, Options{})
+	require.NoError(t, err)
+	require.Len(t, actions, 3)
+	require.Len(t, server.policies, 1)
+
+	// Actions for the created policy must have been performed with its UUID.
+	policyUUID := server.policies[0].UUID
+	for _, action := range actions {
+		require.Equal(t, policyUUID, action.PolicyUUID)
+	}
+	require.Equal(t, []string{
+		"PUT /api/v1/policy",
+		"PUT /api/v1/policy/" + policyUUID.String() + "/condition",
+		"POST /api/v1/policy/" + policyUUID.String() + "/tag/production",
+	}, server.requests)
+	require.Len(t, server.policies[0].PolicyConditions, 1)
+	require.Equal(t, []dtrack.Tag{{Name: "production"}}, server.policies[0].Tags)
+
+	// Once reconciled, syncing again must not perform any actions.
+	server.requests = nil
+	actions, err = Sync(context.Background(), client, policies, Options{})
+	require.NoError(t, err)
+	require.Empty(t, actions)
+	require.Empty(t, server.requests)
+}
@@ -0,0 +1,68 @@
+package dtrack
+
+import (
+	"context"
+	"time"
+)
+
+const DefaultPollingInterval = 1 * time.Second
+
+// PollingOptions configures how the completion of asynchronous operations is polled for.
+type PollingOptions struct {
+	Interval    time.Duration // Interval between polls, defaults to DefaultPollingInterval
+	MaxInterval time.Duration // Upper bound for the interval when backing off, zero means unbounded
+	Multiplier  float64       // Factor the interval is multiplied with after every poll, values <= 1 disable backoff
+	Timeout     time.Duration // Maximum duration to poll for, zero means polling until ctx is done
+}
+
+// poll invokes conditionFunc until it either reports completion, returns an error,
+// the timeout configured in opts is exceeded, or ctx is done.
+// Intervals and the timeout are measured using clock.
+func poll(ctx context.Context, clock Clock, opts PollingOptions, conditionFunc func(ctx context.Context) (bool, error)) error {
+	var deadline time.Time
+	if opts.Timeout > 0 {
+		// The context's timeout aborts requests in flight, while the deadline
+		// ensures that the timeout is honored when clock is not the system's clock.
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+		deadline = clock.Now().Add(opts.Timeout)
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultPollingInterval
+	}
+
+	for {
+		done, err := conditionFunc(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		wait := interval
+		if !deadline.IsZero() {
+			remaining := deadline.Sub(clock.Now())
+			if remaining <= 0 {
+				return context.DeadlineExceeded
+			}
+			if wait > remaining {
+				wait = remaining
+			}
+		}
+
+		if err = sleep(ctx, clock, wait); err != nil {
+			return err
+		}
+
+		if opts.Multiplier > 1 {
+			interval = time.Duration(float64(interval) * opts.Multiplier)
+			if opts.MaxInterval > 0 && interval > opts.MaxInterval {
+				interval = opts.MaxInterval
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package dtrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoll(t *testing.T) {
+	var calls int
+	err := poll(context.Background(), systemClock{}, PollingOptions{Interval: time.Millisecond}, func(_ context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
+
+func TestPoll_ConditionFuncErr(t *testing.T) {
+	testErr := errors.New("test error")
+	err := poll(context.Background(), systemClock{}, PollingOptions{Interval: time.Millisecond}, func(_ context.Context) (bool, error) {
+		return false, testErr
+	})
+	require.ErrorIs(t, err, testErr)
+}
+
+func TestPoll_Timeout(t *testing.T) {
+	err := poll(context.Background(), systemClock{}, PollingOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}, func(_ context.Context) (bool, error) {
+		return false, nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestBOMService_WaitForProcessing(t *testing.T) {
+	var calls int32
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/event/token/foo", r.URL.Path)
+		processing := atomic.AddInt32(&calls, 1) < 3
+		_, _ = fmt.Fprintf(w, `{"processing":%t}`, processing)
+	}))
+
+	err := client.BOM.WaitForProcessing(context.Background(), "foo", PollingOptions{
+		Interval:    time.Millisecond,
+		MaxInterval: 5 * time.Millisecond,
+		Multiplier:  2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestEventService_WaitForProcessing(t *testing.T) {
+	var calls int32
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/event/token/foo", r.URL.Path)
+		processing := atomic.AddInt32(&calls, 1) < 2
+		_, _ = fmt.Fprintf(w, `{"processing":%t}`, processing)
+	}))
+
+	err := client.Event.WaitForProcessing(context.Background(), "foo", PollingOptions{Interval: time.Millisecond})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
@@ -81,6 +81,47 @@ func (ps ProjectService) Get(ctx context.Context, projectUUID uuid.UUID) (p Proj
 	return
 }
 
+// ProjectFilterOptions filters the projects returned by ProjectService.GetAllFiltered.
+// The server only supports filtering by one of Tag, Classifier or Team at a time,
+// and not in combination with SearchText.
+type ProjectFilterOptions struct {
+	SearchText      string    // Matches projects whose name contains the text, case-insensitively
+	Tag             string    // Only projects with this tag
+	Classifier      string    // Only projects with this classifier, e.g. CONTAINER
+	Team            uuid.UUID // Only projects the team has access to, when portfolio access control is enabled
+	ExcludeInactive bool      // Exclude inactive projects
+	OnlyRoot        bool      // Only projects without a parent
+}
+
+// endpoint determines the endpoint serving projects matching filterOptions.
+func (filterOptions ProjectFilterOptions) endpoint() (string, error) {
+	var (
+		path    = "api/v1/project"
+		filters int
+	)
+	if filterOptions.Tag != "" {
+		path = fmt.Sprintf("api/v1/project/tag/%s", url.PathEscape(filterOptions.Tag))
+		filters++
+	}
+	if filterOptions.Classifier != "" {
+		path = fmt.Sprintf("api/v1/project/classifier/%s", url.PathEscape(filterOptions.Classifier))
+		filters++
+	}
+	if filterOptions.Team != uuid.Nil {
+		path = fmt.Sprintf("api/v1/acl/team/%s", filterOptions.Team)
+		filters++
+	}
+
+	if filters > 1 {
+		return "", fmt.Errorf("filtering by more than one of tag, classifier and team is not supported")
+	}
+	if filters > 0 && filterOptions.SearchText != "" {
+		return "", fmt.Errorf("filtering by search text is not supported in combination with tag, classifier or team")
+	}
+
+	return path, nil
+}
+
 func (ps ProjectService) GetAll(ctx context.Context, po PageOptions) (p Page[Project], err error) {
 	req, err := ps.client.newRequest(ctx, http.MethodGet, "api/v1/project", withPageOptions(po))
 	if err != nil {
@@ -96,7 +137,52 @@ func (ps ProjectService) GetAll(ctx context.Context, po PageOptions) (p Page[Pro
 	return
 }
 
+// GetAllFiltered is like GetAll, but only fetches projects matching filterOptions.
+func (ps ProjectService) GetAllFiltered(ctx context.Context, po PageOptions, filterOptions ProjectFilterOptions) (p Page[Project], err error) {
+	path, err := filterOptions.endpoint()
+	if err != nil {
+		return
+	}
+
+	req, err := ps.client.newRequest(ctx, http.MethodGet, path, withPageOptions(po), withProjectFilterOptions(filterOptions))
+	if err != nil {
+		return
+	}
+
+	res, err := ps.client.doRequest(req, &p.Items)
+	if err != nil {
+		return
+	}
+
+	p.TotalCount = res.TotalCount
+	return
+}
+
+func withProjectFilterOptions(filterOptions ProjectFilterOptions) requestOption {
+	return func(req *http.Request) error {
+		query := req.URL.Query()
+		if len(filterOptions.SearchText) > 0 {
+			query.Set("searchText", filterOptions.SearchText)
+		}
+		if filterOptions.ExcludeInactive {
+			query.Set("excludeInactive", "true")
+		}
+		if filterOptions.OnlyRoot {
+			query.Set("onlyRoot", "true")
+		}
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+// Latest fetches the version of the project with the given name that is marked as latest.
+// Since v4.12.0.
 func (ps ProjectService) Latest(ctx context.Context, name string) (p Project, err error) {
+	err = ps.client.assertServerVersionAtLeast("4.12.0")
+	if err != nil {
+		return
+	}
+
 	req, err := ps.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/project/latest/%s", url.PathEscape(name)))
 	if err != nil {
 		return
@@ -199,6 +285,30 @@ func (ps ProjectService) GetAllByTag(ctx context.Context, tag string, excludeIna
 	return
 }
 
+// GetAllByClassifier fetches all projects with the given classifier, e.g. CONTAINER.
+func (ps ProjectService) GetAllByClassifier(ctx context.Context, classifier string, excludeInactive, onlyRoot bool, po PageOptions) (p Page[Project], err error) {
+	pathParams := map[string]string{
+		"classifier": classifier,
+	}
+	params := map[string]string{
+		"excludeInactive": strconv.FormatBool(excludeInactive),
+		"onlyRoot":        strconv.FormatBool(onlyRoot),
+	}
+
+	req, err := ps.client.newRequest(ctx, http.MethodGet, "api/v1/project/classifier/{classifier}", withPathParams(pathParams), withParams(params), withPageOptions(po))
+	if err != nil {
+		return
+	}
+
+	res, err := ps.client.doRequest(req, &p.Items)
+	if err != nil {
+		return
+	}
+
+	p.TotalCount = res.TotalCount
+	return
+}
+
 type ProjectCloneRequest struct {
 	ProjectUUID             uuid.UUID `json:"project"`
 	Version                 string    `json:"version"`
@@ -249,3 +359,46 @@ func (ps ProjectService) GetChildren(ctx context.Context, projectUUID uuid.UUID,
 	p.TotalCount = res.TotalCount
 	return
 }
+
+// ProjectTree is a project along with all of its descendants.
+type ProjectTree struct {
+	Project  Project
+	Children []ProjectTree
+}
+
+// GetTree fetches the project with the given UUID, and walks the project hierarchy below it.
+// Children are fetched depth-first, with one request per page of children of every project in the tree.
+func (ps ProjectService) GetTree(ctx context.Context, projectUUID uuid.UUID) (tree ProjectTree, err error) {
+	project, err := ps.Get(ctx, projectUUID)
+	if err != nil {
+		return
+	}
+
+	return ps.getTree(ctx, project, map[uuid.UUID]struct{}{})
+}
+
+func (ps ProjectService) getTree(ctx context.Context, project Project, visited map[uuid.UUID]struct{}) (tree ProjectTree, err error) {
+	// Guard against cycles, which the server should prevent, but an endless loop would be worse.
+	if _, ok := visited[project.UUID]; ok {
+		return ProjectTree{}, fmt.Errorf("project %s is its own ancestor", project.UUID)
+	}
+	visited[project.UUID] = struct{}{}
+
+	children, err := FetchAll(func(po PageOptions) (Page[Project], error) {
+		return ps.GetChildren(ctx, project.UUID, po)
+	})
+	if err != nil {
+		return ProjectTree{}, fmt.Errorf("failed to fetch children of project %s: %w", project.UUID, err)
+	}
+
+	tree.Project = project
+	for _, child := range children {
+		childTree, err := ps.getTree(ctx, child, visited)
+		if err != nil {
+			return ProjectTree{}, err
+		}
+		tree.Children = append(tree.Children, childTree)
+	}
+
+	return
+}
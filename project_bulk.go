@@ -0,0 +1,145 @@
+package dtrack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ProjectSelector selects projects for bulk operations.
+// Projects must match all criteria that are set.
+type ProjectSelector struct {
+	Tag          string               // Name of a tag the projects must have
+	Classifier   string               // Classifier of the projects, e.g. APPLICATION or LIBRARY
+	InactiveOnly bool                 // Whether to only select inactive projects
+	Match        func(p Project) bool // Additional criteria, e.g. based on LastBOMImport
+}
+
+func (s ProjectSelector) isEmpty() bool {
+	return s.Tag == "" && s.Classifier == "" && !s.InactiveOnly && s.Match == nil
+}
+
+func (s ProjectSelector) matches(p Project) bool {
+	if s.Classifier != "" && p.Classifier != s.Classifier {
+		return false
+	}
+	if s.InactiveOnly && p.Active {
+		return false
+	}
+	if s.Match != nil && !s.Match(p) {
+		return false
+	}
+
+	return true
+}
+
+// Select fetches all projects matching selector.
+func (ps ProjectService) Select(ctx context.Context, selector ProjectSelector) (projects []Project, err error) {
+	candidates, err := FetchAll(func(po PageOptions) (Page[Project], error) {
+		if selector.Tag != "" {
+			return ps.GetAllByTag(ctx, selector.Tag, false, false, po)
+		}
+		if selector.Classifier != "" {
+			return ps.GetAllByClassifier(ctx, selector.Classifier, false, false, po)
+		}
+		return ps.GetAll(ctx, po)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		if selector.matches(candidate) {
+			projects = append(projects, candidate)
+		}
+	}
+
+	return
+}
+
+type BulkDeleteOptions struct {
+	Concurrency int  // Maximum number of deletions to perform at a time, defaults to 1
+	DryRun      bool // Only determine which projects would be deleted, without deleting them
+}
+
+// BulkDelete deletes all projects matching selector, and returns them.
+// To prevent accidental deletion of the entire portfolio, selector must not be empty.
+//
+// As deleting a project also deletes its children, projects with descendants that
+// don't match selector are skipped, and are neither deleted nor returned.
+// Projects that no longer exist when they are deleted, e.g. because they were children
+// of another deleted project, are considered deleted. If deleting any of the projects fails,
+// a *BulkError[uuid.UUID] is returned along with the projects that were deleted,
+// holding the errors keyed by the UUID of the respective project.
+func (ps ProjectService) BulkDelete(ctx context.Context, selector ProjectSelector, opts BulkDeleteOptions) (deleted []Project, err error) {
+	if selector.isEmpty() {
+		return nil, fmt.Errorf("selector must not be empty")
+	}
+
+	projects, err := ps.Select(ctx, selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select projects: %w", err)
+	}
+	projects, err = ps.withoutUnselectedDescendants(ctx, projects)
+	if err != nil {
+		return nil, err
+	}
+	if opts.DryRun {
+		return projects, nil
+	}
+
+	errs := forEachConcurrently(ctx, projects, opts.Concurrency, func(ctx context.Context, project Project) error {
+		err := ps.Delete(ctx, project.UUID)
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+		return err
+	})
+
+	bulkErr := &BulkError[uuid.UUID]{Errors: make(map[uuid.UUID]error)}
+	for i, err := range errs {
+		if err != nil {
+			bulkErr.Errors[projects[i].UUID] = err
+		} else {
+			deleted = append(deleted, projects[i])
+		}
+	}
+	if len(bulkErr.Errors) > 0 {
+		return deleted, bulkErr
+	}
+
+	return deleted, nil
+}
+
+// withoutUnselectedDescendants returns those of projects whose descendants are all part of projects.
+func (ps ProjectService) withoutUnselectedDescendants(ctx context.Context, projects []Project) ([]Project, error) {
+	selected := make(map[uuid.UUID]struct{}, len(projects))
+	for _, project := range projects {
+		selected[project.UUID] = struct{}{}
+	}
+
+	var result []Project
+	for _, project := range projects {
+		tree, err := ps.getTree(ctx, project, map[uuid.UUID]struct{}{})
+		if err != nil {
+			return nil, err
+		}
+		if tree.descendantsIn(selected) {
+			result = append(result, project)
+		}
+	}
+
+	return result, nil
+}
+
+func (t ProjectTree) descendantsIn(projects map[uuid.UUID]struct{}) bool {
+	for _, child := range t.Children {
+		if _, ok := projects[child.Project.UUID]; !ok || !child.descendantsIn(projects) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,144 @@
+package dtrack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectService_BulkDelete(t *testing.T) {
+	projects := []Project{
+		{UUID: uuid.New(), Name: "acme-app", Version: "feature-a", Classifier: "APPLICATION", Active: false},
+		{UUID: uuid.New(), Name: "acme-app", Version: "feature-b", Classifier: "APPLICATION", Active: false},
+		{UUID: uuid.New(), Name: "acme-app", Version: "main", Classifier: "APPLICATION", Active: true},
+		{UUID: uuid.New(), Name: "acme-lib", Version: "feature-a", Classifier: "LIBRARY", Active: false},
+	}
+
+	var (
+		mutex   sync.Mutex
+		deleted []uuid.UUID
+	)
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/project/tag/ephemeral":
+			w.Header().Set("X-Total-Count", "4")
+			_ = json.NewEncoder(w).Encode(projects)
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/children"):
+			w.Header().Set("X-Total-Count", "0")
+			_ = json.NewEncoder(w).Encode([]Project{})
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v1/project/"):
+			projectUUID := uuid.MustParse(strings.TrimPrefix(r.URL.Path, "/api/v1/project/"))
+			if projectUUID == projects[1].UUID {
+				w.WriteHeader(http.StatusNotFound) // Deleted concurrently
+				return
+			}
+			mutex.Lock()
+			deleted = append(deleted, projectUUID)
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+
+	_, err := client.Project.BulkDelete(context.Background(), ProjectSelector{}, BulkDeleteOptions{})
+	require.Error(t, err)
+
+	selector := ProjectSelector{Tag: "ephemeral", Classifier: "APPLICATION", InactiveOnly: true}
+
+	result, err := client.Project.BulkDelete(context.Background(), selector, BulkDeleteOptions{DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, projects[:2], result)
+	require.Empty(t, deleted)
+
+	result, err = client.Project.BulkDelete(context.Background(), selector, BulkDeleteOptions{Concurrency: 2})
+	require.NoError(t, err)
+	require.ElementsMatch(t, projects[:2], result)
+	require.Equal(t, []uuid.UUID{projects[0].UUID}, deleted)
+}
+
+func TestProjectService_BulkDelete_UnselectedDescendants(t *testing.T) {
+	parent := Project{UUID: uuid.New(), Name: "acme", Active: false}
+	child := Project{UUID: uuid.New(), Name: "acme-app", Active: true, ParentRef: &ParentRef{UUID: parent.UUID}}
+	other := Project{UUID: uuid.New(), Name: "acme-lib", Active: false}
+
+	var deleted []uuid.UUID
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/project":
+			w.Header().Set("X-Total-Count", "3")
+			_ = json.NewEncoder(w).Encode([]Project{parent, child, other})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/project/"+parent.UUID.String()+"/children":
+			w.Header().Set("X-Total-Count", "1")
+			_ = json.NewEncoder(w).Encode([]Project{child})
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/children"):
+			w.Header().Set("X-Total-Count", "0")
+			_ = json.NewEncoder(w).Encode([]Project{})
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v1/project/"):
+			deleted = append(deleted, uuid.MustParse(strings.TrimPrefix(r.URL.Path, "/api/v1/project/")))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+
+	// Only the parent matches, deleting it would delete its active child as well.
+	selector := ProjectSelector{InactiveOnly: true}
+
+	result, err := client.Project.BulkDelete(context.Background(), selector, BulkDeleteOptions{DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, []Project{other}, result)
+
+	result, err = client.Project.BulkDelete(context.Background(), selector, BulkDeleteOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []Project{other}, result)
+	require.Equal(t, []uuid.UUID{other.UUID}, deleted)
+
+	// Once the child matches as well, both can be deleted.
+	deleted = nil
+	result, err = client.Project.BulkDelete(context.Background(), ProjectSelector{Match: func(p Project) bool { return true }}, BulkDeleteOptions{})
+	require.NoError(t, err)
+	require.Equal(t, []Project{parent, child, other}, result)
+	require.ElementsMatch(t, []uuid.UUID{parent.UUID, child.UUID, other.UUID}, deleted)
+}
+
+func TestProjectService_BulkDelete_Error(t *testing.T) {
+	projects := []Project{
+		{UUID: uuid.New(), Name: "acme-app", Version: "feature-a"},
+		{UUID: uuid.New(), Name: "acme-app", Version: "feature-b"},
+	}
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/project":
+			w.Header().Set("X-Total-Count", "2")
+			_ = json.NewEncoder(w).Encode(projects)
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/children"):
+			w.Header().Set("X-Total-Count", "0")
+			_ = json.NewEncoder(w).Encode([]Project{})
+		case r.Method == http.MethodDelete && r.URL.Path == "/api/v1/project/"+projects[0].UUID.String():
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	result, err := client.Project.BulkDelete(context.Background(), ProjectSelector{
+		Match: func(p Project) bool { return strings.HasPrefix(p.Version, "feature-") },
+	}, BulkDeleteOptions{})
+	require.Equal(t, projects[1:], result)
+
+	var bulkErr *BulkError[uuid.UUID]
+	require.True(t, errors.As(err, &bulkErr))
+	require.Len(t, bulkErr.Errors, 1)
+	require.ErrorIs(t, bulkErr.Errors[projects[0].UUID], ErrForbidden)
+}
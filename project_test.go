@@ -2,8 +2,12 @@ package dtrack
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -249,3 +253,127 @@ func TestProjectService_Latest(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "2.0.0", latest.Version)
 }
+
+func TestProjectService_GetAll_SearchText(t *testing.T) {
+	client := setUpContainer(t, testContainerOptions{
+		APIPermissions: []string{
+			PermissionPortfolioManagement,
+			PermissionViewPortfolio,
+		},
+	})
+
+	for _, name := range []string{"acme-app", "acme-lib", "other-app"} {
+		_, err := client.Project.Create(context.Background(), Project{Name: name, Version: "1.0.0", Active: true})
+		require.NoError(t, err)
+	}
+
+	projects, err := client.Project.GetAllFiltered(context.Background(), PageOptions{}, ProjectFilterOptions{SearchText: "acme"})
+	require.NoError(t, err)
+	require.Equal(t, 2, projects.TotalCount)
+	require.ElementsMatch(t, []string{"acme-app", "acme-lib"}, []string{projects.Items[0].Name, projects.Items[1].Name})
+}
+
+func TestProjectService_GetTree(t *testing.T) {
+	var (
+		root       = Project{UUID: uuid.New(), Name: "acme"}
+		child1     = Project{UUID: uuid.New(), Name: "acme-app"}
+		child2     = Project{UUID: uuid.New(), Name: "acme-lib"}
+		grandchild = Project{UUID: uuid.New(), Name: "acme-app-frontend"}
+		children   = map[uuid.UUID][]Project{
+			root.UUID:   {child1, child2},
+			child1.UUID: {grandchild},
+		}
+	)
+
+	client := setUpTestServer(t, "4.12.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/project/"), "/")
+		projectUUID := uuid.MustParse(segments[0])
+
+		w.Header().Set("Content-Type", "application/json")
+		if len(segments) == 2 && segments[1] == "children" {
+			w.Header().Set("X-Total-Count", strconv.Itoa(len(children[projectUUID])))
+			_ = json.NewEncoder(w).Encode(append([]Project{}, children[projectUUID]...))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(root)
+	}))
+
+	tree, err := client.Project.GetTree(context.Background(), root.UUID)
+	require.NoError(t, err)
+	require.Equal(t, ProjectTree{
+		Project: root,
+		Children: []ProjectTree{
+			{Project: child1, Children: []ProjectTree{{Project: grandchild}}},
+			{Project: child2},
+		},
+	}, tree)
+}
+
+func TestProjectService_GetAllByClassifier(t *testing.T) {
+	client := setUpTestServer(t, "4.12.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/project/classifier/CONTAINER", r.URL.Path)
+		require.Equal(t, "true", r.URL.Query().Get("excludeInactive"))
+		require.Equal(t, "false", r.URL.Query().Get("onlyRoot"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", "1")
+		_ = json.NewEncoder(w).Encode([]Project{{Name: "acme-image", Classifier: "CONTAINER"}})
+	}))
+
+	page, err := client.Project.GetAllByClassifier(context.Background(), "CONTAINER", true, false, PageOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, page.TotalCount)
+	require.Equal(t, "acme-image", page.Items[0].Name)
+}
+
+func TestProjectService_GetAll_FilterOptions(t *testing.T) {
+	teamUUID := uuid.New()
+
+	var requests []string
+	client := setUpTestServer(t, "4.12.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.RequestURI())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", "0")
+		_, _ = w.Write([]byte("[]"))
+	}))
+
+	for _, filterOptions := range []ProjectFilterOptions{
+		{SearchText: "acme", ExcludeInactive: true},
+		{Tag: "prod", OnlyRoot: true},
+		{Classifier: "CONTAINER"},
+		{Team: teamUUID, ExcludeInactive: true, OnlyRoot: true},
+	} {
+		_, err := client.Project.GetAllFiltered(context.Background(), PageOptions{}, filterOptions)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{
+		"/api/v1/project?excludeInactive=true&searchText=acme",
+		"/api/v1/project/tag/prod?onlyRoot=true",
+		"/api/v1/project/classifier/CONTAINER",
+		fmt.Sprintf("/api/v1/acl/team/%s?excludeInactive=true&onlyRoot=true", teamUUID),
+	}, requests)
+
+	_, err := client.Project.GetAllFiltered(context.Background(), PageOptions{}, ProjectFilterOptions{Tag: "prod", Classifier: "CONTAINER"})
+	require.Error(t, err)
+	_, err = client.Project.GetAllFiltered(context.Background(), PageOptions{}, ProjectFilterOptions{Tag: "prod", SearchText: "acme"})
+	require.Error(t, err)
+}
+
+func TestProjectService_Latest_ServerVersion(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v1/project/latest/acme%2Fapp", r.URL.EscapedPath())
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Project{Name: "acme/app", Version: "2.0.0"})
+	})
+
+	client := setUpTestServer(t, "4.11.0", handler)
+	_, err := client.Project.Latest(context.Background(), "acme/app")
+	require.ErrorContains(t, err, "server version must be at least 4.12.0")
+
+	client = setUpTestServer(t, "4.12.0", handler)
+	project, err := client.Project.Latest(context.Background(), "acme/app")
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0", project.Version)
+}
@@ -0,0 +1,74 @@
+package dtrack
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// WithRateLimit limits the rate at which the client sends requests, using a token bucket
+// that holds up to burst tokens and is refilled at requestsPerSecond. The limit applies
+// across all services, and to each attempt of retried requests. Requests exceeding the
+// limit are delayed, until their context is done. Requests that are given up on this way
+// don't count against the limit.
+func WithRateLimit(requestsPerSecond float64, burst int) ClientOption {
+	return func(c *Client) error {
+		if requestsPerSecond <= 0 {
+			return errors.New("requests per second must be positive")
+		}
+		if burst < 1 {
+			return errors.New("burst must be at least 1")
+		}
+
+		c.rateLimiter = &rateLimiter{
+			rate:   requestsPerSecond,
+			burst:  float64(burst),
+			tokens: float64(burst),
+		}
+		return nil
+	}
+}
+
+type rateLimiter struct {
+	mutex  sync.Mutex
+	rate   float64 // Tokens added per second
+	burst  float64 // Maximum number of tokens
+	tokens float64 // Available tokens, negative when tokens have been reserved in advance
+	last   time.Time
+}
+
+// reserve takes a token from the bucket, and returns how long the caller
+// has to wait before the token becomes available.
+func (rl *rateLimiter) reserve(now time.Time) time.Duration {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	if !rl.last.IsZero() && now.After(rl.last) {
+		rl.tokens += now.Sub(rl.last).Seconds() * rl.rate
+		if rl.tokens > rl.burst {
+			rl.tokens = rl.burst
+		}
+	}
+	if now.After(rl.last) {
+		rl.last = now
+	}
+
+	rl.tokens--
+	if rl.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-rl.tokens / rl.rate * float64(time.Second))
+}
+
+// cancel returns a token taken by reserve, when the caller gave up waiting for it
+// or didn't send its request, so that it doesn't delay subsequent requests.
+func (rl *rateLimiter) cancel() {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	rl.tokens++
+	if rl.tokens > rl.burst {
+		rl.tokens = rl.burst
+	}
+}
@@ -0,0 +1,72 @@
+package dtrack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	now := time.Now()
+	rl := &rateLimiter{rate: 2, burst: 2, tokens: 2}
+
+	// Burst
+	require.Equal(t, time.Duration(0), rl.reserve(now))
+	require.Equal(t, time.Duration(0), rl.reserve(now))
+
+	// Exhausted, reservations queue up
+	require.Equal(t, 500*time.Millisecond, rl.reserve(now))
+	require.Equal(t, time.Second, rl.reserve(now))
+
+	// Refilled, but never beyond burst
+	now = now.Add(time.Minute)
+	require.Equal(t, time.Duration(0), rl.reserve(now))
+	require.Equal(t, time.Duration(0), rl.reserve(now))
+	require.Equal(t, 500*time.Millisecond, rl.reserve(now))
+}
+
+func TestRateLimiter_cancel(t *testing.T) {
+	now := time.Now()
+	rl := &rateLimiter{rate: 2, burst: 2, tokens: 2}
+
+	require.Equal(t, time.Duration(0), rl.reserve(now))
+	require.Equal(t, time.Duration(0), rl.reserve(now))
+
+	// Cancelled reservations don't delay subsequent ones
+	for i := 0; i < 10; i++ {
+		require.Equal(t, 500*time.Millisecond, rl.reserve(now))
+		rl.cancel()
+	}
+	require.Equal(t, 500*time.Millisecond, rl.reserve(now))
+
+	// Never beyond burst
+	rl.cancel()
+	rl.cancel()
+	rl.cancel()
+	require.Equal(t, float64(2), rl.tokens)
+}
+
+func TestWithRateLimit(t *testing.T) {
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}), WithRateLimit(100, 1))
+
+	// The first token was used to fetch version information in NewClient.
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := client.Metrics.LatestPortfolioMetrics(context.Background())
+		require.NoError(t, err)
+	}
+	require.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.Metrics.LatestPortfolioMetrics(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = NewClient("http://localhost", WithRateLimit(0, 1))
+	require.Error(t, err)
+}
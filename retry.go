@@ -0,0 +1,117 @@
+package dtrack
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultRetryInitialBackoff = 500 * time.Millisecond
+	DefaultRetryMaxBackoff     = 30 * time.Second
+)
+
+// RetryOptions configures how requests that failed with transient errors are retried.
+//
+// Requests rejected with status 429 or 503 are retried regardless of their method,
+// since the server did not process them. Requests failing with other 5xx statuses or
+// network errors are only retried when their method is idempotent, i.e. GET, HEAD or OPTIONS.
+// Note that Dependency-Track uses PUT to create resources, which is not considered idempotent.
+// Requests with bodies that can not be replayed, e.g. streamed uploads, are never retried.
+type RetryOptions struct {
+	MaxRetries     int           // Maximum number of retries per request, zero disables retries
+	InitialBackoff time.Duration // Backoff before the first retry, defaults to DefaultRetryInitialBackoff
+	MaxBackoff     time.Duration // Upper bound for backoffs, including those requested via Retry-After, defaults to DefaultRetryMaxBackoff
+}
+
+// WithRetry configures the client to retry requests that failed with transient errors,
+// using jittered exponential backoff. Delays requested by the server via the Retry-After
+// header are honored.
+func WithRetry(opts RetryOptions) ClientOption {
+	return func(c *Client) error {
+		if opts.MaxRetries < 0 {
+			return errors.New("max retries must not be negative")
+		}
+		if opts.InitialBackoff <= 0 {
+			opts.InitialBackoff = DefaultRetryInitialBackoff
+		}
+		if opts.MaxBackoff <= 0 {
+			opts.MaxBackoff = DefaultRetryMaxBackoff
+		}
+
+		c.retryOptions = opts
+		return nil
+	}
+}
+
+func (ro RetryOptions) shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if !isReplayable(req) || req.Context().Err() != nil {
+		return false
+	}
+
+	idempotent := req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions
+
+	if err != nil {
+		return idempotent
+	}
+
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests, res.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case res.StatusCode >= 500:
+		return idempotent
+	default:
+		return false
+	}
+}
+
+// backoff determines how long to wait before the given retry attempt (starting at 1).
+// HTTP dates in Retry-After headers are relative to now.
+func (ro RetryOptions) backoff(attempt int, res *http.Response, now time.Time) time.Duration {
+	if res != nil {
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After"), now); ok {
+			if retryAfter > ro.MaxBackoff {
+				return ro.MaxBackoff
+			}
+			return retryAfter
+		}
+	}
+
+	backoff := ro.InitialBackoff
+	for i := 1; i < attempt && backoff < ro.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > ro.MaxBackoff {
+		backoff = ro.MaxBackoff
+	}
+
+	// Apply jitter of up to 50%, so that clients that failed at the same time don't retry at the same time.
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// parseRetryAfter parses the value of a Retry-After header,
+// which is either a number of seconds, or an HTTP date that is converted to a delay relative to now.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,116 @@
+package dtrack
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetry(t *testing.T) {
+	var (
+		attempts int
+		statuses []int
+		bodies   []string
+	)
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+
+		status := statuses[attempts]
+		attempts++
+		if status == http.StatusTooManyRequests {
+			w.Header().Set("Retry-After", "0")
+		}
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`{"name":"test-project"}`))
+		}
+	}), WithRetry(RetryOptions{MaxRetries: 2, InitialBackoff: time.Millisecond}))
+
+	reset := func(s ...int) {
+		attempts = 0
+		statuses = s
+		bodies = nil
+	}
+
+	t.Run("TransientFailure", func(t *testing.T) {
+		reset(http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK)
+		_, err := client.Project.Get(context.Background(), uuid.Nil)
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("MaxRetriesExceeded", func(t *testing.T) {
+		reset(http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusOK)
+		_, err := client.Project.Get(context.Background(), uuid.Nil)
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr))
+		require.Equal(t, http.StatusBadGateway, apiErr.StatusCode)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("NonIdempotent", func(t *testing.T) {
+		reset(http.StatusBadGateway, http.StatusOK)
+		_, err := client.Project.Create(context.Background(), Project{Name: "test-project"})
+		require.Error(t, err)
+		require.Equal(t, 1, attempts)
+	})
+
+	t.Run("NonIdempotentTooManyRequests", func(t *testing.T) {
+		reset(http.StatusTooManyRequests, http.StatusOK)
+		_, err := client.Project.Create(context.Background(), Project{Name: "test-project"})
+		require.NoError(t, err)
+		require.Equal(t, 2, attempts)
+		require.Equal(t, bodies[0], bodies[1])
+		require.NotEmpty(t, bodies[1])
+	})
+
+	t.Run("ClientError", func(t *testing.T) {
+		reset(http.StatusNotFound, http.StatusOK)
+		_, err := client.Project.Get(context.Background(), uuid.Nil)
+		require.Error(t, err)
+		require.Equal(t, 1, attempts)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	delay, ok := parseRetryAfter("120", now)
+	require.True(t, ok)
+	require.Equal(t, 2*time.Minute, delay)
+
+	delay, ok = parseRetryAfter(now.Add(time.Hour).Format(http.TimeFormat), now)
+	require.True(t, ok)
+	require.Equal(t, time.Hour, delay)
+
+	delay, ok = parseRetryAfter(now.Add(-time.Hour).Format(http.TimeFormat), now)
+	require.True(t, ok)
+	require.Zero(t, delay)
+
+	_, ok = parseRetryAfter("", now)
+	require.False(t, ok)
+
+	_, ok = parseRetryAfter("soon", now)
+	require.False(t, ok)
+}
+
+func TestRetryOptions_backoff(t *testing.T) {
+	ro := RetryOptions{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, maxBackoff := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		backoff := ro.backoff(attempt+1, nil, time.Now())
+		require.GreaterOrEqual(t, backoff, maxBackoff/2)
+		require.LessOrEqual(t, backoff, maxBackoff)
+	}
+
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"3600"}}}
+	require.Equal(t, time.Second, ro.backoff(1, res, time.Now()))
+}
@@ -40,6 +40,19 @@ func (ts TeamService) Get(ctx context.Context, teamUUID uuid.UUID) (t Team, err
 	return
 }
 
+// GetSelf fetches the team the client is authenticated as, including its permissions.
+// It can be used to validate an API key, and to check for required permissions before
+// attempting privileged operations. It is only available when authenticating with an API key.
+func (ts TeamService) GetSelf(ctx context.Context) (t Team, err error) {
+	req, err := ts.client.newRequest(ctx, http.MethodGet, "api/v1/team/self")
+	if err != nil {
+		return
+	}
+
+	_, err = ts.client.doRequest(req, &t)
+	return
+}
+
 func (ts TeamService) GetAll(ctx context.Context, po PageOptions) (p Page[Team], err error) {
 	req, err := ts.client.newRequest(ctx, http.MethodGet, "api/v1/team", withPageOptions(po))
 	if err != nil {
@@ -55,6 +68,9 @@ func (ts TeamService) GetAll(ctx context.Context, po PageOptions) (p Page[Team],
 	return
 }
 
+// GenerateAPIKey generates a new API key for a team.
+// Since v4.13.0, the returned key is the only time the full key is exposed.
+// Subsequent listings only include its public ID and masked form.
 func (ts TeamService) GenerateAPIKey(ctx context.Context, teamUUID uuid.UUID) (apiKey APIKey, err error) {
 	req, err := ts.client.newRequest(ctx, http.MethodPut, fmt.Sprintf("api/v1/team/%s/key", teamUUID))
 	if err != nil {
@@ -65,6 +81,20 @@ func (ts TeamService) GenerateAPIKey(ctx context.Context, teamUUID uuid.UUID) (a
 	return
 }
 
+// RegenerateAPIKey replaces an existing API key with a newly generated one, retaining its comment.
+// Keys are identified by their public ID since v4.13.0, and by the full key before.
+func (ts TeamService) RegenerateAPIKey(ctx context.Context, publicIdOrKey string) (apiKey APIKey, err error) {
+	req, err := ts.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("api/v1/team/key/%s", publicIdOrKey))
+	if err != nil {
+		return
+	}
+
+	_, err = ts.client.doRequest(req, &apiKey)
+	return
+}
+
+// DeleteAPIKey deletes an API key.
+// Keys are identified by their public ID since v4.13.0, and by the full key before.
 func (ts TeamService) DeleteAPIKey(ctx context.Context, publicIdOrKey string) (err error) {
 	req, err := ts.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/team/key/%s", publicIdOrKey))
 	if err != nil {
@@ -74,6 +104,8 @@ func (ts TeamService) DeleteAPIKey(ctx context.Context, publicIdOrKey string) (e
 	return
 }
 
+// UpdateAPIKeyComment sets the comment of an API key.
+// Keys are identified by their public ID since v4.13.0, and by the full key before.
 func (ts TeamService) UpdateAPIKeyComment(ctx context.Context, publicIdOrKey, comment string) (commentOut string, err error) {
 	req, err := ts.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("api/v1/team/key/%s/comment", publicIdOrKey), withBody(comment))
 	if err != nil {
@@ -173,3 +173,73 @@ func TestUpdateAPIKeyComment(t *testing.T) {
 	require.Equal(t, keys[0].PublicId, key.PublicId)
 	require.Equal(t, keys[0].Comment, "test-comment")
 }
+
+func TestRegenerateAPIKey_v4_12(t *testing.T) {
+	client := setUpContainer(t, testContainerOptions{
+		Version: "4.12.7",
+		APIPermissions: []string{
+			PermissionAccessManagement,
+		},
+	})
+
+	team, err := client.Team.Create(context.Background(), Team{
+		Name: "RegenerateAPIKey_v4_12",
+	})
+	require.NoError(t, err)
+
+	key, err := client.Team.GenerateAPIKey(context.Background(), team.UUID)
+	require.NoError(t, err)
+
+	regeneratedKey, err := client.Team.RegenerateAPIKey(context.Background(), key.Key)
+	require.NoError(t, err)
+	require.NotEqual(t, regeneratedKey.Key, key.Key)
+
+	keys, err := client.Team.GetAPIKeys(context.Background(), team.UUID)
+	require.NoError(t, err)
+	require.Equal(t, len(keys), 1)
+	require.Equal(t, keys[0].Key, regeneratedKey.Key)
+}
+
+func TestRegenerateAPIKey(t *testing.T) {
+	client := setUpContainer(t, testContainerOptions{
+		APIPermissions: []string{
+			PermissionAccessManagement,
+		},
+	})
+
+	team, err := client.Team.Create(context.Background(), Team{
+		Name: "RegenerateAPIKey",
+	})
+	require.NoError(t, err)
+
+	key, err := client.Team.GenerateAPIKey(context.Background(), team.UUID)
+	require.NoError(t, err)
+
+	_, err = client.Team.UpdateAPIKeyComment(context.Background(), key.PublicId, "test-comment")
+	require.NoError(t, err)
+
+	regeneratedKey, err := client.Team.RegenerateAPIKey(context.Background(), key.PublicId)
+	require.NoError(t, err)
+	require.NotEqual(t, regeneratedKey.PublicId, key.PublicId)
+	require.NotEmpty(t, regeneratedKey.Key)
+
+	keys, err := client.Team.GetAPIKeys(context.Background(), team.UUID)
+	require.NoError(t, err)
+	require.Equal(t, len(keys), 1)
+	require.Equal(t, keys[0].PublicId, regeneratedKey.PublicId)
+	require.Equal(t, keys[0].Comment, "test-comment")
+}
+
+func TestGetSelf(t *testing.T) {
+	client := setUpContainer(t, testContainerOptions{
+		APIPermissions: []string{
+			PermissionViewPortfolio,
+		},
+	})
+
+	team, err := client.Team.GetSelf(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, team.Name)
+	require.Equal(t, len(team.Permissions), 1)
+	require.Equal(t, team.Permissions[0].Name, PermissionViewPortfolio)
+}
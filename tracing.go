@@ -0,0 +1,91 @@
+package dtrack
+
+import (
+	"net/http"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/DependencyTrack/client-go"
+
+// WithTracerProvider enables OpenTelemetry tracing of API calls.
+//
+// A span is created per API call, and named after the service method that made it,
+// e.g. "ProjectService.Get". Retries of the call are part of the same span.
+// The trace context is propagated to the server using the global propagator.
+func WithTracerProvider(tracerProvider trace.TracerProvider) ClientOption {
+	return func(c *Client) error {
+		c.tracer = tracerProvider.Tracer(tracerName)
+		return nil
+	}
+}
+
+// startSpan starts a span for req, and returns a copy of req that carries the span's context.
+func (c Client) startSpan(req *http.Request) (*http.Request, trace.Span) {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("url.path", redactPath(req.URL.Path)),
+	}
+	if projectUUID, ok := projectUUIDFromPath(req.URL.Path); ok {
+		attrs = append(attrs, attribute.String("dtrack.project.uuid", projectUUID.String()))
+	}
+
+	ctx, span := c.tracer.Start(req.Context(), spanName(req),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...))
+
+	req = req.WithContext(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	return req, span
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+var funcLiteralSuffixRe = regexp.MustCompile(`(\.func\d+)+$`)
+
+// spanName determines the name of the service method that is performing req.
+// It must be called from doRequest.
+func spanName(req *http.Request) string {
+	// Skip runtime.Callers, spanName, startSpan and doRequest.
+	pc := make([]uintptr, 1)
+	if runtime.Callers(4, pc) == 1 {
+		if fn := runtime.FuncForPC(pc[0]); fn != nil {
+			name := funcLiteralSuffixRe.ReplaceAllString(fn.Name(), "")
+			if strings.HasPrefix(name, tracerName+".") {
+				return strings.TrimPrefix(name, tracerName+".")
+			}
+		}
+	}
+
+	return req.Method + " " + redactPath(req.URL.Path)
+}
+
+// projectUUIDFromPath extracts the UUID of the project that path refers to, if any.
+func projectUUIDFromPath(path string) (uuid.UUID, bool) {
+	segments := strings.Split(path, "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] != "project" {
+			continue
+		}
+		if projectUUID, err := uuid.Parse(segments[i+1]); err == nil {
+			return projectUUID, true
+		}
+	}
+
+	return uuid.Nil, false
+}
@@ -0,0 +1,46 @@
+package dtrack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithTracerProvider(t *testing.T) {
+	spanRecorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spanRecorder))
+
+	projectUUID := uuid.New()
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/project/"+projectUUID.String() {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}), WithTracerProvider(tracerProvider))
+
+	_, err := client.Project.Get(context.Background(), projectUUID)
+	require.NoError(t, err)
+	_, err = client.Project.GetAll(context.Background(), PageOptions{})
+	require.Error(t, err)
+
+	spans := spanRecorder.Ended()
+	require.Len(t, spans, 3)
+	require.Equal(t, "AboutService.Get", spans[0].Name())
+
+	require.Equal(t, "ProjectService.Get", spans[1].Name())
+	require.Equal(t, codes.Unset, spans[1].Status().Code)
+	require.Contains(t, spans[1].Attributes(), attribute.String("dtrack.project.uuid", projectUUID.String()))
+	require.Contains(t, spans[1].Attributes(), attribute.Int("http.response.status_code", http.StatusOK))
+
+	require.Equal(t, "ProjectService.GetAll", spans[2].Name())
+	require.Equal(t, codes.Error, spans[2].Status().Code)
+	require.Contains(t, spans[2].Attributes(), attribute.String("url.path", "/api/v1/project"))
+}
@@ -50,7 +50,7 @@ func (us UserService) Login(ctx context.Context, username, password string) (tok
 	body.Set("username", username)
 	body.Set("password", password)
 
-	req, err := us.client.newRequest(ctx, http.MethodPost, "api/v1/user/login", withBody(body))
+	req, err := us.client.newRequest(ctx, http.MethodPost, "api/v1/user/login", withBody(body), withoutAuth())
 	if err != nil {
 		return
 	}
@@ -94,7 +94,13 @@ func (us UserService) GetAllManaged(ctx context.Context, po PageOptions) (p Page
 	if err != nil {
 		return
 	}
-	_, err = us.client.doRequest(req, &p.Items)
+
+	res, err := us.client.doRequest(req, &p.Items)
+	if err != nil {
+		return
+	}
+
+	p.TotalCount = res.TotalCount
 	return
 }
 
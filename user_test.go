@@ -95,6 +95,11 @@ func TestGetAllManagedUsers(t *testing.T) {
 	require.Equal(t, len(users[0].Permissions), 14)
 
 	require.Equal(t, users[1].Username, "test-managed")
+
+	page, err := client.User.GetAllManaged(context.Background(), PageOptions{PageNumber: 1, PageSize: 1})
+	require.NoError(t, err)
+	require.Equal(t, len(page.Items), 1)
+	require.Equal(t, page.TotalCount, 2)
 }
 
 func TestDeleteManagedUser(t *testing.T) {
@@ -1,6 +1,11 @@
 package dtrack
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
 
 // FetchAll is a convenience function to retrieve all items of a paginated API resource.
 func FetchAll[T any](pageFetchFunc func(po PageOptions) (Page[T], error)) (items []T, err error) {
@@ -12,10 +17,66 @@ func FetchAll[T any](pageFetchFunc func(po PageOptions) (Page[T], error)) (items
 	return
 }
 
+// pageSize is the page size used by FetchAll, FetchAllConcurrently, ForEach and Stream.
+const pageSize = 50
+
+// FetchAllConcurrently is like FetchAll, but fetches all pages after the first one concurrently,
+// with at most concurrency requests in flight at a time. The number of pages is derived from the total
+// count reported for the first page. Like with FetchAll, only the first page is fetched if no total count is reported.
+//
+// Note that items may be skipped or duplicated, if they are added or removed while pages are fetched.
+func FetchAllConcurrently[T any](pageFetchFunc func(po PageOptions) (Page[T], error), concurrency int) ([]T, error) {
+	firstPage, err := pageFetchFunc(PageOptions{PageNumber: 1, PageSize: pageSize})
+	if err != nil {
+		return nil, err
+	}
+	if firstPage.TotalCount <= len(firstPage.Items) {
+		return firstPage.Items, nil
+	}
+
+	pageCount := (firstPage.TotalCount + pageSize - 1) / pageSize
+	pages := make([][]T, pageCount)
+	pages[0] = firstPage.Items
+
+	pageNumbers := make([]int, 0, pageCount-1)
+	for pageNumber := 2; pageNumber <= pageCount; pageNumber++ {
+		pageNumbers = append(pageNumbers, pageNumber)
+	}
+
+	// Stop fetching further pages after the first failure.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errs := forEachConcurrently(ctx, pageNumbers, concurrency, func(_ context.Context, pageNumber int) error {
+		page, err := pageFetchFunc(PageOptions{PageNumber: pageNumber, PageSize: pageSize})
+		if err != nil {
+			cancel()
+			return fmt.Errorf("failed to fetch page %d: %w", pageNumber, err)
+		}
+		pages[pageNumber-1] = page.Items
+		return nil
+	})
+
+	var firstErr error
+	for _, err = range errs {
+		if err != nil && (firstErr == nil || errors.Is(firstErr, context.Canceled)) {
+			firstErr = err
+		}
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	items := make([]T, 0, firstPage.TotalCount)
+	for _, page := range pages {
+		items = append(items, page...)
+	}
+
+	return items, nil
+}
+
 // ForEach is a convenience function to perform an action on every item of a paginated API resource.
 func ForEach[T any](pageFetchFunc func(po PageOptions) (Page[T], error), handlerFunc func(item T) error) (err error) {
-	const pageSize = 50
-
 	var (
 		page       Page[T]
 		pageNumber = 1
@@ -49,6 +110,49 @@ func ForEach[T any](pageFetchFunc func(po PageOptions) (Page[T], error), handler
 	return
 }
 
+// Stream fetches all items of a paginated API resource in the background, and sends them to the
+// returned items channel. The next page is fetched while the items of the current page are consumed,
+// and at most one page of items is buffered, so memory usage is independent of the resource's size.
+//
+// The items channel is closed when all items have been sent, fetching a page failed, or ctx is done.
+// Afterwards, the error channel yields the error that ended streaming, if any:
+//
+//	items, errs := dtrack.Stream(ctx, func(po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+//		return client.Project.GetAll(ctx, po)
+//	})
+//	for project := range items {
+//		// ...
+//	}
+//	if err := <-errs; err != nil {
+//		return err
+//	}
+//
+// Consumers that stop reading items early must cancel ctx, so that the background fetching ends.
+func Stream[T any](ctx context.Context, pageFetchFunc func(po PageOptions) (Page[T], error)) (<-chan T, <-chan error) {
+	items := make(chan T, pageSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		err := ForEach(pageFetchFunc, func(item T) error {
+			select {
+			case items <- item:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		close(items)
+
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return items, errs
+}
+
 func OptionalBoolOf(value bool) *bool {
 	return &value
 }
@@ -56,3 +160,106 @@ func OptionalBoolOf(value bool) *bool {
 func OptionalBool() *bool {
 	return nil
 }
+
+// BulkError is returned by bulk operations that failed for some of their items.
+//
+// Unwrap provides the errors of all items, which errors.Is and errors.As only consider
+// since Go 1.20. To support earlier versions, inspect Errors instead, e.g.:
+//
+//	var bulkErr *dtrack.BulkError[uuid.UUID]
+//	if errors.As(err, &bulkErr) {
+//		for projectUUID, err := range bulkErr.Errors {
+//			if errors.Is(err, dtrack.ErrNotFound) {
+//				// ...
+//			}
+//		}
+//	}
+type BulkError[K comparable] struct {
+	Errors map[K]error // Errors of all failed items, keyed by the items' identifiers
+}
+
+func (e *BulkError[K]) Error() string {
+	return fmt.Sprintf("bulk operation failed for %d item(s)", len(e.Errors))
+}
+
+func (e *BulkError[K]) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// GetMany fetches the resources identified by keys concurrently, with at most concurrency requests at a time,
+// and returns them keyed by their identifiers. Service methods may be passed as getFunc directly:
+//
+//	projects, err := dtrack.GetMany(ctx, projectUUIDs, 10, client.Project.Get)
+//
+// If fetching any of the resources fails, a *BulkError[K] is returned along with the
+// resources that were fetched successfully.
+func GetMany[K comparable, T any](ctx context.Context, keys []K, concurrency int, getFunc func(ctx context.Context, key K) (T, error)) (map[K]T, error) {
+	var (
+		result = make(map[K]T, len(keys))
+		mutex  sync.Mutex
+	)
+
+	errs := forEachConcurrently(ctx, keys, concurrency, func(ctx context.Context, key K) error {
+		item, err := getFunc(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		mutex.Lock()
+		result[key] = item
+		mutex.Unlock()
+		return nil
+	})
+
+	bulkErr := &BulkError[K]{Errors: make(map[K]error)}
+	for i, err := range errs {
+		if err != nil {
+			bulkErr.Errors[keys[i]] = err
+		}
+	}
+	if len(bulkErr.Errors) > 0 {
+		return result, bulkErr
+	}
+
+	return result, nil
+}
+
+// forEachConcurrently invokes fn for every item, with at most concurrency invocations running at a time.
+// The returned slice holds the error of every invocation, in the order of items.
+// Items that haven't been processed yet when ctx is done fail with the context's error.
+func forEachConcurrently[T any](ctx context.Context, items []T, concurrency int, fn func(ctx context.Context, item T) error) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		errs      = make([]error, len(items))
+		semaphore = make(chan struct{}, concurrency)
+		wg        sync.WaitGroup
+	)
+
+	for i := range items {
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		case semaphore <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			errs[i] = fn(ctx, items[i])
+		}(i)
+	}
+
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,38 @@
+//go:build go1.23
+
+package dtrack
+
+import (
+	"errors"
+	"iter"
+)
+
+var errStopIteration = errors.New("iteration stopped")
+
+// All returns an iterator over all items of a paginated API resource.
+// Pages are fetched lazily, so breaking out of the loop early avoids fetching the remaining pages:
+//
+//	for project, err := range dtrack.All(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+//		return client.Project.GetAll(ctx, po)
+//	}) {
+//		if err != nil {
+//			return err
+//		}
+//		// ...
+//	}
+//
+// If fetching a page fails, the error is yielded with the zero value of T, and iteration ends.
+func All[T any](pageFetchFunc func(po PageOptions) (Page[T], error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		err := ForEach(pageFetchFunc, func(item T) error {
+			if !yield(item, nil) {
+				return errStopIteration
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errStopIteration) {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
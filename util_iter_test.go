@@ -0,0 +1,58 @@
+//go:build go1.23
+
+package dtrack
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAll(t *testing.T) {
+	var pagesFetched int
+	pageFetchFunc := func(po PageOptions) (p Page[int], err error) {
+		pagesFetched++
+		for i := 0; i < po.PageSize; i++ {
+			idx := (po.PageSize * (po.PageNumber - 1)) + i
+			if idx >= 120 {
+				break
+			}
+			p.Items = append(p.Items, idx)
+		}
+		p.TotalCount = 120
+		return p, nil
+	}
+
+	var items []int
+	for item, err := range All(pageFetchFunc) {
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+	require.Len(t, items, 120)
+	require.Equal(t, 119, items[119])
+	require.Equal(t, 3, pagesFetched)
+
+	// Breaking early does not fetch further pages.
+	pagesFetched = 0
+	for item, err := range All(pageFetchFunc) {
+		require.NoError(t, err)
+		if item == 10 {
+			break
+		}
+	}
+	require.Equal(t, 1, pagesFetched)
+}
+
+func TestAll_PageFetchFuncErr(t *testing.T) {
+	testErr := errors.New("test error")
+
+	var errs []error
+	for _, err := range All(func(po PageOptions) (p Page[int], err error) {
+		return p, testErr
+	}) {
+		errs = append(errs, err)
+	}
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], testErr)
+}
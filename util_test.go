@@ -1,9 +1,13 @@
 package dtrack
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/require"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
@@ -113,3 +117,183 @@ func TestOptionalBoolOf(t *testing.T) {
 func TestOptionalBool(t *testing.T) {
 	require.Nil(t, OptionalBool())
 }
+
+func TestStream(t *testing.T) {
+	var wantItems []int
+	for i := 0; i < 120; i++ {
+		wantItems = append(wantItems, i)
+	}
+
+	items, errs := Stream(context.Background(), func(po PageOptions) (p Page[int], err error) {
+		for i := 0; i < po.PageSize; i++ {
+			idx := (po.PageSize * (po.PageNumber - 1)) + i
+			if idx >= len(wantItems) {
+				break
+			}
+			p.Items = append(p.Items, wantItems[idx])
+		}
+		p.TotalCount = len(wantItems)
+		return p, nil
+	})
+
+	var gotItems []int
+	for item := range items {
+		gotItems = append(gotItems, item)
+	}
+	require.NoError(t, <-errs)
+	require.Equal(t, wantItems, gotItems)
+}
+
+func TestStream_PageFetchFuncErr(t *testing.T) {
+	testErr := errors.New("test error")
+
+	items, errs := Stream(context.Background(), func(po PageOptions) (p Page[int], err error) {
+		if po.PageNumber == 2 {
+			return p, testErr
+		}
+		p.Items = make([]int, po.PageSize)
+		p.TotalCount = 1000
+		return p, nil
+	})
+
+	count := 0
+	for range items {
+		count++
+	}
+	require.Equal(t, pageSize, count)
+	require.ErrorIs(t, <-errs, testErr)
+}
+
+func TestStream_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	items, errs := Stream(ctx, func(po PageOptions) (p Page[int], err error) {
+		p.Items = make([]int, po.PageSize)
+		p.TotalCount = 1000
+		return p, nil
+	})
+
+	<-items
+	cancel()
+
+	for range items {
+		// Drain items that were sent before cancellation.
+	}
+	require.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestFetchAllConcurrently(t *testing.T) {
+	var wantItems []int
+	for i := 0; i < 468; i++ {
+		wantItems = append(wantItems, i)
+	}
+
+	var (
+		mutex         sync.Mutex
+		inFlight      int
+		maxInFlight   int
+		pagesFetched  int
+		pageFetchFunc = func(po PageOptions) (p Page[int], err error) {
+			mutex.Lock()
+			inFlight++
+			pagesFetched++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mutex.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mutex.Lock()
+			inFlight--
+			mutex.Unlock()
+
+			for i := 0; i < po.PageSize; i++ {
+				idx := (po.PageSize * (po.PageNumber - 1)) + i
+				if idx >= len(wantItems) {
+					break
+				}
+				p.Items = append(p.Items, wantItems[idx])
+			}
+			p.TotalCount = len(wantItems)
+			return p, nil
+		}
+	)
+
+	gotItems, err := FetchAllConcurrently(pageFetchFunc, 3)
+	require.NoError(t, err)
+	require.Equal(t, wantItems, gotItems)
+	require.Equal(t, 10, pagesFetched)
+	require.LessOrEqual(t, maxInFlight, 3)
+	require.Greater(t, maxInFlight, 1)
+}
+
+func TestFetchAllConcurrently_PageFetchFuncErr(t *testing.T) {
+	testErr := errors.New("test error")
+
+	_, err := FetchAllConcurrently(func(po PageOptions) (p Page[int], err error) {
+		if po.PageNumber == 3 {
+			return p, testErr
+		}
+		p.Items = make([]int, po.PageSize)
+		p.TotalCount = 1000
+		return p, nil
+	}, 2)
+	require.ErrorIs(t, err, testErr)
+	require.ErrorContains(t, err, "failed to fetch page 3")
+}
+
+func TestFetchAllConcurrently_SinglePage(t *testing.T) {
+	var pagesFetched int
+	items, err := FetchAllConcurrently(func(po PageOptions) (p Page[int], err error) {
+		pagesFetched++
+		p.Items = []int{1, 2, 3}
+		p.TotalCount = 3
+		return p, nil
+	}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, items)
+	require.Equal(t, 1, pagesFetched)
+}
+
+func TestGetMany(t *testing.T) {
+	testErr := errors.New("test error")
+
+	var (
+		mutex       sync.Mutex
+		running     int
+		maxRunning  int
+		keys        = []int{1, 2, 3, 4, 5, 6, 7, 8}
+		concurrency = 3
+	)
+
+	items, err := GetMany(context.Background(), keys, concurrency, func(_ context.Context, key int) (string, error) {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+
+		if key%4 == 0 {
+			return "", testErr
+		}
+		return fmt.Sprintf("item-%d", key), nil
+	})
+	require.LessOrEqual(t, maxRunning, concurrency)
+	require.Len(t, items, 6)
+	require.Equal(t, "item-1", items[1])
+	require.NotContains(t, items, 4)
+
+	var bulkErr *BulkError[int]
+	require.True(t, errors.As(err, &bulkErr))
+	require.Len(t, bulkErr.Errors, 2)
+	require.ErrorIs(t, bulkErr.Errors[4], testErr)
+	require.ErrorIs(t, bulkErr.Errors[8], testErr)
+}
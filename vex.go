@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/google/uuid"
 )
@@ -25,6 +26,8 @@ type vexUploadResponse struct {
 
 type VEXUploadToken string
 
+// ExportCycloneDX exports the VEX of a given project in CycloneDX format,
+// which includes all analysis decisions made for the project's findings.
 func (vs VEXService) ExportCycloneDX(ctx context.Context, projectUUID uuid.UUID) (vex string, err error) {
 	req, err := vs.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/vex/cyclonedx/project/%s", projectUUID))
 	if err != nil {
@@ -37,6 +40,7 @@ func (vs VEXService) ExportCycloneDX(ctx context.Context, projectUUID uuid.UUID)
 	return
 }
 
+// Upload uploads a VEX using the PUT endpoint, which expects the VEX to be base64 encoded.
 func (vs VEXService) Upload(ctx context.Context, uploadReq VEXUploadRequest) (token VEXUploadToken, err error) {
 	req, err := vs.client.newRequest(ctx, http.MethodPut, "api/v1/vex", withBody(uploadReq))
 	if err != nil {
@@ -45,6 +49,41 @@ func (vs VEXService) Upload(ctx context.Context, uploadReq VEXUploadRequest) (to
 
 	var uploadRes vexUploadResponse
 	_, err = vs.client.doRequest(req, &uploadRes)
+	if err != nil {
+		return
+	}
+
+	token = uploadRes.Token
+	return
+}
+
+// PostVex uploads a VEX using the POST endpoint, which accepts the VEX as multipart form data.
+// Contrary to Upload, the VEX must not be base64 encoded.
+func (vs VEXService) PostVex(ctx context.Context, uploadReq VEXUploadRequest) (token VEXUploadToken, err error) {
+	params := make(url.Values)
+	if uploadReq.ProjectUUID != nil {
+		params["project"] = append(params["project"], uploadReq.ProjectUUID.String())
+	}
+	if uploadReq.ProjectName != "" {
+		params["projectName"] = append(params["projectName"], uploadReq.ProjectName)
+	}
+	if uploadReq.ProjectVersion != "" {
+		params["projectVersion"] = append(params["projectVersion"], uploadReq.ProjectVersion)
+	}
+	if uploadReq.VEX != "" {
+		params["vex"] = append(params["vex"], uploadReq.VEX)
+	}
+
+	req, err := vs.client.newRequest(ctx, http.MethodPost, "api/v1/vex", withMultiPart(params))
+	if err != nil {
+		return
+	}
+
+	var uploadRes vexUploadResponse
+	_, err = vs.client.doRequest(req, &uploadRes)
+	if err != nil {
+		return
+	}
 
 	token = uploadRes.Token
 	return
@@ -0,0 +1,67 @@
+package dtrack
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVEXService_PostVex(t *testing.T) {
+	projectUUID := uuid.New()
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "/api/v1/vex", r.URL.Path)
+		require.NoError(t, r.ParseMultipartForm(1<<20))
+		require.Equal(t, projectUUID.String(), r.FormValue("project"))
+		require.Equal(t, "acme-app", r.FormValue("projectName"))
+		require.Equal(t, "1.0.0", r.FormValue("projectVersion"))
+		require.Equal(t, `{"bomFormat":"CycloneDX"}`, r.FormValue("vex"))
+
+		_ = json.NewEncoder(w).Encode(vexUploadResponse{Token: "token"})
+	}))
+
+	token, err := client.VEX.PostVex(context.Background(), VEXUploadRequest{
+		ProjectUUID:    &projectUUID,
+		ProjectName:    "acme-app",
+		ProjectVersion: "1.0.0",
+		VEX:            `{"bomFormat":"CycloneDX"}`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, VEXUploadToken("token"), token)
+}
+
+func TestVEXService_Upload(t *testing.T) {
+	projectUUID := uuid.New()
+	status := http.StatusOK
+
+	client := setUpTestServer(t, "4.11.0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/api/v1/vex", r.URL.Path)
+
+		var uploadReq VEXUploadRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&uploadReq))
+		require.Equal(t, &projectUUID, uploadReq.ProjectUUID)
+		require.Equal(t, base64.StdEncoding.EncodeToString([]byte("vex")), uploadReq.VEX)
+
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(vexUploadResponse{Token: "token"})
+	}))
+
+	uploadReq := VEXUploadRequest{ProjectUUID: &projectUUID, VEX: base64.StdEncoding.EncodeToString([]byte("vex"))}
+
+	token, err := client.VEX.Upload(context.Background(), uploadReq)
+	require.NoError(t, err)
+	require.Equal(t, VEXUploadToken("token"), token)
+
+	// Errors must not be swallowed.
+	status = http.StatusForbidden
+	token, err = client.VEX.Upload(context.Background(), uploadReq)
+	require.ErrorIs(t, err, ErrForbidden)
+	require.Empty(t, token)
+}
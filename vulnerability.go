@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/google/uuid"
@@ -33,6 +34,8 @@ type Vulnerability struct {
 	CVSSV3ImpactSubScore         float64              `json:"cvssV3ImpactSubScore"`
 	CVSSV3ExploitabilitySubScore float64              `json:"cvssV3ExploitabilitySubScore"`
 	CVSSV3Vector                 string               `json:"cvssV3Vector"`
+	CVSSV4Score                  float64              `json:"cvssV4Score,omitempty"`  // Since v4.13.0
+	CVSSV4Vector                 string               `json:"cvssV4Vector,omitempty"` // Since v4.13.0
 	OWASPRRBusinessImpactScore   float64              `json:"owaspRRBusinessImpactScore"`
 	OWASPRRLikelihoodScore       float64              `json:"owaspRRLikelihoodScore"`
 	OWASPRRTechnicalImpactScore  float64              `json:"owaspRRTechnicalImpactScore"`
@@ -43,6 +46,21 @@ type Vulnerability struct {
 	VulnerableVersions           string               `json:"vulnerableVersions"`
 	PatchedVersions              string               `json:"patchedVersions"`
 	Components                   *[]Component         `json:"components,omitempty"`
+	AffectedComponents           []AffectedComponent  `json:"affectedComponents,omitempty"`
+}
+
+// AffectedComponent describes a range of software versions affected by a vulnerability.
+// Ranges are identified either by CPE or by package URL.
+type AffectedComponent struct {
+	UUID                  uuid.UUID `json:"uuid,omitempty"`
+	IdentityType          string    `json:"identityType"` // CPE or PURL
+	Identity              string    `json:"identity"`
+	VersionType           string    `json:"versionType,omitempty"` // EXACT or RANGE
+	Version               string    `json:"version,omitempty"`
+	VersionStartIncluding string    `json:"versionStartIncluding,omitempty"`
+	VersionStartExcluding string    `json:"versionStartExcluding,omitempty"`
+	VersionEndIncluding   string    `json:"versionEndIncluding,omitempty"`
+	VersionEndExcluding   string    `json:"versionEndExcluding,omitempty"`
 }
 
 type VulnerabilityAlias struct {
@@ -56,6 +74,38 @@ type VulnerabilityAlias struct {
 	VulnDbID   string `json:"vulnDbId"`   // ID of the vuln in VulnDB
 }
 
+const (
+	VulnerabilitySourceGitHub   = "GITHUB"
+	VulnerabilitySourceGSD      = "GSD"
+	VulnerabilitySourceInternal = "INTERNAL"
+	VulnerabilitySourceNVD      = "NVD"
+	VulnerabilitySourceOSSIndex = "OSSINDEX"
+	VulnerabilitySourceOSV      = "OSV"
+	VulnerabilitySourceSnyk     = "SNYK"
+	VulnerabilitySourceVulnDB   = "VULNDB"
+)
+
+// IDs returns all non-empty IDs of the alias, keyed by the source they belong to.
+func (va VulnerabilityAlias) IDs() map[string]string {
+	ids := make(map[string]string)
+	for source, id := range map[string]string{
+		VulnerabilitySourceNVD:      va.CveID,
+		VulnerabilitySourceGitHub:   va.GhsaID,
+		VulnerabilitySourceGSD:      va.GsdID,
+		VulnerabilitySourceInternal: va.InternalID,
+		VulnerabilitySourceOSV:      va.OsvID,
+		VulnerabilitySourceOSSIndex: va.SonatypeId,
+		VulnerabilitySourceSnyk:     va.SnykID,
+		VulnerabilitySourceVulnDB:   va.VulnDbID,
+	} {
+		if id != "" {
+			ids[source] = id
+		}
+	}
+
+	return ids
+}
+
 type CWE struct {
 	ID   int    `json:"cweId"`
 	Name string `json:"name"`
@@ -75,6 +125,86 @@ func (vs VulnerabilityService) Get(ctx context.Context, vulnUUID uuid.UUID) (v V
 	return
 }
 
+// GetBySourceAndVulnID fetches a vulnerability by its source (e.g. NVD, GITHUB, OSV) and
+// its ID within that source (e.g. CVE-2021-44228).
+func (vs VulnerabilityService) GetBySourceAndVulnID(ctx context.Context, source, vulnID string) (v Vulnerability, err error) {
+	req, err := vs.client.newRequest(ctx, http.MethodGet, fmt.Sprintf("api/v1/vulnerability/source/%s/vuln/%s", url.PathEscape(source), url.PathEscape(vulnID)))
+	if err != nil {
+		return
+	}
+
+	_, err = vs.client.doRequest(req, &v)
+	return
+}
+
+// GetAliases fetches the aliases of a vulnerability identified by its source and ID,
+// e.g. to correlate a GHSA advisory with the corresponding CVE.
+func (vs VulnerabilityService) GetAliases(ctx context.Context, source, vulnID string) (aliases []VulnerabilityAlias, err error) {
+	v, err := vs.GetBySourceAndVulnID(ctx, source, vulnID)
+	if err != nil {
+		return
+	}
+
+	aliases = v.Aliases
+	return
+}
+
+// GetAffectedComponents fetches the software version ranges affected by a
+// vulnerability identified by its source and ID.
+func (vs VulnerabilityService) GetAffectedComponents(ctx context.Context, source, vulnID string) (acs []AffectedComponent, err error) {
+	v, err := vs.GetBySourceAndVulnID(ctx, source, vulnID)
+	if err != nil {
+		return
+	}
+
+	acs = v.AffectedComponents
+	return
+}
+
+// GenerateInternalID generates a unique ID for a vulnerability with source INTERNAL.
+func (vs VulnerabilityService) GenerateInternalID(ctx context.Context) (vulnID string, err error) {
+	req, err := vs.client.newRequest(ctx, http.MethodGet, "api/v1/vulnerability/vulnId", withAcceptContentType("text/plain"))
+	if err != nil {
+		return
+	}
+
+	_, err = vs.client.doRequest(req, &vulnID)
+	return
+}
+
+// Create creates a vulnerability. Only vulnerabilities with source INTERNAL can be created.
+func (vs VulnerabilityService) Create(ctx context.Context, vulnerability Vulnerability) (v Vulnerability, err error) {
+	req, err := vs.client.newRequest(ctx, http.MethodPut, "api/v1/vulnerability", withBody(vulnerability))
+	if err != nil {
+		return
+	}
+
+	_, err = vs.client.doRequest(req, &v)
+	return
+}
+
+// Update updates a vulnerability. Only vulnerabilities with source INTERNAL can be updated.
+func (vs VulnerabilityService) Update(ctx context.Context, vulnerability Vulnerability) (v Vulnerability, err error) {
+	req, err := vs.client.newRequest(ctx, http.MethodPost, "api/v1/vulnerability", withBody(vulnerability))
+	if err != nil {
+		return
+	}
+
+	_, err = vs.client.doRequest(req, &v)
+	return
+}
+
+// Delete deletes a vulnerability. Only vulnerabilities with source INTERNAL can be deleted.
+func (vs VulnerabilityService) Delete(ctx context.Context, vulnUUID uuid.UUID) (err error) {
+	req, err := vs.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/vulnerability/%s", vulnUUID))
+	if err != nil {
+		return
+	}
+
+	_, err = vs.client.doRequest(req, nil)
+	return
+}
+
 func (vs VulnerabilityService) GetAllForComponent(ctx context.Context, componentUUID uuid.UUID, suppressed bool, po PageOptions) (p Page[Vulnerability], err error) {
 	params := map[string]string{
 		"suppressed": strconv.FormatBool(suppressed),
@@ -113,6 +243,7 @@ func (vs VulnerabilityService) GetAllForProject(ctx context.Context, projectUUID
 	return
 }
 
+// Assign manually attributes a vulnerability to a component.
 func (vs VulnerabilityService) Assign(ctx context.Context, vulnUUID, componentUUID uuid.UUID) (err error) {
 	req, err := vs.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("api/v1/vulnerability/%s/component/%s", vulnUUID, componentUUID))
 	if err != nil {
@@ -123,6 +254,7 @@ func (vs VulnerabilityService) Assign(ctx context.Context, vulnUUID, componentUU
 	return
 }
 
+// Unassign removes a manually attributed vulnerability from a component.
 func (vs VulnerabilityService) Unassign(ctx context.Context, vulnUUID, componentUUID uuid.UUID) (err error) {
 	req, err := vs.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/vulnerability/%s/component/%s", vulnUUID, componentUUID))
 	if err != nil {
@@ -132,3 +264,25 @@ func (vs VulnerabilityService) Unassign(ctx context.Context, vulnUUID, component
 	_, err = vs.client.doRequest(req, nil)
 	return
 }
+
+// AssignBySourceAndVulnID manually attributes a vulnerability, identified by its source and ID, to a component.
+func (vs VulnerabilityService) AssignBySourceAndVulnID(ctx context.Context, source, vulnID string, componentUUID uuid.UUID) (err error) {
+	req, err := vs.client.newRequest(ctx, http.MethodPost, fmt.Sprintf("api/v1/vulnerability/source/%s/vuln/%s/component/%s", url.PathEscape(source), url.PathEscape(vulnID), componentUUID))
+	if err != nil {
+		return
+	}
+
+	_, err = vs.client.doRequest(req, nil)
+	return
+}
+
+// UnassignBySourceAndVulnID removes a manually attributed vulnerability, identified by its source and ID, from a component.
+func (vs VulnerabilityService) UnassignBySourceAndVulnID(ctx context.Context, source, vulnID string, componentUUID uuid.UUID) (err error) {
+	req, err := vs.client.newRequest(ctx, http.MethodDelete, fmt.Sprintf("api/v1/vulnerability/source/%s/vuln/%s/component/%s", url.PathEscape(source), url.PathEscape(vulnID), componentUUID))
+	if err != nil {
+		return
+	}
+
+	_, err = vs.client.doRequest(req, nil)
+	return
+}
@@ -0,0 +1,67 @@
+package dtrack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVulnerabilityLifecycle(t *testing.T) {
+	client := setUpContainer(t, testContainerOptions{
+		APIPermissions: []string{
+			PermissionViewVulnerability,
+			PermissionVulnerabilityManagement,
+		},
+	})
+
+	vulnID, err := client.Vulnerability.GenerateInternalID(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, vulnID)
+
+	vuln, err := client.Vulnerability.Create(context.Background(), Vulnerability{
+		VulnID:   vulnID,
+		Source:   "INTERNAL",
+		Title:    "Test Vulnerability",
+		Severity: "HIGH",
+	})
+	require.NoError(t, err)
+	require.Equal(t, vulnID, vuln.VulnID)
+
+	// Check presence
+	{
+		v, err := client.Vulnerability.GetBySourceAndVulnID(context.Background(), "INTERNAL", vulnID)
+		require.NoError(t, err)
+		require.Equal(t, vuln.UUID, v.UUID)
+		require.Equal(t, "Test Vulnerability", v.Title)
+	}
+
+	// Update
+	{
+		vuln.Title = "Updated Test Vulnerability"
+		v, err := client.Vulnerability.Update(context.Background(), vuln)
+		require.NoError(t, err)
+		require.Equal(t, "Updated Test Vulnerability", v.Title)
+	}
+
+	// Delete
+	{
+		err := client.Vulnerability.Delete(context.Background(), vuln.UUID)
+		require.NoError(t, err)
+
+		_, err = client.Vulnerability.Get(context.Background(), vuln.UUID)
+		require.Error(t, err)
+	}
+}
+
+func TestVulnerabilityAlias_IDs(t *testing.T) {
+	alias := VulnerabilityAlias{
+		CveID:  "CVE-2021-44228",
+		GhsaID: "GHSA-jfh8-c2jp-5v3q",
+	}
+
+	require.Equal(t, map[string]string{
+		VulnerabilitySourceNVD:    "CVE-2021-44228",
+		VulnerabilitySourceGitHub: "GHSA-jfh8-c2jp-5v3q",
+	}, alias.IDs())
+}